@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/format"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	rdfformat "github.com/maartyman/rdfgo/lib/format"
+)
+
+const rdfsComment = "http://www.w3.org/2000/01/rdf-schema#comment"
+
+// vocabulary is what the generated package is made from.
+type vocabulary struct {
+	source    string
+	namespace string
+	prefix    string
+	title     string
+}
+
+// term is a term of a vocabulary with the name of its Go variable.
+type term struct {
+	local   string
+	name    string
+	comment string
+}
+
+// generate reads an ontology and returns the source of a Go package with a
+// variable for every IRI in the namespace of the vocabulary that is the
+// subject of a triple.
+func generate(reader io.Reader, mediaType string, vocabulary vocabulary) ([]byte, error) {
+	quads := rdfformat.ParseContext(context.Background(), reader, mediaType, vocabulary.namespace, rdfformat.ParseOptions{})
+	terms := make(map[string]*term)
+	for quad := range quads.Quads() {
+		subject := quad.GetSubject()
+		local, ok := strings.CutPrefix(subject.GetValue(), vocabulary.namespace)
+		if subject.GetType() != interfaces.NamedNodeType || !ok || local == "" || strings.ContainsAny(local, "/#") {
+			continue
+		}
+		if terms[local] == nil {
+			terms[local] = &term{local: local}
+		}
+		object, isLiteral := quad.GetObject().(interfaces.ILiteral)
+		language := ""
+		if isLiteral {
+			language = object.GetLanguage()
+		}
+		if quad.GetPredicate().GetValue() == rdfsComment && isLiteral && terms[local].comment == "" && (language == "" || strings.HasPrefix(language, "en")) {
+			terms[local].comment = strings.Join(strings.Fields(object.GetValue()), " ")
+		}
+	}
+	if err := quads.Err(); err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("no terms in %s", vocabulary.namespace)
+	}
+
+	sorted := make([]*term, 0, len(terms))
+	for _, term := range terms {
+		sorted = append(sorted, term)
+	}
+	slices.SortFunc(sorted, func(a *term, b *term) int {
+		return strings.Compare(a.local, b.local)
+	})
+	if err := name(sorted); err != nil {
+		return nil, err
+	}
+	return format.Source([]byte(render(vocabulary, sorted)))
+}
+
+// name gives every term an exported Go name: its local name with an upper
+// case first letter and other characters than letters, digits and
+// underscores replaced by underscores. A property that gets the name of a
+// class, like prov:entity and prov:Entity, or of a declaration of the
+// generated package, like sh:namespace, gets the suffix Property.
+func name(terms []*term) error {
+	names := make(map[string]*term)
+	for _, declared := range []string{"Namespace", "Prefix", "Terms"} {
+		names[declared] = &term{local: declared}
+	}
+	for _, term := range terms {
+		runes := []rune(term.local)
+		for i, r := range runes {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+				runes[i] = '_'
+			}
+		}
+		runes[0] = unicode.ToUpper(runes[0])
+		term.name = string(runes)
+		if !unicode.IsLetter(runes[0]) {
+			term.name = "Term" + term.name
+		}
+	}
+	for _, term := range terms {
+		if unicode.IsLower([]rune(term.local)[0]) {
+			continue
+		}
+		if other := names[term.name]; other != nil {
+			return fmt.Errorf("%s and %s have the same name %s", other.local, term.local, term.name)
+		}
+		names[term.name] = term
+	}
+	for _, term := range terms {
+		if !unicode.IsLower([]rune(term.local)[0]) {
+			continue
+		}
+		if names[term.name] != nil {
+			term.name += "Property"
+		}
+		if other := names[term.name]; other != nil {
+			return fmt.Errorf("%s and %s have the same name %s", other.local, term.local, term.name)
+		}
+		names[term.name] = term
+	}
+	return nil
+}
+
+func render(vocabulary vocabulary, terms []*term) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "// Code generated by cmd/vocab from %s. DO NOT EDIT.\n\n", vocabulary.source)
+	fmt.Fprintf(&builder, "// Package rdfgo holds the terms of %s.\n", vocabulary.title)
+	builder.WriteString("package rdfgo\n\n")
+	builder.WriteString("import (\n\t\"github.com/maartyman/rdfgo/interfaces\"\n\tdatamodel \"github.com/maartyman/rdfgo/lib/data_model\"\n)\n\n")
+	fmt.Fprintf(&builder, "const (\n\tNamespace = %q\n\tPrefix = %q\n)\n\n", vocabulary.namespace, vocabulary.prefix)
+	builder.WriteString("var (\n")
+	for i, term := range terms {
+		if term.comment != "" {
+			if i > 0 {
+				builder.WriteString("\n")
+			}
+			builder.WriteString(wrap(term.comment, "\t// ", 77))
+		}
+		fmt.Fprintf(&builder, "\t%s interfaces.INamedNode = datamodel.NewNamedNode(Namespace + %q)\n", term.name, term.local)
+	}
+	builder.WriteString(")\n\n")
+	builder.WriteString("// Terms holds the terms of the vocabulary by local name.\n")
+	builder.WriteString("var Terms = map[string]interfaces.INamedNode{\n")
+	for _, term := range terms {
+		fmt.Fprintf(&builder, "\t%q: %s,\n", term.local, term.name)
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// wrap writes text as lines of at most width characters after the indent.
+func wrap(text string, indent string, width int) string {
+	var builder strings.Builder
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			builder.WriteString(indent + line + "\n")
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	builder.WriteString(indent + line + "\n")
+	return builder.String()
+}
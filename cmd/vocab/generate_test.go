@@ -0,0 +1,59 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	ontology := `@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+@prefix ex: <http://example.org/ns#> .
+
+ex:Entity a rdfs:Class ;
+	rdfs:comment "Eine Sache."@de, """A thing  that
+	exists."""@en .
+ex:entity a rdf:Property .
+ex:namespace a rdf:Property .
+ex:has-part a rdf:Property .
+ex:3D a rdfs:Class .
+<http://example.org/other#Thing> a rdfs:Class .
+`
+	source, err := generate(strings.NewReader(ontology), "text/turtle", vocabulary{
+		source:    "ex.ttl",
+		namespace: "http://example.org/ns#",
+		prefix:    "ex",
+		title:     "the Example vocabulary",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"// Code generated by cmd/vocab from ex.ttl. DO NOT EDIT.",
+		"// Package rdfgo holds the terms of the Example vocabulary.",
+		"\t// A thing that exists.\n\tEntity ",
+		`EntityProperty    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "entity")`,
+		`NamespaceProperty interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "namespace")`,
+		`Has_part          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "has-part")`,
+		`Term3D interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "3D")`,
+		`"has-part":  Has_part,`,
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Errorf("expected %q in\n%s", expected, source)
+		}
+	}
+	if strings.Contains(string(source), "Thing") {
+		t.Errorf("expected no terms of other namespaces in\n%s", source)
+	}
+}
+
+func TestGenerate_collision(t *testing.T) {
+	ontology := `@prefix ex: <http://example.org/ns#> .
+ex:A-b ex:p ex:o .
+ex:A_b ex:p ex:o .
+`
+	_, err := generate(strings.NewReader(ontology), "text/turtle", vocabulary{namespace: "http://example.org/ns#", prefix: "ex"})
+	if err == nil || !strings.Contains(err.Error(), "same name") {
+		t.Fatalf("expected a name collision, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+// Command vocab generates a Go package with a variable for every term of an
+// RDF vocabulary, from an ontology in any format the format package parses.
+//
+//	go run ./cmd/vocab -in ontology.ttl -namespace http://example.org/ns# -prefix ex -title "the Example vocabulary" -out lib/vocab/ex
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	rdfformat "github.com/maartyman/rdfgo/lib/format"
+)
+
+func main() {
+	in := flag.String("in", "", "the ontology file")
+	namespace := flag.String("namespace", "", "the namespace IRI of the vocabulary")
+	prefix := flag.String("prefix", "", "the usual prefix of the namespace")
+	title := flag.String("title", "", "the name of the vocabulary in the package comment")
+	out := flag.String("out", "", "the directory of the generated package")
+	flag.Parse()
+	if *in == "" || *namespace == "" || *prefix == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*in, vocabulary{source: filepath.ToSlash(*in), namespace: *namespace, prefix: *prefix, title: *title}, *out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(in string, vocabulary vocabulary, out string) error {
+	format, ok := rdfformat.LookupFile(in)
+	if !ok {
+		return fmt.Errorf("%w: %s", rdfformat.UnknownFormatError, in)
+	}
+	file, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if vocabulary.title == "" {
+		vocabulary.title = "the " + vocabulary.prefix + " vocabulary"
+	}
+	source, err := generate(file, format.MediaTypes[0], vocabulary)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(out, vocabulary.prefix+".go"), source, 0o644)
+}
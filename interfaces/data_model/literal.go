@@ -6,5 +6,6 @@ const literalTypeString = "Literal"
 type ILiteral interface {
 	ITerm
 	GetLanguage() string
+	GetDirection() string
 	GetDatatype() INamedNode
 }
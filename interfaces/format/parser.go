@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"io"
+
+	. "github.com/maartyman/rdfgo/interfaces/stream"
+)
+
+type IParser interface {
+	Parse(reader io.Reader, baseIRI string) (IStream, error)
+}
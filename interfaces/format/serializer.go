@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"io"
+
+	. "github.com/maartyman/rdfgo/interfaces/stream"
+)
+
+type ISerializer interface {
+	Serialize(writer io.Writer, stream IStream, prefixes map[string]string) error
+}
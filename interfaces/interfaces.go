@@ -2,6 +2,7 @@ package interfaces
 
 import datamodel "github.com/maartyman/rdfgo/interfaces/data_model"
 import dataset "github.com/maartyman/rdfgo/interfaces/dataset"
+import format "github.com/maartyman/rdfgo/interfaces/format"
 import stream "github.com/maartyman/rdfgo/interfaces/stream"
 
 type IBlankNode = datamodel.IBlankNode
@@ -30,3 +31,7 @@ type ISink = stream.ISink
 type ISource = stream.ISource
 type IStore = stream.IStore
 type IStream = stream.IStream
+type IQuadStream = stream.IQuadStream
+
+type IParser = format.IParser
+type ISerializer = format.ISerializer
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	. "github.com/maartyman/rdfgo/interfaces/data_model"
+)
+
+// IQuadStream is a stream of quads that can be cancelled by the consumer and
+// that reports why the producer stopped. Quads is closed when the producer
+// is done, after which Err returns the terminal error, if any.
+type IQuadStream interface {
+	Quads() <-chan IQuad
+	Err() error
+	Done() <-chan struct{}
+	Close()
+}
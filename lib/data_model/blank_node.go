@@ -5,32 +5,38 @@ import (
 	"github.com/maartyman/rdfgo/interfaces"
 )
 
-var blankNodeCounter = 0
-
 type BlankNode struct {
 	value string
 }
 
+// NewBlankNode creates a blank node with a label, without a leading "_:".
+// An empty label gets a fresh label from the default allocator.
 func NewBlankNode(value string) interfaces.IBlankNode {
-	for {
-		if len(value) == 0 || (value[0] != '_' && value[0] != ':') {
-			break
-		}
-		value = value[1:]
-	}
+	value = blankNodeLabel(value)
 	if value == "" {
-		value = fmt.Sprintf("n3-%d", blankNodeCounter)
-		blankNodeCounter++
+		return defaultAllocator.Fresh()
 	}
 	return &BlankNode{
 		value: value,
 	}
 }
 
+func blankNodeLabel(value string) string {
+	for {
+		if len(value) == 0 || (value[0] != '_' && value[0] != ':') {
+			return value
+		}
+		value = value[1:]
+	}
+}
+
 func (b *BlankNode) Equals(other interfaces.ITerm) bool {
 	if other == nil {
 		return false
 	}
+	if b == other {
+		return true
+	}
 	return interfaces.BlankNodeType == other.GetType() && b.value == other.GetValue()
 }
 
@@ -0,0 +1,106 @@
+package rdfgo
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+// defaultAllocator gives the blank nodes created without a label theirs. Its
+// prefix is random, so that its labels do not collide with the labels of
+// documents and stores, including ones written by an earlier run.
+var defaultAllocator = NewBlankNodeAllocator(randomPrefix())
+
+// randomPrefix returns "n3-" followed by 64 random bits and a hyphen.
+func randomPrefix() string {
+	var random [8]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		panic(err)
+	}
+	return "n3-" + hex.EncodeToString(random[:]) + "-"
+}
+
+// BlankNodeAllocator creates blank nodes with fresh labels: a prefix and a
+// counter. It is safe for concurrent use. Labels are only fresh among the
+// ones of the allocator, so the prefix should not be used for other labels.
+type BlankNodeAllocator struct {
+	prefix  string
+	counter atomic.Uint64
+}
+
+func NewBlankNodeAllocator(prefix string) *BlankNodeAllocator {
+	return &BlankNodeAllocator{prefix: prefix}
+}
+
+// Fresh returns a blank node with a label that the allocator has not
+// returned before.
+func (a *BlankNodeAllocator) Fresh() interfaces.IBlankNode {
+	return &BlankNode{value: a.prefix + strconv.FormatUint(a.counter.Add(1)-1, 10)}
+}
+
+// NewScope returns a scope that takes its labels from the allocator.
+func (a *BlankNodeAllocator) NewScope() *BlankNodeScope {
+	return &BlankNodeScope{allocator: a, nodes: make(map[string]interfaces.IBlankNode)}
+}
+
+// BlankNodeScope holds the blank nodes of one document, or of any other set
+// of quads whose blank nodes must not be the same as those of other sets
+// with the same labels. It gives each label a fresh blank node, and the same
+// one every time. It is safe for concurrent use.
+type BlankNodeScope struct {
+	allocator *BlankNodeAllocator
+	mux       sync.Mutex
+	nodes     map[string]interfaces.IBlankNode
+}
+
+// NewBlankNodeScope returns a scope that takes its labels from the default
+// allocator, like NewBlankNode("").
+func NewBlankNodeScope() *BlankNodeScope {
+	return defaultAllocator.NewScope()
+}
+
+// Get returns the blank node of a label in the scope.
+func (s *BlankNodeScope) Get(label string) interfaces.IBlankNode {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	node, ok := s.nodes[label]
+	if !ok {
+		node = s.allocator.Fresh()
+		s.nodes[label] = node
+	}
+	return node
+}
+
+// Relabel replaces the blank nodes of a term, also in a quoted quad, by
+// their blank nodes in the scope.
+func (s *BlankNodeScope) Relabel(term interfaces.ITerm) interfaces.ITerm {
+	return mapTerm(term, func(term interfaces.ITerm) interfaces.ITerm {
+		if term.GetType() == interfaces.BlankNodeType {
+			return s.Get(term.GetValue())
+		}
+		return term
+	})
+}
+
+func (s *BlankNodeScope) RelabelQuad(quad interfaces.IQuad) interfaces.IQuad {
+	return s.Relabel(quad).(interfaces.IQuad)
+}
+
+// mapTerm applies f to a term, or to the subject, object and graph of a
+// quad, recursively. Predicates are left alone, as f may turn named nodes
+// into blank nodes.
+func mapTerm(term interfaces.ITerm, f func(interfaces.ITerm) interfaces.ITerm) interfaces.ITerm {
+	if term.GetType() != interfaces.QuadType {
+		return f(term)
+	}
+	quad := term.(interfaces.IQuad)
+	subject, object, graph := mapTerm(quad.GetSubject(), f), mapTerm(quad.GetObject(), f), mapTerm(quad.GetGraph(), f)
+	if subject == quad.GetSubject() && object == quad.GetObject() && graph == quad.GetGraph() {
+		return quad
+	}
+	return &Quad{subject: subject, predicate: quad.GetPredicate(), object: object, graph: graph}
+}
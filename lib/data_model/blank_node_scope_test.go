@@ -0,0 +1,85 @@
+package rdfgo
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+func TestBlankNodeAllocator_Fresh(t *testing.T) {
+	allocator := NewBlankNodeAllocator("b")
+	if label := allocator.Fresh().GetValue(); label != "b0" {
+		t.Errorf("Expected the first label to be b0, got %s", label)
+	}
+	labels := make([]string, 1000)
+	var group sync.WaitGroup
+	for worker := 0; worker < 10; worker++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for i := worker; i < len(labels); i += 10 {
+				labels[i] = allocator.Fresh().GetValue()
+			}
+		}()
+	}
+	group.Wait()
+	seen := make(map[string]bool)
+	for _, label := range labels {
+		if seen[label] {
+			t.Fatalf("Expected fresh labels, got %s twice", label)
+		}
+		seen[label] = true
+	}
+	if NewBlankNode("").Equals(NewBlankNode("")) {
+		t.Errorf("Expected blank nodes without a label to be different")
+	}
+}
+
+func TestBlankNodeScope(t *testing.T) {
+	allocator := NewBlankNodeAllocator("s")
+	first, second := allocator.NewScope(), allocator.NewScope()
+	if !first.Get("a").Equals(first.Get("a")) {
+		t.Errorf("Expected a label to get the same blank node in a scope")
+	}
+	if first.Get("a").Equals(first.Get("b")) || first.Get("a").Equals(second.Get("a")) {
+		t.Errorf("Expected different labels and scopes to get different blank nodes")
+	}
+
+	var group sync.WaitGroup
+	nodes := make([]interfaces.IBlankNode, 10)
+	for i := range nodes {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			nodes[i] = second.Get("shared" + strconv.Itoa(i%2))
+		}()
+	}
+	group.Wait()
+	for i, node := range nodes {
+		if !node.Equals(nodes[i%2]) {
+			t.Errorf("Expected concurrent lookups of a label to get the same blank node")
+		}
+	}
+	if NewBlankNodeScope().Get("a").Equals(NewBlankNodeScope().Get("a")) {
+		t.Errorf("Expected scopes of the default allocator to be different")
+	}
+}
+
+func TestBlankNodeScope_Relabel(t *testing.T) {
+	scope := NewBlankNodeAllocator("r").NewScope()
+	p := NewNamedNode("http://example.com/p")
+	quoted, _ := NewQuad(NewBlankNode("a"), p, NewNamedNode("http://example.com/o"), nil)
+	quad, _ := NewQuad(quoted, p, NewBlankNode("a"), NewBlankNode("g"))
+	relabeled := scope.RelabelQuad(quad)
+	expectedQuoted, _ := NewQuad(scope.Get("a"), p, NewNamedNode("http://example.com/o"), nil)
+	expected, _ := NewQuad(expectedQuoted, p, scope.Get("a"), scope.Get("g"))
+	if !relabeled.Equals(expected) {
+		t.Errorf("Expected %s, got %s", expected.ToString(), relabeled.ToString())
+	}
+	plain, _ := NewQuad(NewNamedNode("http://example.com/s"), p, NewStringLiteral("o", ""), nil)
+	if scope.RelabelQuad(plain) != plain {
+		t.Errorf("Expected a quad without blank nodes to be kept")
+	}
+}
@@ -26,7 +26,7 @@ func TestBlankNode_Equals(t *testing.T) {
 
 	for _, tt := range tests {
 		if tt.b1.Equals(tt.b2) != tt.expected {
-			t.Errorf(tt.message)
+			t.Error(tt.message)
 		}
 	}
 }
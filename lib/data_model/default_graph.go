@@ -17,6 +17,9 @@ func (d *DefaultGraph) Equals(other interfaces.ITerm) bool {
 	if other == nil {
 		return false
 	}
+	if d == other {
+		return true
+	}
 	return interfaces.DefaultGraphType == other.GetType()
 }
 
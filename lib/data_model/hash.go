@@ -0,0 +1,61 @@
+package rdfgo
+
+import (
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+const (
+	hashOffset uint64 = 14695981039346656037
+	hashPrime  uint64 = 1099511628211
+)
+
+// Hash returns a 64-bit FNV-1a hash of a term. Equal terms have the same
+// hash, in every process and on every platform, so hashes can be stored.
+func Hash(term interfaces.ITerm) uint64 {
+	return hashTerm(hashOffset, term)
+}
+
+func hashTerm(hash uint64, term interfaces.ITerm) uint64 {
+	if term == nil {
+		return hashByte(hash, 0xff)
+	}
+	hash = hashByte(hash, byte(term.GetType()))
+	switch t := term.(type) {
+	case interfaces.IQuad:
+		hash = hashTerm(hash, t.GetSubject())
+		hash = hashTerm(hash, t.GetPredicate())
+		hash = hashTerm(hash, t.GetObject())
+		return hashTerm(hash, t.GetGraph())
+	case interfaces.ILiteral:
+		hash = hashString(hash, t.GetValue())
+		// Literals compare their languages case-insensitively.
+		hash = hashString(hash, strings.ToLower(t.GetLanguage()))
+		hash = hashString(hash, t.GetDirection())
+		if t.GetDatatype() == nil {
+			return hashByte(hash, 0xff)
+		}
+		return hashString(hash, t.GetDatatype().GetValue())
+	default:
+		return hashString(hash, t.GetValue())
+	}
+}
+
+// hashString hashes the length of a string as a varint before its bytes,
+// so that the strings of a term cannot run into each other.
+func hashString(hash uint64, value string) uint64 {
+	length := len(value)
+	for ; length >= 0x80; length >>= 7 {
+		hash = hashByte(hash, byte(length)|0x80)
+	}
+	hash = hashByte(hash, byte(length))
+	for i := 0; i < len(value); i++ {
+		hash = hashByte(hash, value[i])
+	}
+	return hash
+}
+
+func hashByte(hash uint64, b byte) uint64 {
+	return (hash ^ uint64(b)) * hashPrime
+}
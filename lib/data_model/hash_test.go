@@ -0,0 +1,41 @@
+package rdfgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+func TestHash(t *testing.T) {
+	quad, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewStringLiteral("o", "en-GB"), nil)
+	equalQuad, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewStringLiteral("o", "en-gb"), NewDefaultGraph())
+	otherQuad, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewStringLiteral("o", "en-GB--ltr"), nil)
+	if Hash(quad) != Hash(equalQuad) || Hash(quad) == Hash(otherQuad) {
+		t.Error("Expected equal quads, and only those, to have the same hash")
+	}
+	if Hash(NewNamedNode("http://example.org/")) != 0xfa51b6cd3e9b9273 {
+		t.Errorf("Expected the hash to be stable, got %#x", Hash(NewNamedNode("http://example.org/")))
+	}
+
+	terms := []interfaces.ITerm{
+		NewNamedNode("a"), NewBlankNode("a"), NewVariable("a"), NewStringLiteral("a", ""), NewDefaultGraph(),
+		NewLiteral("a", "", nil), NewLiteral("a", "", NewNamedNode("")), NewNamedNode(strings.Repeat("a", 200)),
+		NewNamedNode(strings.Repeat("a", 201)), nil,
+	}
+	hashes := make(map[uint64]bool)
+	for _, term := range terms {
+		hashes[Hash(term)] = true
+	}
+	if len(hashes) != len(terms) {
+		t.Errorf("Expected different terms to have different hashes")
+	}
+}
+
+func TestEquals_SameInstance(t *testing.T) {
+	for _, term := range []interfaces.ITerm{NewNamedNode("a"), NewBlankNode("a"), NewVariable("a"), NewDefaultGraph()} {
+		if !term.Equals(term) {
+			t.Errorf("Expected %s to equal itself", term.ToString())
+		}
+	}
+}
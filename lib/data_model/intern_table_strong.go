@@ -0,0 +1,32 @@
+//go:build !go1.24
+
+package rdfgo
+
+import "sync"
+
+// internTable maps keys to their values. Without weak pointers, which need
+// Go 1.24, it keeps every value until the table itself is dropped.
+type internTable[K comparable, T any] struct {
+	mux     sync.Mutex
+	entries map[K]*T
+}
+
+func (t *internTable[K, T]) get(key K, create func() *T) *T {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if value := t.entries[key]; value != nil {
+		return value
+	}
+	if t.entries == nil {
+		t.entries = make(map[K]*T)
+	}
+	value := create()
+	t.entries[key] = value
+	return value
+}
+
+func (t *internTable[K, T]) len() int {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return len(t.entries)
+}
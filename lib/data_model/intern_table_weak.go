@@ -0,0 +1,52 @@
+//go:build go1.24
+
+package rdfgo
+
+import (
+	"runtime"
+	"sync"
+	"weak"
+)
+
+// internTable maps keys to weak pointers. An entry is removed when the
+// garbage collector has collected the value it points to.
+type internTable[K comparable, T any] struct {
+	mux     sync.Mutex
+	entries map[K]weak.Pointer[T]
+}
+
+type internEntry[K comparable, T any] struct {
+	key     K
+	pointer weak.Pointer[T]
+}
+
+func (t *internTable[K, T]) get(key K, create func() *T) *T {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if value := t.entries[key].Value(); value != nil {
+		return value
+	}
+	if t.entries == nil {
+		t.entries = make(map[K]weak.Pointer[T])
+	}
+	value := create()
+	pointer := weak.Make(value)
+	t.entries[key] = pointer
+	runtime.AddCleanup(value, t.remove, internEntry[K, T]{key, pointer})
+	return value
+}
+
+func (t *internTable[K, T]) remove(entry internEntry[K, T]) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	// The entry could already point to a newer value with the same key.
+	if t.entries[entry.key] == entry.pointer {
+		delete(t.entries, entry.key)
+	}
+}
+
+func (t *internTable[K, T]) len() int {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return len(t.entries)
+}
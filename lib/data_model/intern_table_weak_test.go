@@ -0,0 +1,39 @@
+//go:build go1.24
+
+package rdfgo
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestInterningDataFactory_Collect(t *testing.T) {
+	df := NewInterningDataFactory()
+	kept := df.NamedNode("http://example.org/kept")
+	for i := 0; i < 100; i++ {
+		df.Literal(string(rune('a'+i%26))+string(rune('a'+i/26)), "", nil)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for df.literals.len() > 0 || df.namedNodes.len() > 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected unused terms to be dropped, %d literals are left", df.literals.len())
+		}
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+	if df.NamedNode("http://example.org/kept") != kept {
+		t.Error("Expected a term that is still used to be kept")
+	}
+
+	// An entry that was replaced is not removed by the cleanup of its old value.
+	table := &internTable[string, int]{}
+	old := table.get("a", func() *int { return new(int) })
+	entry := internEntry[string, int]{"a", table.entries["a"]}
+	delete(table.entries, "a")
+	current := table.get("a", func() *int { return new(int) })
+	table.remove(entry)
+	if table.get("a", nil) != current || old == current {
+		t.Error("Expected the newer entry to be kept")
+	}
+}
@@ -0,0 +1,91 @@
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	langtag "github.com/maartyman/rdfgo/lib/langtag"
+)
+
+var _ interfaces.IDataFactory = (*InterningDataFactory)(nil)
+
+var defaultGraph interfaces.IDefaultGraph = &DefaultGraph{}
+
+// InterningDataFactory is a data factory that returns the same instance for
+// equal terms, so a dataset holds every IRI and literal once and Equals can
+// compare pointers. Built with Go 1.24 or later it only holds its terms
+// weakly: a term that is not used anymore is garbage collected and dropped
+// from the factory. With older versions it keeps every term it returned.
+// Blank nodes without a label and quads are not interned. It is safe for
+// concurrent use.
+type InterningDataFactory struct {
+	namedNodes internTable[string, NamedNode]
+	blankNodes internTable[string, BlankNode]
+	literals   internTable[literalKey, Literal]
+	variables  internTable[string, Variable]
+}
+
+type literalKey struct {
+	value    string
+	language string
+	datatype string
+}
+
+func NewInterningDataFactory() *InterningDataFactory {
+	return &InterningDataFactory{}
+}
+
+func (df *InterningDataFactory) NamedNode(value string) interfaces.INamedNode {
+	value = namedNodeValue(value)
+	return df.namedNodes.get(value, func() *NamedNode {
+		return &NamedNode{value: value}
+	})
+}
+
+func (df *InterningDataFactory) BlankNode(value string) interfaces.IBlankNode {
+	value = blankNodeLabel(value)
+	if value == "" {
+		return defaultAllocator.Fresh()
+	}
+	return df.blankNodes.get(value, func() *BlankNode {
+		return &BlankNode{value: value}
+	})
+}
+
+func (df *InterningDataFactory) SimpleLiteral(value string) interfaces.ILiteral {
+	return df.Literal(value, "", nil)
+}
+
+func (df *InterningDataFactory) Literal(value string, language string, datatype interfaces.INamedNode) interfaces.ILiteral {
+	key := literalKey{value: value, language: langtag.Normalize(language)}
+	if language == "" {
+		key.datatype = IRI.XSD.String.GetValue()
+		if datatype != nil {
+			key.datatype = datatype.GetValue()
+		}
+	}
+	return df.literals.get(key, func() *Literal {
+		if key.datatype == "" {
+			return NewLiteral(value, language, nil).(*Literal)
+		}
+		return NewLiteral(value, language, df.NamedNode(key.datatype)).(*Literal)
+	})
+}
+
+func (df *InterningDataFactory) Variable(value string) interfaces.IVariable {
+	value = variableName(value)
+	return df.variables.get(value, func() *Variable {
+		return &Variable{value: value}
+	})
+}
+
+func (df *InterningDataFactory) DefaultGraph() interfaces.IDefaultGraph {
+	return defaultGraph
+}
+
+func (df *InterningDataFactory) Quad(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) (interfaces.IQuad, error) {
+	return NewQuad(subject, predicate, object, graph)
+}
@@ -0,0 +1,66 @@
+package rdfgo
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+func TestInterningDataFactory(t *testing.T) {
+	df := NewInterningDataFactory()
+	if df.NamedNode("http://example.org/a") != df.NamedNode("<http://example.org/a>") {
+		t.Error("Expected equal named nodes to be the same instance")
+	}
+	if df.NamedNode("http://example.org/a") == df.NamedNode("http://example.org/b") {
+		t.Error("Expected different named nodes to be different instances")
+	}
+	if df.BlankNode("b") != df.BlankNode("_:b") || df.BlankNode("") == df.BlankNode("") {
+		t.Error("Expected blank nodes with a label, and only those, to be the same instance")
+	}
+	if df.Variable("v") != df.Variable("?v") {
+		t.Error("Expected equal variables to be the same instance")
+	}
+	if df.DefaultGraph() != df.DefaultGraph() {
+		t.Error("Expected the default graph to be the same instance")
+	}
+
+	literal := df.Literal("1", "", df.NamedNode(IRI.XSD.Integer.GetValue()))
+	if literal != df.Literal("1", "", NewNamedNode(IRI.XSD.Integer.GetValue())) || literal.GetDatatype() != df.NamedNode(IRI.XSD.Integer.GetValue()) {
+		t.Error("Expected equal literals and their datatypes to be the same instance")
+	}
+	if df.SimpleLiteral("a") != df.Literal("a", "", nil) || df.SimpleLiteral("a") != df.Literal("a", "", IRI.XSD.String) {
+		t.Error("Expected a literal without a datatype to be an xsd:string")
+	}
+	if df.Literal("a", "en-us", nil) != df.Literal("a", "EN-US", IRI.XSD.String) || df.Literal("a", "en-us", nil) == df.Literal("a", "en-us--ltr", nil) {
+		t.Error("Expected literals with the same language in another case, and only those, to be the same instance")
+	}
+	if !df.Literal("a", "en-us--ltr", nil).Equals(NewStringLiteral("a", "en-US--ltr")) {
+		t.Error("Expected an interned literal to equal a literal that is not interned")
+	}
+
+	quad, err := df.Quad(df.NamedNode("s"), df.NamedNode("p"), literal, nil)
+	expected, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewIntegerLiteral(1), nil)
+	if err != nil || !quad.Equals(expected) {
+		t.Errorf("Expected %s, got %v", expected.ToString(), err)
+	}
+}
+
+func TestInterningDataFactory_Concurrent(t *testing.T) {
+	df := NewInterningDataFactory()
+	nodes := make([]interfaces.INamedNode, 100)
+	var group sync.WaitGroup
+	for i := range nodes {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			nodes[i] = df.NamedNode("http://example.org/a")
+		}()
+	}
+	group.Wait()
+	for _, node := range nodes {
+		if node != nodes[0] {
+			t.Fatal("Expected concurrent calls to return the same instance")
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 
 const (
 	xsd = "http://www.w3.org/2001/XMLSchema#"
-	//rdf  = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	rdf = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
 	//swap = "http://www.w3.org/2000/10/swap/"
 )
 
@@ -18,15 +18,12 @@ type XSDTerms struct {
 	String  interfaces.INamedNode
 }
 
-/*
 type RDFTerms struct {
-	Type       interfaces.INamedNode
-	Nil        interfaces.INamedNode
-	First      interfaces.INamedNode
-	Rest       interfaces.INamedNode
-	LangString interfaces.INamedNode
+	LangString    interfaces.INamedNode
+	DirLangString interfaces.INamedNode
 }
 
+/*
 type OWLTerms struct {
 	SameAs interfaces.INamedNode
 }
@@ -43,8 +40,8 @@ type LogTerms struct {
 
 type Terms struct {
 	XSD XSDTerms
+	RDF RDFTerms
 	/*
-		RDF RDFTerms
 		OWL OWLTerms
 		R   RTerms
 		Log LogTerms
@@ -59,14 +56,11 @@ var IRI = Terms{
 		Integer: NewNamedNode(xsd + "integer"),
 		String:  NewNamedNode(xsd + "string"),
 	},
+	RDF: RDFTerms{
+		LangString:    NewNamedNode(rdf + "langString"),
+		DirLangString: NewNamedNode(rdf + "dirLangString"),
+	},
 	/*
-		RDF: RDFTerms{
-			Type:       NewNamedNode(rdf + "type"),
-			Nil:        NewNamedNode(rdf + "nil"),
-			First:      NewNamedNode(rdf + "first"),
-			Rest:       NewNamedNode(rdf + "rest"),
-			LangString: NewNamedNode(rdf + "langString"),
-		},
 		OWL: OWLTerms{
 			SameAs: NewNamedNode("http://www.w3.org/2002/07/owl#sameAs"),
 		},
@@ -1,34 +1,93 @@
 package rdfgo
 
 import (
+	"errors"
 	"fmt"
 	"github.com/maartyman/rdfgo/interfaces"
+	langtag "github.com/maartyman/rdfgo/lib/langtag"
+	"math"
+	"strconv"
+	"strings"
 )
 
+var InvalidDecimalError = errors.New("xsd:decimal has no NaN or infinite values")
+
 type Literal struct {
-	value    string
-	language string
-	datatype interfaces.INamedNode
+	value     string
+	language  string
+	direction string
+	datatype  interfaces.INamedNode
 }
 
+// NewLiteral creates a literal. A language can carry a base direction after
+// two hyphens, like en--ltr. A literal with a language gets the datatype
+// rdf:langString, or rdf:dirLangString with a direction, whatever datatype
+// is given, and its language is normalized to the case of BCP 47.
 func NewLiteral(value string, language string, datatype interfaces.INamedNode) interfaces.ILiteral {
+	language, direction := langtag.SplitDirection(language)
+	if language == "" {
+		direction = ""
+	} else {
+		language = langtag.Normalize(language)
+		datatype = IRI.RDF.LangString
+		if direction != "" {
+			datatype = IRI.RDF.DirLangString
+		}
+	}
 	return &Literal{
-		value:    value,
-		language: language,
-		datatype: datatype,
+		value:     value,
+		language:  language,
+		direction: direction,
+		datatype:  datatype,
 	}
 }
 
+// NewStringLiteral creates an xsd:string, or a language-tagged string when
+// language is not empty.
 func NewStringLiteral(value string, language string) interfaces.ILiteral {
 	return NewLiteral(value, language, IRI.XSD.String)
 }
 
+// NewStrictStringLiteral is NewStringLiteral for languages that have to be
+// well-formed BCP 47 tags with an optional direction of ltr or rtl. It fails
+// with a langtag.InvalidTagError or langtag.InvalidDirectionError otherwise.
+func NewStrictStringLiteral(value string, language string) (interfaces.ILiteral, error) {
+	tag, direction := langtag.SplitDirection(language)
+	if language != "" {
+		if err := langtag.Validate(tag); err != nil {
+			return nil, err
+		}
+	}
+	if strings.Contains(language, "--") {
+		if err := langtag.ValidateDirection(direction); err != nil {
+			return nil, err
+		}
+	}
+	return NewStringLiteral(value, language), nil
+}
+
 func NewIntegerLiteral(value int) interfaces.ILiteral {
 	return NewLiteral(fmt.Sprintf("%d", value), "", IRI.XSD.Integer)
 }
 
+// NewDecimalLiteral writes value without an exponent, which xsd:decimal does
+// not allow, and with at least one digit after the decimal point. NaN and
+// infinite values give a literal that is not a valid xsd:decimal.
 func NewDecimalLiteral(value float64) interfaces.ILiteral {
-	return NewLiteral(fmt.Sprintf("%g", value), "", IRI.XSD.Decimal)
+	lexical := strconv.FormatFloat(value, 'f', -1, 64)
+	if !math.IsNaN(value) && !math.IsInf(value, 0) && !strings.Contains(lexical, ".") {
+		lexical += ".0"
+	}
+	return NewLiteral(lexical, "", IRI.XSD.Decimal)
+}
+
+// NewStrictDecimalLiteral is NewDecimalLiteral for values that have to be
+// finite. It fails with an InvalidDecimalError otherwise.
+func NewStrictDecimalLiteral(value float64) (interfaces.ILiteral, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, fmt.Errorf("%w: %v", InvalidDecimalError, value)
+	}
+	return NewDecimalLiteral(value), nil
 }
 
 func NewDoubleLiteral(value float64) interfaces.ILiteral {
@@ -50,7 +109,7 @@ func (l *Literal) Equals(other interfaces.ITerm) bool {
 	if !ok || interfaces.LiteralType != other.GetType() {
 		return false
 	}
-	return l.value == literal.GetValue() && l.language == literal.GetLanguage() &&
+	return l.value == literal.GetValue() && strings.EqualFold(l.language, literal.GetLanguage()) && l.direction == literal.GetDirection() &&
 		((l.datatype == nil && literal.GetDatatype() == nil) ||
 			(l.datatype != nil && l.datatype.Equals(literal.GetDatatype())))
 }
@@ -67,19 +126,24 @@ func (l *Literal) GetLanguage() string {
 	return l.language
 }
 
+// GetDirection returns the base direction of a directional language-tagged
+// string, ltr or rtl, or an empty string.
+func (l *Literal) GetDirection() string {
+	return l.direction
+}
+
 func (l *Literal) GetDatatype() interfaces.INamedNode {
 	return l.datatype
 }
 
 func (l *Literal) ToString() string {
-	languageString := ""
 	if l.language != "" {
-		languageString = fmt.Sprintf("@%s", l.language)
+		return fmt.Sprintf("\"%s\"@%s", l.value, langtag.JoinDirection(l.language, l.direction))
 	}
 	dataTypeString := ""
 	if l.datatype != nil {
 		dataTypeString = fmt.Sprintf("^^%s", l.datatype.ToString())
 
 	}
-	return fmt.Sprintf("\"%s\"%s%s", l.value, languageString, dataTypeString)
+	return fmt.Sprintf("\"%s\"%s", l.value, dataTypeString)
 }
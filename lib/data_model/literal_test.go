@@ -1,8 +1,13 @@
 package rdfgo
 
 import (
+	"errors"
 	"github.com/maartyman/rdfgo/interfaces"
+	"math"
+	"strconv"
 	"testing"
+
+	langtag "github.com/maartyman/rdfgo/lib/langtag"
 )
 
 func TestLiteralCreation(t *testing.T) {
@@ -18,13 +23,27 @@ func TestLiteralCreation(t *testing.T) {
 			literal:          NewLiteral("l1", "en", NewNamedNode("http://example.com")),
 			expectedValue:    "l1",
 			expectedLang:     "en",
-			expectedDatatype: NewNamedNode("http://example.com"),
+			expectedDatatype: IRI.RDF.LangString,
 		},
 		{
 			name:             "NewStringLiteral with language",
-			literal:          NewStringLiteral("l1", "en"),
+			literal:          NewStringLiteral("l1", "EN-us"),
 			expectedValue:    "l1",
-			expectedLang:     "en",
+			expectedLang:     "en-US",
+			expectedDatatype: IRI.RDF.LangString,
+		},
+		{
+			name:             "NewStringLiteral with direction",
+			literal:          NewStringLiteral("l1", "ar--rtl"),
+			expectedValue:    "l1",
+			expectedLang:     "ar",
+			expectedDatatype: IRI.RDF.DirLangString,
+		},
+		{
+			name:             "NewStringLiteral without language",
+			literal:          NewStringLiteral("l1", ""),
+			expectedValue:    "l1",
+			expectedLang:     "",
 			expectedDatatype: IRI.XSD.String,
 		},
 		{
@@ -41,6 +60,13 @@ func TestLiteralCreation(t *testing.T) {
 			expectedLang:     "",
 			expectedDatatype: IRI.XSD.Decimal,
 		},
+		{
+			name:             "NewDecimalLiteral without exponent",
+			literal:          NewDecimalLiteral(1e6),
+			expectedValue:    "1000000.0",
+			expectedLang:     "",
+			expectedDatatype: IRI.XSD.Decimal,
+		},
 		{
 			name:             "NewDoubleLiteral",
 			literal:          NewDoubleLiteral(123.456),
@@ -76,6 +102,21 @@ func TestLiteralCreation(t *testing.T) {
 	}
 }
 
+func TestLiteral_NewStrictDecimalLiteral(t *testing.T) {
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := NewStrictDecimalLiteral(value); !errors.Is(err, InvalidDecimalError) {
+			t.Errorf("Expected InvalidDecimalError for %v, got %v", value, err)
+		}
+		if literal := NewDecimalLiteral(value); literal.GetValue() != strconv.FormatFloat(value, 'f', -1, 64) {
+			t.Errorf("Expected NewDecimalLiteral to keep %v, got %s", value, literal.GetValue())
+		}
+	}
+	literal, err := NewStrictDecimalLiteral(2)
+	if err != nil || literal.GetValue() != "2.0" {
+		t.Errorf("Expected 2.0, got %v and %v", literal, err)
+	}
+}
+
 func TestLiteral_GetType(t *testing.T) {
 	l1 := NewLiteral("l1", "en", NewNamedNode("http://example.com"))
 	if l1.GetType() != interfaces.LiteralType {
@@ -91,7 +132,7 @@ func TestLiteral_GetValue(t *testing.T) {
 }
 
 func TestLiteral_GetDatatype(t *testing.T) {
-	l1 := NewLiteral("l1", "en", NewNamedNode("http://example.com"))
+	l1 := NewLiteral("l1", "", NewNamedNode("http://example.com"))
 	if !l1.GetDatatype().Equals(NewNamedNode("http://example.com")) {
 		t.Errorf("Literal datatype should equal <http://example.com>")
 	}
@@ -133,6 +174,37 @@ func TestLiteral_Equals(t *testing.T) {
 	if l5.Equals(l7) {
 		t.Errorf("Literal should not equal NamedNodes with same value")
 	}
+	if !NewStringLiteral("l1", "en-us").Equals(&Literal{value: "l1", language: "EN-US", datatype: IRI.RDF.LangString}) {
+		t.Errorf("Literal should equal another Literal with a language in another case")
+	}
+	if NewStringLiteral("l1", "en--ltr").Equals(NewStringLiteral("l1", "en--rtl")) || NewStringLiteral("l1", "en--ltr").Equals(NewStringLiteral("l1", "en")) {
+		t.Errorf("Literal should not equal another Literal with a different direction")
+	}
+}
+
+func TestLiteral_GetDirection(t *testing.T) {
+	if direction := NewStringLiteral("l1", "ar--rtl").GetDirection(); direction != "rtl" {
+		t.Errorf("Literal direction should be rtl, but got %s", direction)
+	}
+	if direction := NewStringLiteral("l1", "--ltr").GetDirection(); direction != "" {
+		t.Errorf("Literal without language should have no direction, but got %s", direction)
+	}
+}
+
+func TestLiteral_NewStrictStringLiteral(t *testing.T) {
+	for _, language := range []string{"", "en", "zh-Hant-TW", "en--ltr", "i-klingon"} {
+		if _, err := NewStrictStringLiteral("l1", language); err != nil {
+			t.Errorf("Language %q should be valid, but got %v", language, err)
+		}
+	}
+	for _, language := range []string{"e", "en-", "en-US-a", "--ltr"} {
+		if _, err := NewStrictStringLiteral("l1", language); !errors.Is(err, langtag.InvalidTagError) {
+			t.Errorf("Language %q should be invalid, but got %v", language, err)
+		}
+	}
+	if _, err := NewStrictStringLiteral("l1", "en--up"); !errors.Is(err, langtag.InvalidDirectionError) {
+		t.Errorf("Direction up should be invalid, but got %v", err)
+	}
 }
 
 func TestLiteral_EqualsNil(t *testing.T) {
@@ -164,7 +236,7 @@ func TestLiteralToString(t *testing.T) {
 				language: "en",
 				datatype: NewNamedNode("http://example.com/datatype"),
 			},
-			expected: "\"example\"@en^^<http://example.com/datatype>",
+			expected: "\"example\"@en",
 		},
 		{
 			name: "With different datatype",
@@ -193,6 +265,16 @@ func TestLiteralToString(t *testing.T) {
 			},
 			expected: "\"\"@es",
 		},
+		{
+			name: "With direction",
+			literal: Literal{
+				value:     "example",
+				language:  "en",
+				direction: "ltr",
+				datatype:  IRI.RDF.DirLangString,
+			},
+			expected: "\"example\"@en--ltr",
+		},
 	}
 
 	for _, tt := range tests {
@@ -3,6 +3,7 @@ package rdfgo
 import (
 	"fmt"
 	"github.com/maartyman/rdfgo/interfaces"
+	rdfiri "github.com/maartyman/rdfgo/lib/iri"
 )
 
 type NamedNode struct {
@@ -10,6 +11,12 @@ type NamedNode struct {
 }
 
 func NewNamedNode(value string) interfaces.INamedNode {
+	return &NamedNode{
+		value: namedNodeValue(value),
+	}
+}
+
+func namedNodeValue(value string) string {
 	if len(value) > 0 {
 		if value[0] == '<' {
 			value = value[1:]
@@ -20,15 +27,27 @@ func NewNamedNode(value string) interfaces.INamedNode {
 			value = value[:len(value)-1]
 		}
 	}
-	return &NamedNode{
-		value: value,
+	return value
+}
+
+// NewStrictNamedNode is NewNamedNode for IRIs that have to be absolute and
+// valid by RFC 3987. It fails with an rdfiri.InvalidIRIError or
+// rdfiri.RelativeIRIError otherwise.
+func NewStrictNamedNode(value string) (interfaces.INamedNode, error) {
+	node := NewNamedNode(value)
+	if err := rdfiri.Validate(node.GetValue()); err != nil {
+		return nil, err
 	}
+	return node, nil
 }
 
 func (n *NamedNode) Equals(other interfaces.ITerm) bool {
 	if other == nil {
 		return false
 	}
+	if n == other {
+		return true
+	}
 	return interfaces.NamedNodeType == other.GetType() && n.value == other.GetValue()
 }
 
@@ -1,8 +1,11 @@
 package rdfgo
 
 import (
+	"errors"
 	"github.com/maartyman/rdfgo/interfaces"
 	"testing"
+
+	rdfiri "github.com/maartyman/rdfgo/lib/iri"
 )
 
 func TestNamedNode_NewNamedNode(t *testing.T) {
@@ -58,3 +61,16 @@ func TestNamedNode_ToString(t *testing.T) {
 		t.Errorf("NameNode to string should equal <l1>")
 	}
 }
+
+func TestNamedNode_NewStrictNamedNode(t *testing.T) {
+	node, err := NewStrictNamedNode("<http://example.org/a>")
+	if err != nil || node.GetValue() != "http://example.org/a" {
+		t.Errorf("expected http://example.org/a, got %v, %v", node, err)
+	}
+	if _, err := NewStrictNamedNode("http://example.org/a b"); !errors.Is(err, rdfiri.InvalidIRIError) {
+		t.Errorf("expected an InvalidIRIError, got %v", err)
+	}
+	if _, err := NewStrictNamedNode("a"); !errors.Is(err, rdfiri.RelativeIRIError) {
+		t.Errorf("expected a RelativeIRIError, got %v", err)
+	}
+}
@@ -21,13 +21,13 @@ func utilTermIsWrong(
 		}
 	}
 	if q != nil {
-		t.Errorf("Quad should be nil if error: " + testErrorMessage)
+		t.Error("Quad should be nil if error: " + testErrorMessage)
 	}
 }
 
 func utilTermIsCorrect(t *testing.T, err error, q interfaces.IQuad, testErrorMessage string) {
 	if err != nil {
-		t.Errorf(testErrorMessage)
+		t.Error(testErrorMessage)
 	}
 	if q == nil {
 		t.Errorf("Quad should not be nil if no error")
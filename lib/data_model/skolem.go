@@ -0,0 +1,72 @@
+package rdfgo
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+// GenIDPath is the path under which RDF 1.1 places skolem IRIs.
+const GenIDPath = "/.well-known/genid/"
+
+// Skolemizer replaces blank nodes by skolem IRIs, base + GenIDPath + label,
+// and back.
+type Skolemizer struct {
+	prefix string
+}
+
+// NewSkolemizer returns a skolemizer for skolem IRIs on a base, such as
+// https://example.org.
+func NewSkolemizer(base string) *Skolemizer {
+	return &Skolemizer{prefix: strings.TrimSuffix(base, "/") + GenIDPath}
+}
+
+// Skolemize replaces the blank nodes of a term, also in a quoted quad, by
+// skolem IRIs.
+func (s *Skolemizer) Skolemize(term interfaces.ITerm) interfaces.ITerm {
+	return mapTerm(term, func(term interfaces.ITerm) interfaces.ITerm {
+		if term.GetType() == interfaces.BlankNodeType {
+			return NewNamedNode(s.prefix + url.PathEscape(term.GetValue()))
+		}
+		return term
+	})
+}
+
+func (s *Skolemizer) SkolemizeQuad(quad interfaces.IQuad) interfaces.IQuad {
+	return s.Skolemize(quad).(interfaces.IQuad)
+}
+
+// Deskolemize replaces the skolem IRIs of the skolemizer in a term, also in
+// a quoted quad, by the blank nodes they were made from. Skolem IRIs in the
+// predicate of a quad are kept, as a predicate can not be a blank node.
+func (s *Skolemizer) Deskolemize(term interfaces.ITerm) interfaces.ITerm {
+	return mapTerm(term, func(term interfaces.ITerm) interfaces.ITerm {
+		if label, ok := s.label(term); ok {
+			return &BlankNode{value: label}
+		}
+		return term
+	})
+}
+
+func (s *Skolemizer) DeskolemizeQuad(quad interfaces.IQuad) interfaces.IQuad {
+	return s.Deskolemize(quad).(interfaces.IQuad)
+}
+
+// IsSkolem reports whether a term is a skolem IRI of the skolemizer.
+func (s *Skolemizer) IsSkolem(term interfaces.ITerm) bool {
+	_, ok := s.label(term)
+	return ok
+}
+
+func (s *Skolemizer) label(term interfaces.ITerm) (string, bool) {
+	if term.GetType() != interfaces.NamedNodeType {
+		return "", false
+	}
+	escaped, ok := strings.CutPrefix(term.GetValue(), s.prefix)
+	if !ok || escaped == "" {
+		return "", false
+	}
+	label, err := url.PathUnescape(escaped)
+	return label, err == nil
+}
@@ -0,0 +1,38 @@
+package rdfgo
+
+import (
+	"testing"
+)
+
+func TestSkolemizer(t *testing.T) {
+	skolemizer := NewSkolemizer("https://example.org/")
+	p := NewNamedNode("http://example.com/p")
+	quoted, _ := NewQuad(NewBlankNode("a/b"), p, NewStringLiteral("o", ""), nil)
+	quad, _ := NewQuad(quoted, p, NewBlankNode("c"), NewBlankNode("g"))
+
+	skolemized := skolemizer.SkolemizeQuad(quad)
+	expectedQuoted, _ := NewQuad(NewNamedNode("https://example.org/.well-known/genid/a%2Fb"), p, NewStringLiteral("o", ""), nil)
+	expected, _ := NewQuad(
+		expectedQuoted, p,
+		NewNamedNode("https://example.org/.well-known/genid/c"),
+		NewNamedNode("https://example.org/.well-known/genid/g"),
+	)
+	if !skolemized.Equals(expected) {
+		t.Fatalf("Expected %s, got %s", expected.ToString(), skolemized.ToString())
+	}
+	if !skolemizer.IsSkolem(skolemized.GetObject()) || skolemizer.IsSkolem(p) || skolemizer.IsSkolem(NewBlankNode("c")) {
+		t.Errorf("Expected only the skolem IRIs to be skolem IRIs")
+	}
+	if deskolemized := skolemizer.DeskolemizeQuad(skolemized); !deskolemized.Equals(quad) {
+		t.Errorf("Expected %s, got %s", quad.ToString(), deskolemized.ToString())
+	}
+
+	skolemPredicate, _ := NewQuad(NewNamedNode("https://example.org/.well-known/genid/s"), NewNamedNode("https://example.org/.well-known/genid/p"), NewNamedNode("https://example.org/.well-known/genid/%zz"), nil)
+	deskolemized := skolemizer.DeskolemizeQuad(skolemPredicate)
+	if !deskolemized.GetSubject().Equals(NewBlankNode("s")) || !deskolemized.GetPredicate().Equals(skolemPredicate.GetPredicate()) || !deskolemized.GetObject().Equals(skolemPredicate.GetObject()) {
+		t.Errorf("Expected only the subject to be deskolemized, got %s", deskolemized.ToString())
+	}
+	if skolemizer.IsSkolem(NewNamedNode("https://example.org/.well-known/genid/")) || NewSkolemizer("https://other.org").IsSkolem(skolemized.GetObject()) {
+		t.Errorf("Expected IRIs of other bases and without a label not to be skolem IRIs")
+	}
+}
@@ -10,18 +10,25 @@ type Variable struct {
 }
 
 func NewVariable(value string) interfaces.IVariable {
+	return &Variable{
+		value: variableName(value),
+	}
+}
+
+func variableName(value string) string {
 	if value[0] == '?' {
 		value = value[1:]
 	}
-	return &Variable{
-		value: value,
-	}
+	return value
 }
 
 func (v *Variable) Equals(other interfaces.ITerm) bool {
 	if other == nil {
 		return false
 	}
+	if v == other {
+		return true
+	}
 	return interfaces.VariableType == other.GetType() && v.value == other.GetValue()
 }
 
@@ -0,0 +1,21 @@
+package rdfgo
+
+import (
+	"context"
+	"io"
+
+	stream "github.com/maartyman/rdfgo/lib/stream"
+)
+
+// BulkLoad parses a document straight into a store with Store.BulkLoad. The
+// document is parsed strictly, so it returns the first syntax error.
+func BulkLoad(
+	ctx context.Context,
+	store *stream.Store,
+	reader io.Reader,
+	mediaType string,
+	baseIRI string,
+	options ...stream.BulkLoadOptions,
+) (stream.BulkLoadProgress, error) {
+	return store.BulkLoad(ctx, ParseContext(ctx, reader, mediaType, baseIRI, ParseOptions{}), options...)
+}
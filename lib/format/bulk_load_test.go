@@ -0,0 +1,31 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/maartyman/rdfgo/lib/stream"
+)
+
+func TestBulkLoad(t *testing.T) {
+	store := NewStore().(*Store)
+	document := `<http://example.com/s> <http://example.com/p> "a" .
+<http://example.com/s> <http://example.com/p> "b" .
+<http://example.com/s> <http://example.com/p> "a" .
+`
+	progress, err := BulkLoad(context.Background(), store, strings.NewReader(document), NTriplesMediaType, "", BulkLoadOptions{BatchSize: 2})
+	if err != nil {
+		t.Fatalf("Expected the document to load, got %v", err)
+	}
+	if store.Size() != 2 || progress.Read != 3 || progress.Duplicates != 1 {
+		t.Errorf("Expected 2 quads and 1 duplicate, got %d and %+v", store.Size(), progress)
+	}
+
+	_, err = BulkLoad(context.Background(), store, strings.NewReader(document+"error\n"), NTriplesMediaType, "")
+	var parseError *ParseError
+	if !errors.As(err, &parseError) || parseError.Line != 4 {
+		t.Errorf("Expected a syntax error on line 4, got %v", err)
+	}
+}
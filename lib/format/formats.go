@@ -0,0 +1,95 @@
+package rdfgo
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	hdt "github.com/maartyman/rdfgo/lib/hdt"
+)
+
+const (
+	NTriplesMediaType = "application/n-triples"
+	NQuadsMediaType   = "application/n-quads"
+	TurtleMediaType   = "text/turtle"
+	TriGMediaType     = "application/trig"
+	HDTMediaType      = "application/vnd.hdt"
+	JSONLDMediaType   = "application/ld+json"
+)
+
+func builtinFormats() []Format {
+	return []Format{
+		{
+			Name:       "Turtle",
+			MediaTypes: []string{TurtleMediaType, "application/x-turtle"},
+			Extensions: []string{".ttl"},
+			Parser:     &turtleParser{syntax: turtleSyntax},
+			Serializer: &turtleSerializer{syntax: turtleSyntax},
+			Sniff:      sniffTurtle,
+		},
+		{
+			Name:       "TriG",
+			MediaTypes: []string{TriGMediaType, "application/x-trig"},
+			Extensions: []string{".trig"},
+			Parser:     &turtleParser{syntax: triGSyntax},
+			Serializer: &turtleSerializer{syntax: triGSyntax},
+			Sniff:      sniffTriG,
+		},
+		{
+			Name:       "N-Quads",
+			MediaTypes: []string{NQuadsMediaType, "text/x-nquads"},
+			Extensions: []string{".nq"},
+			Parser:     &turtleParser{syntax: nQuadsSyntax},
+			Serializer: &nQuadsSerializer{syntax: nQuadsSyntax},
+			Sniff:      sniffNQuads,
+		},
+		{
+			Name:       "N-Triples",
+			MediaTypes: []string{NTriplesMediaType},
+			Extensions: []string{".nt"},
+			Parser:     &turtleParser{syntax: nTriplesSyntax},
+			Serializer: &nQuadsSerializer{syntax: nTriplesSyntax},
+			Sniff:      sniffNTriples,
+		},
+		{
+			Name:       "JSON-LD",
+			MediaTypes: []string{JSONLDMediaType},
+			Extensions: []string{".jsonld"},
+			Parser:     &jsonldParser{},
+			Serializer: &jsonldSerializer{},
+			Sniff:      sniffJSONLD,
+		},
+		{
+			Name:       "HDT",
+			MediaTypes: []string{HDTMediaType},
+			Extensions: []string{".hdt"},
+			Parser:     &hdtParser{},
+			Serializer: &hdtSerializer{},
+			Sniff:      sniffHDT,
+		},
+	}
+}
+
+type hdtParser struct{}
+
+func (p *hdtParser) Parse(reader io.Reader, _ string) (interfaces.IStream, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	file, err := hdt.Load(data)
+	if err != nil {
+		return nil, err
+	}
+	return file.Match(nil, nil, nil, nil), nil
+}
+
+type hdtSerializer struct{}
+
+func (s *hdtSerializer) Serialize(writer io.Writer, stream interfaces.IStream, _ map[string]string) error {
+	return hdt.Write(writer, stream, "")
+}
+
+func sniffHDT(head []byte) bool {
+	return bytes.HasPrefix(head, []byte("$HDT"))
+}
@@ -0,0 +1,1084 @@
+package rdfgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	rdfiri "github.com/maartyman/rdfgo/lib/iri"
+	langtag "github.com/maartyman/rdfgo/lib/langtag"
+	stream "github.com/maartyman/rdfgo/lib/stream"
+)
+
+var JSONLDError = errors.New("invalid JSON-LD")
+
+// errJSONLDStopped ends the conversion of a document when the consumer of
+// the stream stops.
+var errJSONLDStopped = errors.New("stopped")
+
+const (
+	xsdNamespace = "http://www.w3.org/2001/XMLSchema#"
+	xsdInteger   = xsdNamespace + "integer"
+	xsdDouble    = xsdNamespace + "double"
+	xsdBoolean   = xsdNamespace + "boolean"
+	rdfJSON      = rdfNamespace + "JSON"
+)
+
+// jsonldContext is an active context of the JSON-LD 1.1 processing
+// algorithms.
+type jsonldContext struct {
+	base      string
+	vocab     string
+	language  string
+	direction string
+	terms     map[string]*jsonldTerm
+}
+
+// jsonldTerm is a term definition. A term with an empty id maps to null.
+type jsonldTerm struct {
+	id          string
+	reverse     bool
+	prefix      bool
+	typeMapping string
+	containers  map[string]bool
+	language    *string
+	direction   *string
+	context     any
+	hasContext  bool
+}
+
+func (c *jsonldContext) clone() *jsonldContext {
+	clone := *c
+	clone.terms = maps.Clone(c.terms)
+	return &clone
+}
+
+func (c *jsonldContext) term(key string) *jsonldTerm {
+	if term, ok := c.terms[key]; ok {
+		return term
+	}
+	return &jsonldTerm{}
+}
+
+// jsonldParser converts JSON-LD 1.1 documents to RDF, by the deserialization
+// algorithm of JSON-LD 1.1 without an expanded document in between. Contexts
+// have to be embedded in the document: remote contexts and @import are not
+// loaded and fail with JSONLDError.
+type jsonldParser struct{}
+
+func (p *jsonldParser) Parse(reader io.Reader, baseIRI string) (interfaces.IStream, error) {
+	return p.parse(context.Background(), reader, baseIRI, ParseOptions{}).ToIStream(), nil
+}
+
+// ParseContext parses a document like Parse. As a JSON-LD document is read as
+// a whole, the error mode of options is not used.
+func (p *jsonldParser) ParseContext(
+	ctx context.Context,
+	reader io.Reader,
+	baseIRI string,
+	options ParseOptions,
+) interfaces.IQuadStream {
+	return p.parse(ctx, reader, baseIRI, options)
+}
+
+func (p *jsonldParser) parse(
+	ctx context.Context,
+	reader io.Reader,
+	baseIRI string,
+	options ParseOptions,
+) *stream.QuadStream {
+	return stream.NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		decoder := json.NewDecoder(reader)
+		decoder.UseNumber()
+		var document any
+		if err := decoder.Decode(&document); err != nil {
+			return fmt.Errorf("%w: %v", JSONLDError, err)
+		}
+		if _, err := decoder.Token(); err != io.EOF {
+			return fmt.Errorf("%w: data after the document", JSONLDError)
+		}
+		state := &jsonldState{base: baseIRI, options: options, emit: emit}
+		active := &jsonldContext{base: baseIRI, terms: make(map[string]*jsonldTerm)}
+		err := state.parseElements(active, document, NewDefaultGraph(), true)
+		if errors.Is(err, errJSONLDStopped) {
+			return ctx.Err()
+		}
+		return err
+	})
+}
+
+type jsonldState struct {
+	base    string
+	options ParseOptions
+	emit    func(interfaces.IQuad) bool
+}
+
+func (s *jsonldState) processContext(active *jsonldContext, local any) (*jsonldContext, error) {
+	result := active.clone()
+	contexts, ok := local.([]any)
+	if !ok {
+		contexts = []any{local}
+	}
+	for _, context := range contexts {
+		switch context := context.(type) {
+		case nil:
+			result = &jsonldContext{base: s.base, terms: make(map[string]*jsonldTerm)}
+		case string:
+			return nil, fmt.Errorf("%w: remote context %q is not supported", JSONLDError, context)
+		case map[string]any:
+			if err := s.defineContext(result, context); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("%w: invalid local context", JSONLDError)
+		}
+	}
+	return result, nil
+}
+
+func (s *jsonldState) defineContext(active *jsonldContext, context map[string]any) error {
+	if _, ok := context["@import"]; ok {
+		return fmt.Errorf("%w: @import is not supported", JSONLDError)
+	}
+	if base, ok := context["@base"]; ok {
+		switch base := base.(type) {
+		case nil:
+			active.base = ""
+		case string:
+			if !hasScheme(base) && active.base == "" {
+				return fmt.Errorf("%w: relative @base %q without a base IRI", JSONLDError, base)
+			}
+			active.base = resolveJSONLD(active.base, base)
+		default:
+			return fmt.Errorf("%w: invalid @base", JSONLDError)
+		}
+	}
+	if vocab, ok := context["@vocab"]; ok {
+		switch vocab := vocab.(type) {
+		case nil:
+			active.vocab = ""
+		case string:
+			expanded, err := s.expandIRI(active, vocab, true, true, context, map[string]bool{})
+			if err != nil {
+				return err
+			}
+			active.vocab = expanded
+		default:
+			return fmt.Errorf("%w: invalid @vocab", JSONLDError)
+		}
+	}
+	if language, ok := context["@language"]; ok {
+		switch language := language.(type) {
+		case nil:
+			active.language = ""
+		case string:
+			active.language = language
+		default:
+			return fmt.Errorf("%w: invalid @language", JSONLDError)
+		}
+	}
+	if direction, ok := context["@direction"]; ok {
+		value, err := jsonldDirection(direction)
+		if err != nil {
+			return err
+		}
+		active.direction = value
+	}
+
+	defined := make(map[string]bool)
+	for _, term := range slices.Sorted(maps.Keys(context)) {
+		if !strings.HasPrefix(term, "@") {
+			if err := s.defineTerm(active, context, term, defined); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// defineTerm creates the definition of a term of a local context. defined
+// holds false for the terms that are being defined, to detect cycles.
+func (s *jsonldState) defineTerm(active *jsonldContext, local map[string]any, term string, defined map[string]bool) error {
+	if done, ok := defined[term]; ok {
+		if !done {
+			return fmt.Errorf("%w: cyclic definition of %q", JSONLDError, term)
+		}
+		return nil
+	}
+	defined[term] = false
+	definition := &jsonldTerm{}
+	var object map[string]any
+	simple := false
+	switch value := local[term].(type) {
+	case nil:
+		active.terms[term] = definition
+		defined[term] = true
+		return nil
+	case string:
+		object, simple = map[string]any{"@id": value}, true
+	case map[string]any:
+		object = value
+	default:
+		return fmt.Errorf("%w: invalid definition of %q", JSONLDError, term)
+	}
+
+	if reverse, ok := object["@reverse"]; ok {
+		value, ok := reverse.(string)
+		if !ok {
+			return fmt.Errorf("%w: invalid @reverse of %q", JSONLDError, term)
+		}
+		id, err := s.expandIRI(active, value, false, true, local, defined)
+		if err != nil {
+			return err
+		}
+		definition.id, definition.reverse = id, true
+	} else if id, ok := object["@id"]; ok {
+		switch id := id.(type) {
+		case nil:
+		case string:
+			expanded, err := s.expandIRI(active, id, false, true, local, defined)
+			if err != nil {
+				return err
+			}
+			definition.id = expanded
+			definition.prefix = simple && expanded != "" && !strings.ContainsAny(term, ":/") &&
+				(strings.HasPrefix(expanded, "_:") || strings.ContainsAny(expanded[len(expanded)-1:], ":/?#[]@"))
+		default:
+			return fmt.Errorf("%w: invalid @id of %q", JSONLDError, term)
+		}
+	} else if prefix, suffix, ok := strings.Cut(term, ":"); ok {
+		// A compact IRI is expanded by its prefix only, as the term itself is
+		// being defined.
+		definition.id = term
+		if prefix != "_" && !strings.HasPrefix(suffix, "//") {
+			expanded, err := s.expandIRI(active, prefix+":", false, true, local, defined)
+			if err != nil {
+				return err
+			}
+			definition.id = expanded + suffix
+		}
+	} else if active.vocab != "" {
+		definition.id = active.vocab + term
+	} else {
+		return fmt.Errorf("%w: %q has no IRI", JSONLDError, term)
+	}
+
+	if prefix, ok := object["@prefix"]; ok {
+		value, ok := prefix.(bool)
+		if !ok {
+			return fmt.Errorf("%w: invalid @prefix of %q", JSONLDError, term)
+		}
+		definition.prefix = value
+	}
+	if typeMapping, ok := object["@type"]; ok {
+		value, ok := typeMapping.(string)
+		if !ok {
+			return fmt.Errorf("%w: invalid @type of %q", JSONLDError, term)
+		}
+		expanded, err := s.expandIRI(active, value, false, true, local, defined)
+		if err != nil {
+			return err
+		}
+		definition.typeMapping = expanded
+	}
+	if container, ok := object["@container"]; ok {
+		definition.containers = make(map[string]bool)
+		values, ok := container.([]any)
+		if !ok {
+			values = []any{container}
+		}
+		for _, value := range values {
+			name, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("%w: invalid @container of %q", JSONLDError, term)
+			}
+			definition.containers[name] = true
+		}
+	}
+	if language, ok := object["@language"]; ok {
+		value, ok := language.(string)
+		if !ok && language != nil {
+			return fmt.Errorf("%w: invalid @language of %q", JSONLDError, term)
+		}
+		definition.language = &value
+	}
+	if direction, ok := object["@direction"]; ok {
+		value, err := jsonldDirection(direction)
+		if err != nil {
+			return err
+		}
+		definition.direction = &value
+	}
+	if context, ok := object["@context"]; ok {
+		definition.context, definition.hasContext = context, true
+	}
+	active.terms[term] = definition
+	defined[term] = true
+	return nil
+}
+
+func jsonldDirection(direction any) (string, error) {
+	switch direction {
+	case nil:
+		return "", nil
+	case langtag.LeftToRight, langtag.RightToLeft:
+		return direction.(string), nil
+	}
+	return "", fmt.Errorf("%w: invalid @direction %v", JSONLDError, direction)
+}
+
+// expandIRI expands a term, compact IRI or relative IRI. While a local
+// context is processed, local and defined are used to define the terms the
+// value depends on first.
+func (s *jsonldState) expandIRI(
+	active *jsonldContext,
+	value string,
+	documentRelative bool,
+	vocab bool,
+	local map[string]any,
+	defined map[string]bool,
+) (string, error) {
+	if strings.HasPrefix(value, "@") {
+		return value, nil
+	}
+	define := func(term string) error {
+		if _, ok := local[term]; ok && !defined[term] {
+			return s.defineTerm(active, local, term, defined)
+		}
+		return nil
+	}
+	if err := define(value); err != nil {
+		return "", err
+	}
+	if term, ok := active.terms[value]; ok && vocab {
+		return term.id, nil
+	}
+	if prefix, suffix, ok := strings.Cut(value, ":"); ok {
+		if prefix == "_" || strings.HasPrefix(suffix, "//") {
+			return value, nil
+		}
+		if err := define(prefix); err != nil {
+			return "", err
+		}
+		if term, ok := active.terms[prefix]; ok && term.id != "" && term.prefix {
+			return term.id + suffix, nil
+		}
+		if hasScheme(value) {
+			return value, nil
+		}
+	}
+	if vocab && active.vocab != "" {
+		return active.vocab + value, nil
+	}
+	if documentRelative {
+		return resolveJSONLD(active.base, value), nil
+	}
+	return value, nil
+}
+
+func resolveJSONLD(base string, iri string) string {
+	if base == "" || hasScheme(iri) {
+		return iri
+	}
+	resolved, err := rdfiri.Resolve(base, iri)
+	if err != nil {
+		return iri
+	}
+	return resolved
+}
+
+// keyword returns the keyword a key of an object is or is an alias of, or
+// the empty string.
+func (s *jsonldState) keyword(active *jsonldContext, key string) string {
+	if strings.HasPrefix(key, "@") {
+		return key
+	}
+	if term, ok := active.terms[key]; ok && strings.HasPrefix(term.id, "@") {
+		return term.id
+	}
+	return ""
+}
+
+// parseElements converts a node object, or an array of them, in graph.
+func (s *jsonldState) parseElements(active *jsonldContext, value any, graph interfaces.ITerm, top bool) error {
+	switch value := value.(type) {
+	case []any:
+		for _, element := range value {
+			if err := s.parseElements(active, element, graph, top); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		_, err := s.parseNode(active, value, graph, top)
+		return err
+	}
+	return nil
+}
+
+// parseNode converts a node object and returns its subject. A top-level
+// object with only a @graph, and a @context, is not a node: the nodes of its
+// graph are in the default graph, and it returns nil.
+func (s *jsonldState) parseNode(active *jsonldContext, object map[string]any, graph interfaces.ITerm, top bool) (interfaces.ITerm, error) {
+	if local, ok := object["@context"]; ok {
+		var err error
+		if active, err = s.processContext(active, local); err != nil {
+			return nil, err
+		}
+	}
+	keys := slices.Sorted(maps.Keys(object))
+
+	// The contexts of the types of a node apply to its properties, but not
+	// to the nodes nested in it.
+	nodeContext := active
+	var subject interfaces.ITerm
+	for _, key := range keys {
+		switch s.keyword(active, key) {
+		case "@type":
+			for _, value := range jsonldStrings(object[key]) {
+				if term := active.term(value); term.hasContext {
+					var err error
+					if nodeContext, err = s.processContext(nodeContext, term.context); err != nil {
+						return nil, err
+					}
+				}
+			}
+		case "@id":
+			id, ok := object[key].(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: invalid @id", JSONLDError)
+			}
+			subject = s.nodeTerm(active, id)
+		}
+	}
+	if subject == nil {
+		subject = s.blankNode("")
+	}
+
+	var graphValue any
+	hasGraph, onlyGraph := false, true
+	for _, key := range keys {
+		switch s.keyword(active, key) {
+		case "@graph":
+			graphValue, hasGraph = object[key], true
+		case "@context":
+		default:
+			onlyGraph = false
+		}
+	}
+	if hasGraph && top && onlyGraph {
+		return nil, s.parseElements(active, graphValue, graph, false)
+	}
+	if err := s.parseProperties(nodeContext, active, object, subject, graph); err != nil {
+		return nil, err
+	}
+	if hasGraph {
+		if err := s.parseElements(active, graphValue, subject, false); err != nil {
+			return nil, err
+		}
+	}
+	return subject, nil
+}
+
+// parseProperties converts the properties of a node object. outer is the
+// context for the nodes nested in it.
+func (s *jsonldState) parseProperties(
+	active *jsonldContext,
+	outer *jsonldContext,
+	object map[string]any,
+	subject interfaces.ITerm,
+	graph interfaces.ITerm,
+) error {
+	for _, key := range slices.Sorted(maps.Keys(object)) {
+		value := object[key]
+		switch s.keyword(active, key) {
+		case "@context", "@id", "@index", "@graph":
+		case "@type":
+			for _, value := range jsonldStrings(value) {
+				iri, _ := s.expandIRI(active, value, true, true, nil, nil)
+				if err := s.emitQuad(subject, rdfType, s.nodeTerm(active, iri), graph); err != nil {
+					return err
+				}
+			}
+		case "@reverse":
+			properties, ok := value.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%w: invalid @reverse", JSONLDError)
+			}
+			for _, property := range slices.Sorted(maps.Keys(properties)) {
+				if err := s.parseProperty(active, outer, property, properties[property], subject, graph, true); err != nil {
+					return err
+				}
+			}
+		case "@included":
+			if err := s.parseElements(outer, value, graph, false); err != nil {
+				return err
+			}
+		case "@nest":
+			for _, nested := range jsonldArray(value) {
+				nested, ok := nested.(map[string]any)
+				if !ok {
+					return fmt.Errorf("%w: invalid @nest", JSONLDError)
+				}
+				if err := s.parseProperties(active, outer, nested, subject, graph); err != nil {
+					return err
+				}
+			}
+		case "":
+			if err := s.parseProperty(active, outer, key, value, subject, graph, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *jsonldState) parseProperty(
+	active *jsonldContext,
+	outer *jsonldContext,
+	key string,
+	value any,
+	subject interfaces.ITerm,
+	graph interfaces.ITerm,
+	reverse bool,
+) error {
+	iri, _ := s.expandIRI(active, key, false, true, nil, nil)
+	if iri == "" || strings.HasPrefix(iri, "@") {
+		return nil
+	}
+	term := active.term(key)
+	objects, err := s.parseValues(active, outer, term, value, graph)
+	if err != nil {
+		return err
+	}
+	predicate := s.nodeTerm(active, iri)
+	for _, object := range objects {
+		if reverse != term.reverse {
+			err = s.emitQuad(object, predicate, subject, graph)
+		} else {
+			err = s.emitQuad(subject, predicate, object, graph)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseValues converts the value of a property by the containers of its term.
+func (s *jsonldState) parseValues(
+	active *jsonldContext,
+	outer *jsonldContext,
+	term *jsonldTerm,
+	value any,
+	graph interfaces.ITerm,
+) ([]interfaces.ITerm, error) {
+	if term.hasContext {
+		var err error
+		if outer, err = s.processContext(active, term.context); err != nil {
+			return nil, err
+		}
+	}
+	if object, ok := value.(map[string]any); ok {
+		for _, container := range []string{"@language", "@index", "@id", "@type"} {
+			if term.containers[container] {
+				return s.parseMap(active, outer, term, container, object, graph)
+			}
+		}
+	}
+	switch {
+	case term.containers["@list"]:
+		head, err := s.parseList(active, outer, term, jsonldArray(value), graph)
+		return []interfaces.ITerm{head}, err
+	case term.containers["@graph"]:
+		var names []interfaces.ITerm
+		for _, element := range jsonldArray(value) {
+			name := s.blankNode("")
+			if err := s.parseElements(outer, element, name, false); err != nil {
+				return nil, err
+			}
+			names = append(names, name)
+		}
+		return names, nil
+	}
+	return s.parseValue(active, outer, term, value, graph)
+}
+
+// parseMap converts the object of a language, index, id or type map.
+func (s *jsonldState) parseMap(
+	active *jsonldContext,
+	outer *jsonldContext,
+	term *jsonldTerm,
+	container string,
+	object map[string]any,
+	graph interfaces.ITerm,
+) ([]interfaces.ITerm, error) {
+	var objects []interfaces.ITerm
+	for _, key := range slices.Sorted(maps.Keys(object)) {
+		none := key == "@none" || s.keyword(active, key) == "@none"
+		for _, element := range jsonldArray(object[key]) {
+			var values []interfaces.ITerm
+			var err error
+			switch container {
+			case "@language":
+				text, ok := element.(string)
+				if !ok {
+					if element == nil {
+						continue
+					}
+					return nil, fmt.Errorf("%w: invalid value in a language map", JSONLDError)
+				}
+				language := key
+				if none {
+					language = ""
+				}
+				values = []interfaces.ITerm{s.languageLiteral(text, language, s.termDirection(active, term))}
+			case "@index":
+				values, err = s.parseValue(active, outer, term, element, graph)
+			default:
+				node, ok := element.(map[string]any)
+				if !ok {
+					id, isString := element.(string)
+					if container != "@type" || !isString {
+						return nil, fmt.Errorf("%w: invalid value in a %s map", JSONLDError, container)
+					}
+					node = map[string]any{"@id": id}
+				}
+				if !none {
+					node = maps.Clone(node)
+					if container == "@id" {
+						node["@id"] = key
+					} else {
+						node["@type"] = append(jsonldArray(node["@type"]), key)
+					}
+				}
+				values, err = s.parseValue(active, outer, term, node, graph)
+			}
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, values...)
+		}
+	}
+	return objects, nil
+}
+
+func (s *jsonldState) parseList(
+	active *jsonldContext,
+	outer *jsonldContext,
+	term *jsonldTerm,
+	elements []any,
+	graph interfaces.ITerm,
+) (interfaces.ITerm, error) {
+	var items []interfaces.ITerm
+	for _, element := range elements {
+		if nested, ok := element.([]any); ok {
+			head, err := s.parseList(active, outer, term, nested, graph)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, head)
+			continue
+		}
+		values, err := s.parseValue(active, outer, term, element, graph)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, values...)
+	}
+	if len(items) == 0 {
+		return rdfNil, nil
+	}
+	head := s.blankNode("")
+	node := head
+	for i, item := range items {
+		var next interfaces.ITerm = rdfNil
+		if i < len(items)-1 {
+			next = s.blankNode("")
+		}
+		if err := s.emitQuad(node, rdfFirst, item, graph); err != nil {
+			return nil, err
+		}
+		if err := s.emitQuad(node, rdfRest, next, graph); err != nil {
+			return nil, err
+		}
+		node = next
+	}
+	return head, nil
+}
+
+// parseValue converts a value that is not in a container: a string, number
+// or boolean, a value, list or set object, a node object, or an array.
+func (s *jsonldState) parseValue(
+	active *jsonldContext,
+	outer *jsonldContext,
+	term *jsonldTerm,
+	value any,
+	graph interfaces.ITerm,
+) ([]interfaces.ITerm, error) {
+	switch value := value.(type) {
+	case []any:
+		var objects []interfaces.ITerm
+		for _, element := range value {
+			values, err := s.parseValue(active, outer, term, element, graph)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, values...)
+		}
+		return objects, nil
+	case string:
+		switch term.typeMapping {
+		case "@id":
+			return []interfaces.ITerm{s.nodeTerm(active, value)}, nil
+		case "@vocab":
+			iri, _ := s.expandIRI(active, value, true, true, nil, nil)
+			return []interfaces.ITerm{s.nodeTerm(active, iri)}, nil
+		case "", "@none":
+			language := active.language
+			if term.language != nil {
+				language = *term.language
+			}
+			return []interfaces.ITerm{s.languageLiteral(value, language, s.termDirection(active, term))}, nil
+		}
+		return []interfaces.ITerm{NewLiteral(value, "", NewNamedNode(term.typeMapping))}, nil
+	case bool, json.Number:
+		datatype := ""
+		if !strings.HasPrefix(term.typeMapping, "@") {
+			datatype = term.typeMapping
+		}
+		return []interfaces.ITerm{nativeLiteral(value, datatype)}, nil
+	case map[string]any:
+		keywords := make(map[string]any)
+		for key, element := range value {
+			if keyword := s.keyword(active, key); keyword != "" {
+				keywords[keyword] = element
+			}
+		}
+		if _, ok := keywords["@value"]; ok {
+			return s.parseValueObject(active, keywords)
+		}
+		if list, ok := keywords["@list"]; ok {
+			head, err := s.parseList(active, outer, term, jsonldArray(list), graph)
+			return []interfaces.ITerm{head}, err
+		}
+		if set, ok := keywords["@set"]; ok {
+			return s.parseValue(active, outer, term, set, graph)
+		}
+		subject, err := s.parseNode(outer, value, graph, false)
+		return []interfaces.ITerm{subject}, err
+	}
+	return nil, nil
+}
+
+func (s *jsonldState) parseValueObject(active *jsonldContext, keywords map[string]any) ([]interfaces.ITerm, error) {
+	value := keywords["@value"]
+	datatype := ""
+	if typeValue, ok := keywords["@type"]; ok {
+		name, ok := typeValue.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid @type of a value", JSONLDError)
+		}
+		datatype, _ = s.expandIRI(active, name, true, true, nil, nil)
+	}
+	if datatype == "@json" {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", JSONLDError, err)
+		}
+		return []interfaces.ITerm{NewLiteral(string(data), "", NewNamedNode(rdfJSON))}, nil
+	}
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		language, _ := keywords["@language"].(string)
+		direction, err := jsonldDirection(keywords["@direction"])
+		if err != nil {
+			return nil, err
+		}
+		if datatype != "" {
+			return []interfaces.ITerm{NewLiteral(value, "", NewNamedNode(datatype))}, nil
+		}
+		return []interfaces.ITerm{s.languageLiteral(value, language, direction)}, nil
+	case bool, json.Number:
+		return []interfaces.ITerm{nativeLiteral(value, datatype)}, nil
+	}
+	return nil, fmt.Errorf("%w: invalid @value", JSONLDError)
+}
+
+func (s *jsonldState) termDirection(active *jsonldContext, term *jsonldTerm) string {
+	if term.direction != nil {
+		return *term.direction
+	}
+	return active.direction
+}
+
+func (s *jsonldState) languageLiteral(value string, language string, direction string) interfaces.ILiteral {
+	if language == "" {
+		return NewStringLiteral(value, "")
+	}
+	return NewStringLiteral(value, langtag.JoinDirection(language, direction))
+}
+
+// nativeLiteral converts a JSON number or boolean to a literal, with the
+// canonical lexical forms of JSON-LD: numbers without a fraction are
+// integers and other numbers are doubles.
+func nativeLiteral(value any, datatype string) interfaces.ILiteral {
+	if value, ok := value.(bool); ok {
+		if datatype == "" {
+			datatype = xsdBoolean
+		}
+		return NewLiteral(strconv.FormatBool(value), "", NewNamedNode(datatype))
+	}
+	number := value.(json.Number)
+	float, _ := number.Float64()
+	if datatype != xsdDouble && float == math.Trunc(float) && math.Abs(float) < 1e21 {
+		if datatype == "" {
+			datatype = xsdInteger
+		}
+		lexical := number.String()
+		if strings.ContainsAny(lexical, ".eE") {
+			lexical = strconv.FormatFloat(float, 'f', -1, 64)
+		}
+		return NewLiteral(lexical, "", NewNamedNode(datatype))
+	}
+	if datatype == "" {
+		datatype = xsdDouble
+	}
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(float, 'E', -1, 64), "E")
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+	power, _ := strconv.Atoi(exponent)
+	return NewLiteral(mantissa+"E"+strconv.Itoa(power), "", NewNamedNode(datatype))
+}
+
+// nodeTerm returns the blank node or IRI of an identifier.
+func (s *jsonldState) nodeTerm(active *jsonldContext, id string) interfaces.ITerm {
+	if label, ok := strings.CutPrefix(id, "_:"); ok {
+		return s.blankNode(label)
+	}
+	expanded, _ := s.expandIRI(active, id, true, false, nil, nil)
+	if label, ok := strings.CutPrefix(expanded, "_:"); ok {
+		return s.blankNode(label)
+	}
+	return NewNamedNode(expanded)
+}
+
+func (s *jsonldState) blankNode(label string) interfaces.IBlankNode {
+	if label != "" && s.options.BlankNodeScope != nil {
+		return s.options.BlankNodeScope.Get(label)
+	}
+	return NewBlankNode(label)
+}
+
+// emitQuad emits a quad, unless one of its IRIs is relative or empty, in
+// which case JSON-LD drops it.
+func (s *jsonldState) emitQuad(subject interfaces.ITerm, predicate interfaces.ITerm, object interfaces.ITerm, graph interfaces.ITerm) error {
+	for _, term := range []interfaces.ITerm{subject, predicate, object, graph} {
+		if literal, ok := term.(interfaces.ILiteral); ok && literal.GetDatatype() != nil {
+			term = literal.GetDatatype()
+		}
+		if term.GetType() == interfaces.NamedNodeType && !hasScheme(term.GetValue()) {
+			return nil
+		}
+	}
+	quad, err := NewQuad(subject, predicate, object, graph)
+	if err != nil {
+		return nil
+	}
+	if !s.emit(quad) {
+		return errJSONLDStopped
+	}
+	return nil
+}
+
+func jsonldArray(value any) []any {
+	if value == nil {
+		return nil
+	}
+	if array, ok := value.([]any); ok {
+		return array
+	}
+	return []any{value}
+}
+
+func jsonldStrings(value any) []string {
+	var values []string
+	for _, element := range jsonldArray(value) {
+		if text, ok := element.(string); ok {
+			values = append(values, text)
+		}
+	}
+	return values
+}
+
+// jsonldSerializer writes quads as a JSON-LD document in flattened form: a
+// node object for each subject, with the nodes of named graphs in the @graph
+// of the node of the graph name. Prefixes become the @context, and IRIs are
+// written as compact IRIs where a prefix matches. It reads all quads before
+// it writes the document.
+type jsonldSerializer struct{}
+
+type jsonldGraph struct {
+	nodes []map[string]any
+	index map[string]map[string]any
+}
+
+func (g *jsonldGraph) node(id string) map[string]any {
+	node, ok := g.index[id]
+	if !ok {
+		node = map[string]any{"@id": id}
+		g.index[id] = node
+		g.nodes = append(g.nodes, node)
+	}
+	return node
+}
+
+func (s *jsonldSerializer) Serialize(writer io.Writer, stream interfaces.IStream, prefixes map[string]string) error {
+	names := slices.Collect(maps.Keys(prefixes))
+	// Longer namespaces are tried first, so the most specific prefix is used.
+	sort.Slice(names, func(i int, j int) bool {
+		if len(prefixes[names[i]]) != len(prefixes[names[j]]) {
+			return len(prefixes[names[i]]) > len(prefixes[names[j]])
+		}
+		return names[i] < names[j]
+	})
+	compact := func(iri string) string {
+		for _, name := range names {
+			if suffix, ok := strings.CutPrefix(iri, prefixes[name]); ok && suffix != "" && !strings.HasPrefix(suffix, "//") {
+				return name + ":" + suffix
+			}
+		}
+		return iri
+	}
+	id := func(term interfaces.ITerm) (string, error) {
+		switch term.GetType() {
+		case interfaces.NamedNodeType:
+			return compact(term.GetValue()), nil
+		case interfaces.BlankNodeType:
+			return "_:" + term.GetValue(), nil
+		}
+		return "", fmt.Errorf("%w: %s in JSON-LD", UnsupportedTermError, term.ToString())
+	}
+
+	defaultGraph := &jsonldGraph{index: make(map[string]map[string]any)}
+	graphs := make(map[string]*jsonldGraph)
+	var graphNames []string
+	var err error
+	for quad := range stream {
+		if quad == nil || err != nil {
+			continue
+		}
+		err = func() error {
+			graph := defaultGraph
+			if quad.GetGraph().GetType() != interfaces.DefaultGraphType {
+				name, err := id(quad.GetGraph())
+				if err != nil {
+					return err
+				}
+				if graph = graphs[name]; graph == nil {
+					graph = &jsonldGraph{index: make(map[string]map[string]any)}
+					graphs[name] = graph
+					graphNames = append(graphNames, name)
+				}
+			}
+			subject, err := id(quad.GetSubject())
+			if err != nil {
+				return err
+			}
+			node := graph.node(subject)
+			if rdfType.Equals(quad.GetPredicate()) && quad.GetObject().GetType() != interfaces.LiteralType {
+				object, err := id(quad.GetObject())
+				if err != nil {
+					return err
+				}
+				node["@type"] = append(jsonldArray(node["@type"]), object)
+				return nil
+			}
+			predicate, err := id(quad.GetPredicate())
+			if err != nil {
+				return err
+			}
+			var value map[string]any
+			if literal, ok := quad.GetObject().(interfaces.ILiteral); ok {
+				value = map[string]any{"@value": literal.GetValue()}
+				switch {
+				case literal.GetLanguage() != "":
+					value["@language"] = literal.GetLanguage()
+					if literal.GetDirection() != "" {
+						value["@direction"] = literal.GetDirection()
+					}
+				case literal.GetDatatype() != nil && literal.GetDatatype().GetValue() != xsdNamespace+"string":
+					value["@type"] = compact(literal.GetDatatype().GetValue())
+				}
+			} else {
+				object, err := id(quad.GetObject())
+				if err != nil {
+					return err
+				}
+				value = map[string]any{"@id": object}
+			}
+			values, _ := node[predicate].([]any)
+			node[predicate] = append(values, value)
+			return nil
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, name := range graphNames {
+		node := defaultGraph.node(name)
+		var nodes []any
+		for _, graphNode := range graphs[name].nodes {
+			nodes = append(nodes, graphNode)
+		}
+		node["@graph"] = nodes
+	}
+	var document any = defaultGraph.nodes
+	if defaultGraph.nodes == nil {
+		document = []any{}
+	}
+	if len(names) > 0 {
+		context := make(map[string]any)
+		for _, name := range names {
+			namespace := prefixes[name]
+			if namespace != "" && strings.ContainsAny(namespace[len(namespace)-1:], ":/?#[]@") {
+				context[name] = namespace
+			} else {
+				context[name] = map[string]any{"@id": namespace, "@prefix": true}
+			}
+		}
+		document = map[string]any{"@context": context, "@graph": document}
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
+
+// sniffJSONLD accepts a JSON object or array that uses a JSON-LD keyword.
+func sniffJSONLD(head []byte) bool {
+	trimmed := bytes.TrimLeft(head, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '{' && trimmed[0] != '[' {
+		return false
+	}
+	for _, keyword := range []string{`"@context"`, `"@id"`, `"@graph"`, `"@type"`} {
+		if bytes.Contains(head, []byte(keyword)) {
+			return true
+		}
+	}
+	return false
+}
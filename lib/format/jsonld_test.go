@@ -0,0 +1,355 @@
+package rdfgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	. "github.com/maartyman/rdfgo/lib/stream"
+)
+
+// parseJSONLD parses a document and returns its quads as sorted N-Quads lines.
+func parseJSONLD(t *testing.T, document string, baseIRI string) ([]string, error) {
+	t.Helper()
+	quadStream := (&jsonldParser{}).parse(context.Background(), strings.NewReader(document), baseIRI, ParseOptions{})
+	quads, err := quadStream.ToArray()
+	var buffer bytes.Buffer
+	if serializeErr := (&nQuadsSerializer{syntax: nQuadsSyntax}).Serialize(&buffer, ArrayToStream(quads).ToIStream(), nil); serializeErr != nil {
+		t.Fatalf("Unexpected error: %v", serializeErr)
+	}
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	if buffer.Len() == 0 {
+		lines = nil
+	}
+	slices.Sort(lines)
+	return lines, err
+}
+
+func TestJSONLDParser(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		expected []string
+	}{
+		{"Prefixes", `{
+			"@context": {"ex": "http://example.com/", "name": "ex:name", "knows": {"@id": "ex:knows", "@type": "@id"}},
+			"@id": "ex:alice", "@type": "ex:Person", "name": "Alice", "knows": "ex:bob"
+		}`, []string{
+			`<http://example.com/alice> <http://example.com/knows> <http://example.com/bob> .`,
+			`<http://example.com/alice> <http://example.com/name> "Alice" .`,
+			`<http://example.com/alice> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.com/Person> .`,
+		}},
+		{"VocabAndBase", `{
+			"@context": {"@vocab": "http://example.com/vocab#", "@base": "http://example.com/base/", "link": {"@type": "@vocab"}},
+			"@id": "alice", "age": 42, "height": 1.75, "big": 1e3, "member": true, "link": "Thing", "relative": {"@id": "../bob"}
+		}`, []string{
+			`<http://example.com/base/alice> <http://example.com/vocab#age> "42"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+			`<http://example.com/base/alice> <http://example.com/vocab#big> "1000"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+			`<http://example.com/base/alice> <http://example.com/vocab#height> "1.75E0"^^<http://www.w3.org/2001/XMLSchema#double> .`,
+			`<http://example.com/base/alice> <http://example.com/vocab#link> <http://example.com/vocab#Thing> .`,
+			`<http://example.com/base/alice> <http://example.com/vocab#member> "true"^^<http://www.w3.org/2001/XMLSchema#boolean> .`,
+			`<http://example.com/base/alice> <http://example.com/vocab#relative> <http://example.com/bob> .`,
+		}},
+		{"Literals", `{
+			"@context": {
+				"@vocab": "http://example.com/", "@language": "en",
+				"label": {"@container": "@language"}, "code": {"@type": "http://example.com/Code"},
+				"dutch": {"@language": "nl", "@direction": "ltr"}, "plain": {"@language": null},
+				"count": {"@type": "http://www.w3.org/2001/XMLSchema#double"}, "flag": {"@type": "http://example.com/Flag"}
+			},
+			"@id": "http://example.com/s",
+			"text": "colour", "plain": "x", "dutch": "kleur", "code": "A1", "count": 2, "flag": false,
+			"label": {"fr": "couleur", "@none": "none", "de": [null, "Farbe"]},
+			"value": [
+				{"@value": "typed", "@type": "http://example.com/T"}, {"@value": 7, "@type": "http://example.com/N"},
+				{"@value": "rtl", "@language": "ar", "@direction": "rtl"}, {"@value": {"b": 1, "a": [true]}, "@type": "@json"},
+				{"@value": null}, {"@value": "simple"}, null
+			]
+		}`, []string{
+			`<http://example.com/s> <http://example.com/code> "A1"^^<http://example.com/Code> .`,
+			`<http://example.com/s> <http://example.com/count> "2.0E0"^^<http://www.w3.org/2001/XMLSchema#double> .`,
+			`<http://example.com/s> <http://example.com/dutch> "kleur"@nl--ltr .`,
+			`<http://example.com/s> <http://example.com/flag> "false"^^<http://example.com/Flag> .`,
+			`<http://example.com/s> <http://example.com/label> "Farbe"@de .`,
+			`<http://example.com/s> <http://example.com/label> "couleur"@fr .`,
+			`<http://example.com/s> <http://example.com/label> "none" .`,
+			`<http://example.com/s> <http://example.com/plain> "x" .`,
+			`<http://example.com/s> <http://example.com/text> "colour"@en .`,
+			`<http://example.com/s> <http://example.com/value> "7"^^<http://example.com/N> .`,
+			`<http://example.com/s> <http://example.com/value> "rtl"@ar--rtl .`,
+			`<http://example.com/s> <http://example.com/value> "simple" .`,
+			`<http://example.com/s> <http://example.com/value> "typed"^^<http://example.com/T> .`,
+			`<http://example.com/s> <http://example.com/value> "{\"a\":[true],\"b\":1}"^^<http://www.w3.org/1999/02/22-rdf-syntax-ns#JSON> .`,
+		}},
+		{"Graphs", `{
+			"@context": {"ex": "http://example.com/", "id": "@id", "graph": "@graph"},
+			"graph": [
+				{"id": "ex:g", "ex:p": "in default graph", "graph": {"id": "ex:s", "ex:p": {"id": "_:b"}}},
+				{"id": "_:b", "ex:p": "blank"}
+			]
+		}`, []string{
+			`<http://example.com/g> <http://example.com/p> "in default graph" .`,
+			`<http://example.com/s> <http://example.com/p> _:b <http://example.com/g> .`,
+			`_:b <http://example.com/p> "blank" .`,
+		}},
+		{"ReverseNestAndIncluded", `{
+			"@context": {"ex": "http://example.com/", "parent": {"@reverse": "ex:child"}, "details": "@nest"},
+			"@id": "ex:s",
+			"parent": {"@id": "ex:p"},
+			"@reverse": {"ex:knows": [{"@id": "ex:k"}], "parent": {"@id": "ex:q"}},
+			"details": {"ex:nested": "value"},
+			"@included": [{"@id": "ex:i", "ex:p": "included"}]
+		}`, []string{
+			`<http://example.com/i> <http://example.com/p> "included" .`,
+			`<http://example.com/k> <http://example.com/knows> <http://example.com/s> .`,
+			`<http://example.com/p> <http://example.com/child> <http://example.com/s> .`,
+			`<http://example.com/s> <http://example.com/child> <http://example.com/q> .`,
+			`<http://example.com/s> <http://example.com/nested> "value" .`,
+		}},
+		{"Maps", `{
+			"@context": {
+				"@vocab": "http://example.com/",
+				"byIndex": {"@container": "@index"}, "byId": {"@container": "@id"}, "byType": {"@container": "@type"}
+			},
+			"@id": "http://example.com/s",
+			"byIndex": {"one": "first", "two": ["second"]},
+			"byId": {"http://example.com/a": {"name": "a"}, "@none": {"@id": "http://example.com/n"}},
+			"byType": {"Person": {"@id": "http://example.com/t"}, "Thing": "http://example.com/u"}
+		}`, []string{
+			`<http://example.com/a> <http://example.com/name> "a" .`,
+			`<http://example.com/s> <http://example.com/byId> <http://example.com/a> .`,
+			`<http://example.com/s> <http://example.com/byId> <http://example.com/n> .`,
+			`<http://example.com/s> <http://example.com/byIndex> "first" .`,
+			`<http://example.com/s> <http://example.com/byIndex> "second" .`,
+			`<http://example.com/s> <http://example.com/byType> <http://example.com/t> .`,
+			`<http://example.com/s> <http://example.com/byType> <http://example.com/u> .`,
+			`<http://example.com/t> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.com/Person> .`,
+			`<http://example.com/u> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.com/Thing> .`,
+		}},
+		{"ScopedContexts", `{
+			"@context": {
+				"@vocab": "http://example.com/",
+				"Person": {"@context": {"name": "http://xmlns.com/foaf/0.1/name"}},
+				"address": {"@context": {"@vocab": "http://schema.org/"}}
+			},
+			"@id": "http://example.com/s", "@type": "Person", "name": "Alice",
+			"friend": {"@id": "http://example.com/f", "name": "Bob"},
+			"address": {"@id": "http://example.com/a", "street": "Main"}
+		}`, []string{
+			`<http://example.com/a> <http://schema.org/street> "Main" .`,
+			`<http://example.com/f> <http://example.com/name> "Bob" .`,
+			`<http://example.com/s> <http://example.com/address> <http://example.com/a> .`,
+			`<http://example.com/s> <http://example.com/friend> <http://example.com/f> .`,
+			`<http://example.com/s> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://example.com/Person> .`,
+			`<http://example.com/s> <http://xmlns.com/foaf/0.1/name> "Alice" .`,
+		}},
+		{"Dropped", `[
+			{"@context": {"ignored": null, "@vocab": null},
+			 "@id": "http://example.com/s", "ignored": "x", "unmapped": "y", "http://example.com/p": {"@id": "//example.org/x"}, "_:p": "blank predicate"},
+			{"@id": "relative", "http://example.com/p": "no base"},
+			{"@context": null, "@id": "http://example.com/t", "http://example.com/p": {"@value": "x", "@type": "relative"}},
+			"ignored", {"@value": "top-level value"}
+		]`, nil},
+		{"CompactIRITerms", `{
+			"@context": [
+				{"ex": "http://example.com/", "ex:alias": {"@id": "ex:other"}, "_:term": "http://example.com/blank"},
+				{"ex:p": {"@type": "@id"}, "nothing": {"@prefix": true, "@id": "http://example.com/x#"}, "nothing:a": "http://example.com/y", "plain": {"@id": "http://example.com/"}}
+			],
+			"@id": "ex:s", "ex:alias": "v", "ex:p": "ex:o", "nothing:a": "w", "nothing:b": "x", "plain:c": "not a prefix"
+		}`, []string{
+			`<http://example.com/s> <http://example.com/other> "v" .`,
+			`<http://example.com/s> <http://example.com/p> <http://example.com/o> .`,
+			`<http://example.com/s> <http://example.com/x#b> "x" .`,
+			`<http://example.com/s> <http://example.com/y> "w" .`,
+			`<http://example.com/s> <plain:c> "not a prefix" .`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := parseJSONLD(t, tt.document, "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !slices.Equal(lines, tt.expected) {
+				t.Errorf("Expected\n%s\ngot\n%s", strings.Join(tt.expected, "\n"), strings.Join(lines, "\n"))
+			}
+		})
+	}
+}
+
+func TestJSONLDParser_Lists(t *testing.T) {
+	document := `{
+		"@context": {"@vocab": "http://example.com/", "items": {"@container": "@list"}, "names": {"@container": "@graph"}},
+		"@id": "http://example.com/s",
+		"items": ["a", ["b"]],
+		"empty": {"@list": []},
+		"set": {"@set": ["c"]},
+		"names": {"@id": "http://example.com/t", "p": "in a graph"}
+	}`
+	quads, err := (&jsonldParser{}).parse(context.Background(), strings.NewReader(document), "", ParseOptions{}).ToArray()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	store := toStore(quads)
+	s := NewNamedNode("http://example.com/s")
+	object := func(subject interfaces.ITerm, predicate string) interfaces.ITerm {
+		matches := Stream(store.Match(subject, NewNamedNode(predicate), nil, nil)).ToArray()
+		if len(matches) != 1 {
+			t.Fatalf("Expected one %s of %v, got %d", predicate, subject, len(matches))
+		}
+		return matches[0].GetObject()
+	}
+	head := object(s, "http://example.com/items")
+	second := object(head, rdfNamespace+"rest")
+	if !object(head, rdfNamespace+"first").Equals(NewStringLiteral("a", "")) || !object(second, rdfNamespace+"rest").Equals(rdfNil) {
+		t.Errorf("Expected a list of two items")
+	}
+	nested := object(second, rdfNamespace+"first")
+	if !object(nested, rdfNamespace+"first").Equals(NewStringLiteral("b", "")) {
+		t.Errorf("Expected an array in a list to be a nested list")
+	}
+	if !object(s, "http://example.com/empty").Equals(rdfNil) || !object(s, "http://example.com/set").Equals(NewStringLiteral("c", "")) {
+		t.Errorf("Expected an empty list to be rdf:nil and a set to be its values")
+	}
+	graph := object(s, "http://example.com/names")
+	if graph.GetType() != interfaces.BlankNodeType || len(Stream(store.Match(nil, nil, nil, graph)).ToArray()) != 1 {
+		t.Errorf("Expected a graph container to hold the node in a named graph")
+	}
+	if store.Size() != 11 {
+		t.Errorf("Expected 11 quads, got %d", store.Size())
+	}
+}
+
+func TestJSONLDParser_Options(t *testing.T) {
+	scope := NewBlankNodeScope()
+	quadStream := (&jsonldParser{}).parse(context.Background(), strings.NewReader(`{"@id": "_:a", "http://example.com/p": {"@id": "_:a"}}`), "", ParseOptions{BlankNodeScope: scope})
+	quads, err := quadStream.ToArray()
+	if err != nil || len(quads) != 1 || !quads[0].GetSubject().Equals(scope.Get("a")) || !quads[0].GetObject().Equals(scope.Get("a")) {
+		t.Errorf("Expected the blank nodes of the scope, got %v and %v", quads, err)
+	}
+
+	stream, _ := (&jsonldParser{}).Parse(strings.NewReader(`{"@id": "relative", "http://example.com/p": "o"}`), "http://example.com/base/")
+	quads = Stream(stream).ToArray()
+	if len(quads) != 1 || quads[0].GetSubject().GetValue() != "http://example.com/base/relative" {
+		t.Errorf("Expected relative IRIs to be resolved against the base IRI, got %v", quads)
+	}
+
+	closed := (&jsonldParser{}).ParseContext(context.Background(), strings.NewReader(`[{"@id": "http://example.com/s", "http://example.com/p": ["a", "b", "c"]}]`), "", ParseOptions{})
+	<-closed.Quads()
+	closed.Close()
+	for range closed.Quads() {
+	}
+	if closed.Err() != nil {
+		t.Errorf("Expected a closed stream to stop without an error, got %v", closed.Err())
+	}
+}
+
+func TestJSONLDParser_Errors(t *testing.T) {
+	for name, document := range map[string]string{
+		"InvalidJSON":        `{"@id": }`,
+		"TrailingData":       `{} {}`,
+		"RemoteContext":      `{"@context": "http://example.com/context.jsonld"}`,
+		"Import":             `{"@context": {"@import": "http://example.com/context.jsonld"}}`,
+		"InvalidContext":     `{"@context": 1}`,
+		"InvalidBase":        `{"@context": {"@base": 1}}`,
+		"RelativeBase":       `{"@context": {"@base": "relative/"}}`,
+		"InvalidVocab":       `{"@context": {"@vocab": 1}}`,
+		"InvalidLanguage":    `{"@context": {"@language": 1}}`,
+		"InvalidDirection":   `{"@context": {"@direction": "up"}}`,
+		"InvalidTerm":        `{"@context": {"a": 1}}`,
+		"CyclicTerm":         `{"@context": {"a": "b:x", "b": "a:y"}}`,
+		"CyclicVocab":        `{"@context": {"@vocab": "a:", "a": "a:"}}`,
+		"TermWithoutIRI":     `{"@context": {"a": {}}}`,
+		"InvalidReverse":     `{"@context": {"a": {"@reverse": 1}}}`,
+		"CyclicReverse":      `{"@context": {"a": {"@reverse": "a"}}}`,
+		"InvalidID":          `{"@context": {"a": {"@id": 1}}}`,
+		"CyclicID":           `{"@context": {"a": {"@id": "a"}}}`,
+		"CyclicPrefix":       `{"@context": {"a:b": {"@id": "a:c"}, "a": "a:b"}}`,
+		"InvalidPrefix":      `{"@context": {"a": {"@id": "http://a/", "@prefix": 1}}}`,
+		"InvalidType":        `{"@context": {"a": {"@id": "http://a/", "@type": 1}}}`,
+		"CyclicType":         `{"@context": {"a": {"@id": "http://a/", "@type": "b"}, "b": {"@id": "http://b/", "@type": "a"}}}`,
+		"InvalidContainer":   `{"@context": {"a": {"@id": "http://a/", "@container": 1}}}`,
+		"InvalidTermLang":    `{"@context": {"a": {"@id": "http://a/", "@language": 1}}}`,
+		"InvalidTermDir":     `{"@context": {"a": {"@id": "http://a/", "@direction": 1}}}`,
+		"InvalidNodeID":      `{"@id": 1}`,
+		"InvalidReverseMap":  `{"@reverse": 1}`,
+		"InvalidNest":        `{"@nest": 1}`,
+		"InvalidLanguageMap": `{"@context": {"a": {"@id": "http://a/", "@container": "@language"}}, "a": {"en": 1}}`,
+		"InvalidIDMap":       `{"@context": {"a": {"@id": "http://a/", "@container": "@id"}}, "a": {"http://b/": "c"}}`,
+		"InvalidValueType":   `{"http://a/": {"@value": "x", "@type": 1}}`,
+		"InvalidValue":       `{"http://a/": {"@value": [1]}}`,
+		"InvalidValueDir":    `{"http://a/": {"@value": "x", "@direction": "up"}}`,
+		"TypeScoped":         `{"@context": {"T": {"@id": "http://T/", "@context": "http://remote/"}}, "@type": "T"}`,
+		"PropertyScoped":     `{"@context": {"a": {"@id": "http://a/", "@context": "http://remote/"}}, "a": "x"}`,
+		"NestedNode":         `{"http://a/": {"@id": 1}}`,
+		"ListItem":           `{"http://a/": {"@list": [{"@id": 1}]}}`,
+		"NestedList":         `{"http://a/": {"@list": [[{"@id": 1}]]}}`,
+		"IndexMap":           `{"@context": {"a": {"@id": "http://a/", "@container": "@index"}}, "a": {"i": {"@id": 1}}}`,
+		"GraphContainer":     `{"@context": {"a": {"@id": "http://a/", "@container": "@graph"}}, "a": {"@id": 1}}`,
+		"Graph":              `{"@id": "http://s/", "@graph": {"@id": 1}}`,
+		"Included":           `{"@included": {"@id": 1}}`,
+		"NestedProperties":   `{"@nest": {"http://a/": {"@id": 1}}}`,
+		"ReverseProperty":    `{"@reverse": {"http://a/": {"@id": 1}}}`,
+		"ArrayValue":         `{"http://a/": [{"@id": 1}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseJSONLD(t, document, ""); !errors.Is(err, JSONLDError) {
+				t.Errorf("Expected JSONLDError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestJSONLDSerializer(t *testing.T) {
+	for _, prefixes := range []map[string]string{nil, {"ex": "http://example.com/", "other": "http://example.com/other", "xsd": xsdNamespace}} {
+		document, parsed := roundTrip(t, &jsonldSerializer{}, &jsonldParser{}, prefixes)
+		expected := serializerQuads()
+		if len(parsed) != len(expected) {
+			t.Fatalf("Expected %d quads, got %d from:\n%s", len(expected), len(parsed), document)
+		}
+		store := toStore(parsed)
+		for _, quad := range expected {
+			if !store.Has(quad) {
+				t.Errorf("Expected %s in:\n%s", quad.ToString(), document)
+			}
+		}
+		if prefixes != nil && (!strings.Contains(document, `"@id": "ex:s"`) || !strings.Contains(document, `"other": {`)) {
+			t.Errorf("Expected compact IRIs and a @context with the prefixes:\n%s", document)
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := (&jsonldSerializer{}).Serialize(&buffer, ArrayToStream(nil).ToIStream(), nil); err != nil || buffer.String() != "[]\n" {
+		t.Errorf("Expected an empty array, got %q and %v", buffer.String(), err)
+	}
+
+	inner, _ := NewQuad(NewNamedNode("http://s/"), NewNamedNode("http://p/"), NewNamedNode("http://o/"), nil)
+	literal := NewStringLiteral("x", "")
+	variable := NewVariable("v")
+	for _, terms := range [][4]interfaces.ITerm{
+		{inner, NewNamedNode("http://p/"), NewNamedNode("http://o/"), nil},
+		{NewNamedNode("http://s/"), NewNamedNode("http://p/"), NewNamedNode("http://o/"), variable},
+		{NewNamedNode("http://s/"), rdfType, inner, nil},
+		{NewNamedNode("http://s/"), variable, literal, nil},
+		{NewNamedNode("http://s/"), NewNamedNode("http://p/"), inner, nil},
+	} {
+		quad, _ := NewQuad(terms[0], terms[1], terms[2], terms[3])
+		stream := ArrayToStream([]interfaces.IQuad{nil, quad, quad})
+		if err := (&jsonldSerializer{}).Serialize(&bytes.Buffer{}, stream.ToIStream(), nil); !errors.Is(err, UnsupportedTermError) {
+			t.Errorf("Expected UnsupportedTermError for %s, got %v", quad.ToString(), err)
+		}
+	}
+}
+
+func toStore(quads []interfaces.IQuad) IStore {
+	store := NewStore()
+	for _, quad := range quads {
+		store.AddQuad(quad)
+	}
+	return store
+}
@@ -0,0 +1,463 @@
+package rdfgo
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	iriToken
+	prefixedNameToken
+	blankNodeToken
+	stringToken
+	langTagToken
+	datatypeToken
+	integerToken
+	decimalToken
+	doubleToken
+	keywordToken
+	punctuationToken
+	invalidToken
+)
+
+const snippetSize = 40
+
+type token struct {
+	kind   tokenKind
+	value  string
+	prefix string
+	line   int
+	column int
+	offset int
+}
+
+// lexer splits the Turtle family of syntaxes (N-Triples, N-Quads, Turtle
+// and TriG) into tokens while keeping track of the position in the input.
+type lexer struct {
+	reader    *bufio.Reader
+	lookahead []rune
+	line      int
+	column    int
+	offset    int
+	err       error
+	current   []rune
+	previous  []rune
+}
+
+func newLexer(reader io.Reader) *lexer {
+	return &lexer{
+		reader: bufio.NewReader(reader),
+		line:   1,
+		column: 1,
+	}
+}
+
+func (l *lexer) peekAt(n int) rune {
+	for len(l.lookahead) <= n {
+		if l.err != nil {
+			return -1
+		}
+		r, _, err := l.reader.ReadRune()
+		if err != nil {
+			l.err = err
+			return -1
+		}
+		l.lookahead = append(l.lookahead, r)
+	}
+	return l.lookahead[n]
+}
+
+func (l *lexer) peek() rune {
+	return l.peekAt(0)
+}
+
+func (l *lexer) next() rune {
+	r := l.peek()
+	if r < 0 {
+		return r
+	}
+	l.lookahead = l.lookahead[1:]
+	l.offset += len(string(r))
+	if r == '\n' {
+		l.line++
+		l.column = 1
+		l.previous, l.current = l.current, l.previous[:0]
+	} else {
+		l.column++
+		l.current = append(l.current, r)
+	}
+	return r
+}
+
+// readError returns the error that stopped reading the input, if it was
+// anything other than reaching the end of the input.
+func (l *lexer) readError() error {
+	if l.err != nil && !errors.Is(l.err, io.EOF) {
+		return l.err
+	}
+	return nil
+}
+
+// snippet returns the text surrounding a position on the current or the
+// previous line.
+func (l *lexer) snippet(line int, column int) string {
+	var text []rune
+	switch line {
+	case l.line:
+		text = append(text, l.current...)
+		for i := 0; ; i++ {
+			r := l.peekAt(i)
+			if r < 0 || r == '\n' || r == '\r' || len(text) >= column+snippetSize {
+				break
+			}
+			text = append(text, r)
+		}
+	case l.line - 1:
+		text = l.previous
+	}
+	start := max(0, min(column-1, len(text))-snippetSize)
+	end := min(len(text), column-1+snippetSize)
+	return string(text[start:end])
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	if err := l.readError(); err != nil {
+		return err
+	}
+	return fmt.Errorf(format, args...)
+}
+
+func (l *lexer) skipWhitespace() {
+	for {
+		r := l.peek()
+		switch {
+		case r == '#':
+			for r = l.peek(); r >= 0 && r != '\n' && r != '\r'; r = l.peek() {
+				l.next()
+			}
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			l.next()
+		default:
+			return
+		}
+	}
+}
+
+// skipLine discards the input up to and including the next line break.
+func (l *lexer) skipLine() {
+	for r := l.next(); r >= 0 && r != '\n'; r = l.next() {
+	}
+}
+
+func (l *lexer) nextToken() (token, error) {
+	l.skipWhitespace()
+	t := token{line: l.line, column: l.column, offset: l.offset}
+
+	r := l.peek()
+	switch {
+	case r < 0:
+		if err := l.readError(); err != nil {
+			return t, err
+		}
+		t.kind = eofToken
+		return t, nil
+	case r == '<':
+		l.next()
+		value, err := l.readIRI()
+		t.kind, t.value = iriToken, value
+		return t, err
+	case r == '"' || r == '\'':
+		value, err := l.readString()
+		t.kind, t.value = stringToken, value
+		return t, err
+	case r == '@':
+		l.next()
+		var builder strings.Builder
+		for r = l.peek(); isLetter(r) || isDigit(r) || (r == '-' && builder.Len() > 0); r = l.peek() {
+			builder.WriteRune(l.next())
+		}
+		if builder.Len() == 0 || !isLetter([]rune(builder.String())[0]) {
+			return t, l.errorf("invalid language tag")
+		}
+		t.kind, t.value = langTagToken, builder.String()
+		return t, nil
+	case r == '^':
+		l.next()
+		if l.next() != '^' {
+			return t, l.errorf("expected '^^'")
+		}
+		t.kind = datatypeToken
+		return t, nil
+	case r == '_' && l.peekAt(1) == ':':
+		l.next()
+		l.next()
+		value, err := l.readBlankNodeLabel()
+		t.kind, t.value = blankNodeToken, value
+		return t, err
+	case isDigit(r) || r == '+' || r == '-' || (r == '.' && isDigit(l.peekAt(1))):
+		return l.readNumber(t)
+	case strings.ContainsRune(".;,[](){}", r):
+		l.next()
+		t.kind, t.value = punctuationToken, string(r)
+		return t, nil
+	case r == ':' || isNameStartChar(r):
+		return l.readName(t)
+	}
+	l.next()
+	return t, l.errorf("unexpected character %q", r)
+}
+
+func (l *lexer) readIRI() (string, error) {
+	var builder strings.Builder
+	for {
+		r := l.next()
+		switch {
+		case r < 0:
+			return "", l.errorf("unterminated IRI")
+		case r == '>':
+			return builder.String(), nil
+		case r == '\\':
+			if l.peek() != 'u' && l.peek() != 'U' {
+				return "", l.errorf("invalid escape sequence in IRI")
+			}
+			value, err := l.readUnicodeEscape()
+			if err != nil {
+				return "", err
+			}
+			builder.WriteRune(value)
+		case r <= ' ' || strings.ContainsRune("<\"{}|^`", r):
+			return "", l.errorf("invalid character %q in IRI", r)
+		default:
+			builder.WriteRune(r)
+		}
+	}
+}
+
+func (l *lexer) readUnicodeEscape() (rune, error) {
+	size := 4
+	if l.next() == 'U' {
+		size = 8
+	}
+	var digits strings.Builder
+	for i := 0; i < size; i++ {
+		digits.WriteRune(l.next())
+	}
+	value, err := strconv.ParseUint(digits.String(), 16, 32)
+	if err != nil || value > unicode.MaxRune {
+		return 0, l.errorf("invalid unicode escape \\u%s", digits.String())
+	}
+	return rune(value), nil
+}
+
+func (l *lexer) readString() (string, error) {
+	quote := l.next()
+	long := false
+	if l.peek() == quote && l.peekAt(1) == quote {
+		l.next()
+		l.next()
+		long = true
+	}
+
+	var builder strings.Builder
+	for {
+		r := l.next()
+		switch {
+		case r < 0:
+			return "", l.errorf("unterminated string")
+		case r == quote:
+			if !long {
+				return builder.String(), nil
+			}
+			if l.peek() == quote && l.peekAt(1) == quote && l.peekAt(2) != quote {
+				l.next()
+				l.next()
+				return builder.String(), nil
+			}
+			builder.WriteRune(r)
+		case r == '\\':
+			value, err := l.readStringEscape()
+			if err != nil {
+				return "", err
+			}
+			builder.WriteRune(value)
+		case (r == '\n' || r == '\r') && !long:
+			return "", l.errorf("line break in string")
+		default:
+			builder.WriteRune(r)
+		}
+	}
+}
+
+func (l *lexer) readStringEscape() (rune, error) {
+	switch r := l.peek(); r {
+	case 'u', 'U':
+		return l.readUnicodeEscape()
+	case 't':
+		l.next()
+		return '\t', nil
+	case 'b':
+		l.next()
+		return '\b', nil
+	case 'n':
+		l.next()
+		return '\n', nil
+	case 'r':
+		l.next()
+		return '\r', nil
+	case 'f':
+		l.next()
+		return '\f', nil
+	case '"', '\'', '\\':
+		l.next()
+		return r, nil
+	default:
+		return 0, l.errorf("invalid escape sequence \\%c", r)
+	}
+}
+
+func (l *lexer) readBlankNodeLabel() (string, error) {
+	var builder strings.Builder
+	r := l.peek()
+	if !isNameStartChar(r) && r != '_' && !isDigit(r) {
+		return "", l.errorf("invalid blank node label")
+	}
+	for r = l.peek(); isNameChar(r) || r == '.'; r = l.peek() {
+		if r == '.' && !isNameChar(l.peekAt(1)) && l.peekAt(1) != '.' {
+			break
+		}
+		builder.WriteRune(l.next())
+	}
+	value := builder.String()
+	if strings.HasSuffix(value, ".") {
+		return "", l.errorf("blank node label ends with '.'")
+	}
+	return value, nil
+}
+
+func (l *lexer) readNumber(t token) (token, error) {
+	var builder strings.Builder
+	if r := l.peek(); r == '+' || r == '-' {
+		builder.WriteRune(l.next())
+	}
+	for isDigit(l.peek()) {
+		builder.WriteRune(l.next())
+	}
+	t.kind = integerToken
+	if l.peek() == '.' && isDigit(l.peekAt(1)) {
+		t.kind = decimalToken
+		builder.WriteRune(l.next())
+		for isDigit(l.peek()) {
+			builder.WriteRune(l.next())
+		}
+	}
+	if r := l.peek(); r == 'e' || r == 'E' {
+		exponent := 1
+		if sign := l.peekAt(1); sign == '+' || sign == '-' {
+			exponent = 2
+		}
+		if isDigit(l.peekAt(exponent)) {
+			t.kind = doubleToken
+			for i := 0; i < exponent; i++ {
+				builder.WriteRune(l.next())
+			}
+			for isDigit(l.peek()) {
+				builder.WriteRune(l.next())
+			}
+		}
+	}
+	t.value = builder.String()
+	if t.value == "" || t.value == "+" || t.value == "-" {
+		return t, l.errorf("invalid number")
+	}
+	return t, nil
+}
+
+func (l *lexer) readName(t token) (token, error) {
+	var builder strings.Builder
+	colon := -1
+	for {
+		r := l.peek()
+		switch {
+		case r == ':':
+			if colon < 0 {
+				colon = builder.Len()
+			}
+			builder.WriteRune(l.next())
+		case r == '.':
+			next := l.peekAt(1)
+			if !(isNameChar(next) || next == ':' || next == '.' || next == '%' || next == '\\') {
+				return l.finishName(t, builder.String(), colon)
+			}
+			builder.WriteRune(l.next())
+		case r == '%' && colon >= 0:
+			builder.WriteRune(l.next())
+			for i := 0; i < 2; i++ {
+				if !isHex(l.peek()) {
+					return t, l.errorf("invalid percent encoding in prefixed name")
+				}
+				builder.WriteRune(l.next())
+			}
+		case r == '\\' && colon >= 0:
+			l.next()
+			escaped := l.next()
+			if !strings.ContainsRune("_~.-!$&'()*+,;=/?#@%", escaped) {
+				return t, l.errorf("invalid escape sequence \\%c in prefixed name", escaped)
+			}
+			builder.WriteRune(escaped)
+		case isNameChar(r):
+			builder.WriteRune(l.next())
+		default:
+			return l.finishName(t, builder.String(), colon)
+		}
+	}
+}
+
+func (l *lexer) finishName(t token, value string, colon int) (token, error) {
+	if colon < 0 {
+		t.kind, t.value = keywordToken, value
+		return t, nil
+	}
+	t.kind, t.prefix, t.value = prefixedNameToken, value[:colon], value[colon+1:]
+	return t, nil
+}
+
+func isLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isHex(r rune) bool {
+	return isDigit(r) || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')
+}
+
+func isNameStartChar(r rune) bool {
+	return isLetter(r) ||
+		(r >= 0x00C0 && r <= 0x00D6) ||
+		(r >= 0x00D8 && r <= 0x00F6) ||
+		(r >= 0x00F8 && r <= 0x02FF) ||
+		(r >= 0x0370 && r <= 0x037D) ||
+		(r >= 0x037F && r <= 0x1FFF) ||
+		(r >= 0x200C && r <= 0x200D) ||
+		(r >= 0x2070 && r <= 0x218F) ||
+		(r >= 0x2C00 && r <= 0x2FEF) ||
+		(r >= 0x3001 && r <= 0xD7FF) ||
+		(r >= 0xF900 && r <= 0xFDCF) ||
+		(r >= 0xFDF0 && r <= 0xFFFD) ||
+		(r >= 0x10000 && r <= 0xEFFFF)
+}
+
+func isNameChar(r rune) bool {
+	return isNameStartChar(r) || isDigit(r) || r == '_' || r == '-' || r == 0xB7 ||
+		(r >= 0x0300 && r <= 0x036F) || r == 0x203F || r == 0x2040
+}
@@ -0,0 +1,60 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+type ErrorMode int
+
+const (
+	StrictMode ErrorMode = iota
+	LenientMode
+)
+
+// ParseOptions configures how a parser reacts to syntax errors. In strict
+// mode parsing stops at the first error, in lenient mode the statement
+// containing the error is skipped and parsing resumes at the next statement.
+// Quads of a skipped statement that were streamed before the error are kept.
+// OnError is called for every syntax error in both modes. With a
+// BlankNodeScope, the N-Triples, N-Quads, Turtle and TriG parsers give the
+// blank node labels of the document the blank nodes of the scope instead of
+// keeping them, so documents parsed with different scopes share no blank
+// nodes.
+type ParseOptions struct {
+	Mode           ErrorMode
+	OnError        func(*ParseError)
+	BlankNodeScope *datamodel.BlankNodeScope
+}
+
+type ParseError struct {
+	Line    int
+	Column  int
+	Offset  int
+	Snippet string
+	Err     error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// cancelledError stops a parser whose stream was closed.
+var cancelledError = errors.New("parsing was cancelled")
+
+// ContextParser is implemented by parsers that can be cancelled and that
+// report syntax errors. The terminal error of the returned stream is the
+// error that ended parsing.
+type ContextParser interface {
+	interfaces.IParser
+	ParseContext(ctx context.Context, reader io.Reader, baseIRI string, options ParseOptions) interfaces.IQuadStream
+}
@@ -0,0 +1,232 @@
+package rdfgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/stream"
+)
+
+func parseWithOptions(
+	t *testing.T,
+	syntax syntax,
+	document string,
+	mode ErrorMode,
+) ([]interfaces.IQuad, []*ParseError, error) {
+	t.Helper()
+	var reported []*ParseError
+	parser := &turtleParser{syntax: syntax}
+	quadStream := parser.ParseContext(context.Background(), strings.NewReader(document), "http://example.com/", ParseOptions{
+		Mode: mode,
+		OnError: func(err *ParseError) {
+			reported = append(reported, err)
+		},
+	})
+	var quads []interfaces.IQuad
+	for quad := range quadStream.Quads() {
+		quads = append(quads, quad)
+	}
+	return quads, reported, quadStream.Err()
+}
+
+func TestParseError(t *testing.T) {
+	_, err := parseString(t, nTriplesSyntax, "<s> <p> <o> .\n<s> <p> ex:o .\n", "")
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("Expected a ParseError, got %v", err)
+	}
+	if parseError.Line != 2 || parseError.Column != 9 || parseError.Offset != 22 {
+		t.Errorf("Unexpected position %d:%d (%d)", parseError.Line, parseError.Column, parseError.Offset)
+	}
+	if parseError.Snippet != "<s> <p> ex:o ." {
+		t.Errorf("Unexpected snippet %q", parseError.Snippet)
+	}
+	if errors.Unwrap(err) == nil {
+		t.Errorf("Expected the cause to be unwrapped")
+	}
+}
+
+func TestParseError_Snippet(t *testing.T) {
+	long := "<s> <p> \"" + strings.Repeat("a", 100) + "\" error ."
+	_, err := parseString(t, turtleSyntax, long, "")
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("Expected a ParseError, got %v", err)
+	}
+	if len(parseError.Snippet) > 2*snippetSize || !strings.Contains(parseError.Snippet, "error") {
+		t.Errorf("Unexpected snippet %q", parseError.Snippet)
+	}
+}
+
+func TestParseContext_Strict(t *testing.T) {
+	document := "<s> <p> <o1> .\n<s> <p> error .\n<s> <p> <o3> .\n"
+	quads, reported, err := parseWithOptions(t, nTriplesSyntax, document, StrictMode)
+	if err == nil || len(reported) != 1 || reported[0] != err {
+		t.Errorf("Expected the first error to end parsing, got %v", err)
+	}
+	if len(quads) != 1 {
+		t.Errorf("Expected 1 quad, got %d", len(quads))
+	}
+}
+
+func TestParseContext_Lenient(t *testing.T) {
+	tests := []struct {
+		name     string
+		syntax   syntax
+		document string
+		quads    int
+		lines    []int
+	}{
+		{
+			name:     "N-Triples",
+			syntax:   nTriplesSyntax,
+			document: "<s> <p> <o1> .\n<s> <p> error .\n<s> <p> \"unterminated\n<s> <p> <o4> .\n<s> <p>\n<s> <p> <o6> .\n",
+			quads:    3,
+			lines:    []int{2, 3, 6},
+		},
+		{
+			name:     "N-Quads",
+			syntax:   nQuadsSyntax,
+			document: "<s> <p> <o1> <g> .\n<s> <p> <o2> <g> <h> .\n<s> <p> <o3> <g> .\n",
+			quads:    2,
+			lines:    []int{2},
+		},
+		{
+			name:     "Turtle",
+			syntax:   turtleSyntax,
+			document: "@prefix ex: <http://example.com/> .\nex:s ex:p ex:o1 .\nex:s ex:p\n  unknown:o .\nex:s ex:p ex:o3 ; ex:q \"x\" \"y\" .\nex:s ex:p ex:o4 .",
+			quads:    4,
+			lines:    []int{4, 5},
+		},
+		{
+			name:     "TriG",
+			syntax:   triGSyntax,
+			document: "<g> { <s> <p> <o1> . <s> <p> ? . <s> <p> <o3> }\n<s> <p> <o4> .\n<g> { <s> <p> }\n<s> <p> <o5> .",
+			quads:    4,
+			lines:    []int{1, 3},
+		},
+		{
+			name:     "UnterminatedIRI",
+			syntax:   turtleSyntax,
+			document: "<s> <p> <o1> .\n<s> <p> ? <o2",
+			quads:    1,
+			lines:    []int{2},
+		},
+		{
+			name:     "UnterminatedString",
+			syntax:   triGSyntax,
+			document: "<g> { <s> <p> <o1> . <s> <p> ? \"o2",
+			quads:    1,
+			lines:    []int{1, 1},
+		},
+		{
+			name:     "UnterminatedStatement",
+			syntax:   turtleSyntax,
+			document: "<s> <p> <o1> .\n<s> <p> \"o2",
+			quads:    1,
+			lines:    []int{2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			quads, reported, err := parseWithOptions(t, test.syntax, test.document, LenientMode)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(quads) != test.quads {
+				t.Errorf("Expected %d quads, got:\n%s", test.quads, strings.Join(quadStrings(quads), "\n"))
+			}
+			var lines []int
+			for _, parseError := range reported {
+				lines = append(lines, parseError.Line)
+			}
+			if len(lines) != len(test.lines) {
+				t.Fatalf("Expected errors on lines %v, got %v (%v)", test.lines, lines, reported)
+			}
+			for i := range lines {
+				if lines[i] != test.lines[i] {
+					t.Errorf("Expected errors on lines %v, got %v", test.lines, lines)
+				}
+			}
+		})
+	}
+}
+
+func TestParseContext_ReadError(t *testing.T) {
+	parser := &turtleParser{syntax: turtleSyntax}
+	quadStream := parser.ParseContext(context.Background(), failingReader{}, "", ParseOptions{Mode: LenientMode})
+	for range quadStream.Quads() {
+	}
+	var parseError *ParseError
+	if err := quadStream.Err(); err == nil || errors.As(err, &parseError) {
+		t.Errorf("Expected the read error to end parsing, got %v", err)
+	}
+}
+
+func TestParseContext_Cancel(t *testing.T) {
+	document := strings.Repeat("<s> <p> <o> .\n", 1000)
+	parser := &turtleParser{syntax: nTriplesSyntax}
+	quadStream := parser.ParseContext(context.Background(), strings.NewReader(document), "", ParseOptions{})
+	<-quadStream.Quads()
+	quadStream.Close()
+	count := 1
+	for range quadStream.Quads() {
+		count++
+	}
+	if count >= 1000 || quadStream.Err() != nil {
+		t.Errorf("Expected parsing to stop without an error, got %d quads and %v", count, quadStream.Err())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	quadStream = parser.ParseContext(ctx, strings.NewReader(document), "", ParseOptions{Mode: LenientMode})
+	<-quadStream.Quads()
+	cancel()
+	for range quadStream.Quads() {
+	}
+	if !errors.Is(quadStream.Err(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", quadStream.Err())
+	}
+}
+
+func TestRegistry_ParseContext(t *testing.T) {
+	ctx := context.Background()
+	quadStream := ParseContext(ctx, strings.NewReader("<s> <p> <o> <g> .\nerror\n"), NQuadsMediaType, "", ParseOptions{Mode: LenientMode})
+	count := 0
+	for range quadStream.Quads() {
+		count++
+	}
+	if err := quadStream.Err(); err != nil || count != 1 {
+		t.Errorf("Expected 1 quad and no error, got %d and %v", count, err)
+	}
+
+	quadStream = ParseContext(ctx, strings.NewReader("unknown"), "", "", ParseOptions{})
+	for range quadStream.Quads() {
+	}
+	if err := quadStream.Err(); !errors.Is(err, UnknownFormatError) {
+		t.Errorf("Expected UnknownFormatError, got %v", err)
+	}
+
+	registry := NewRegistry()
+	registry.Register(Format{Name: "plain", MediaTypes: []string{"text/plain"}, Parser: &hdtParser{}})
+	quadStream = registry.ParseContext(ctx, strings.NewReader("no hdt"), "text/plain", "", ParseOptions{})
+	for range quadStream.Quads() {
+	}
+	if err := quadStream.Err(); err == nil {
+		t.Errorf("Expected the parser error to be reported")
+	}
+
+	var buffer bytes.Buffer
+	if err := Serialize(&buffer, ArrayToStream(nil).ToIStream(), HDTMediaType, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	quadStream = ParseContext(ctx, &buffer, HDTMediaType, "", ParseOptions{})
+	for range quadStream.Quads() {
+	}
+	if err := quadStream.Err(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,696 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	rdfiri "github.com/maartyman/rdfgo/lib/iri"
+	stream "github.com/maartyman/rdfgo/lib/stream"
+)
+
+const rdfNamespace = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+
+type syntax int
+
+const (
+	nTriplesSyntax syntax = iota
+	nQuadsSyntax
+	turtleSyntax
+	triGSyntax
+)
+
+var (
+	rdfType  = NewNamedNode(rdfNamespace + "type")
+	rdfFirst = NewNamedNode(rdfNamespace + "first")
+	rdfRest  = NewNamedNode(rdfNamespace + "rest")
+	rdfNil   = NewNamedNode(rdfNamespace + "nil")
+)
+
+type turtleParser struct {
+	syntax syntax
+}
+
+func (p *turtleParser) Parse(reader io.Reader, baseIRI string) (interfaces.IStream, error) {
+	return p.parse(context.Background(), reader, baseIRI, ParseOptions{}).ToIStream(), nil
+}
+
+func (p *turtleParser) ParseContext(
+	ctx context.Context,
+	reader io.Reader,
+	baseIRI string,
+	options ParseOptions,
+) interfaces.IQuadStream {
+	return p.parse(ctx, reader, baseIRI, options)
+}
+
+func (p *turtleParser) parse(
+	ctx context.Context,
+	reader io.Reader,
+	baseIRI string,
+	options ParseOptions,
+) *stream.QuadStream {
+	return stream.NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		state := newParserState(p.syntax, reader, baseIRI, emit)
+		state.options = options
+		if err := state.parse(); err != nil && !errors.Is(err, cancelledError) {
+			return err
+		}
+		return ctx.Err()
+	})
+}
+
+// parserState holds everything needed while parsing a single document:
+// the token lookahead, the prefixes, the base IRI and the current graph.
+type parserState struct {
+	syntax   syntax
+	lexer    *lexer
+	current  token
+	peeked   bool
+	base     string
+	prefixes map[string]string
+	graph    interfaces.ITerm
+	// statementLine is the line on which the current statement started.
+	statementLine int
+	emit          func(interfaces.IQuad) bool
+	options       ParseOptions
+}
+
+func newParserState(syntax syntax, reader io.Reader, baseIRI string, emit func(interfaces.IQuad) bool) *parserState {
+	state := &parserState{
+		syntax:   syntax,
+		lexer:    newLexer(reader),
+		prefixes: make(map[string]string),
+		graph:    NewDefaultGraph(),
+		emit:     emit,
+	}
+	state.base = baseIRI
+	return state
+}
+
+func (s *parserState) peek() (token, error) {
+	if !s.peeked {
+		t, err := s.lexer.nextToken()
+		if err != nil {
+			s.current = token{kind: invalidToken, line: t.line, column: t.column, offset: t.offset}
+			return t, s.wrap(t, err)
+		}
+		s.current = t
+		s.peeked = true
+	}
+	return s.current, nil
+}
+
+func (s *parserState) next() (token, error) {
+	t, err := s.peek()
+	s.peeked = false
+	return t, err
+}
+
+func (s *parserState) wrap(t token, err error) error {
+	if s.lexer.readError() != nil {
+		return err
+	}
+	return &ParseError{
+		Line:    t.line,
+		Column:  t.column,
+		Offset:  t.offset,
+		Snippet: s.lexer.snippet(t.line, t.column),
+		Err:     err,
+	}
+}
+
+func (s *parserState) errorf(t token, format string, args ...interface{}) error {
+	return s.wrap(t, fmt.Errorf(format, args...))
+}
+
+func (s *parserState) expectPunctuation(value string) error {
+	t, err := s.next()
+	if err != nil {
+		return err
+	}
+	if t.kind != punctuationToken || t.value != value {
+		return s.errorf(t, "expected '%s'", value)
+	}
+	return nil
+}
+
+func isPunctuation(t token, value string) bool {
+	return t.kind == punctuationToken && t.value == value
+}
+
+func (s *parserState) extended() bool {
+	return s.syntax == turtleSyntax || s.syntax == triGSyntax
+}
+
+func (s *parserState) parse() error {
+	for {
+		t, err := s.peek()
+		if err == nil && t.kind == eofToken {
+			return nil
+		}
+		if err == nil {
+			err = s.parseStatement()
+		}
+		if err != nil {
+			if err := s.report(err); err != nil {
+				return err
+			}
+			if err := s.skipStatement(false); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// report passes a syntax error to the error callback and returns the error
+// when parsing has to stop. Errors other than syntax errors always stop it.
+func (s *parserState) report(err error) error {
+	if readError := s.lexer.readError(); readError != nil {
+		return readError
+	}
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		return err
+	}
+	if s.options.OnError != nil {
+		s.options.OnError(parseError)
+	}
+	if s.options.Mode == StrictMode {
+		return err
+	}
+	return nil
+}
+
+// skipStatement discards the rest of a statement that contained an error.
+// Line based syntaxes skip to the end of the line, the others skip past the
+// next '.' or, inside a graph, up to the closing '}'.
+func (s *parserState) skipStatement(inGraph bool) error {
+	if !s.extended() {
+		if s.peeked && s.current.line > s.statementLine {
+			return nil
+		}
+		s.peeked = false
+		if s.lexer.line == s.statementLine {
+			s.lexer.skipLine()
+		}
+		return s.lexer.readError()
+	}
+	if !s.peeked && isPunctuation(s.current, ".") {
+		return nil
+	}
+	if inGraph && !s.peeked && isPunctuation(s.current, "}") {
+		s.peeked = true
+		return nil
+	}
+	for {
+		offset := s.lexer.offset
+		t, err := s.peek()
+		if err != nil {
+			if readError := s.lexer.readError(); readError != nil {
+				return readError
+			}
+			// Make sure every error moves past at least one character, so
+			// skipping always reaches the end of the input.
+			if s.lexer.offset == offset {
+				if s.lexer.peek() < 0 {
+					return nil
+				}
+				s.lexer.next()
+			}
+			continue
+		}
+		if t.kind == eofToken || (inGraph && isPunctuation(t, "}")) {
+			return nil
+		}
+		s.next()
+		if isPunctuation(t, ".") {
+			return nil
+		}
+	}
+}
+
+func (s *parserState) parseStatement() error {
+	t, err := s.peek()
+	if err != nil {
+		return err
+	}
+	s.statementLine = t.line
+	if s.extended() {
+		switch {
+		case t.kind == langTagToken && (t.value == "prefix" || t.value == "base"):
+			s.next()
+			if err := s.parseDirective(t.value); err != nil {
+				return err
+			}
+			return s.expectPunctuation(".")
+		case t.kind == keywordToken && (strings.EqualFold(t.value, "prefix") || strings.EqualFold(t.value, "base")):
+			s.next()
+			return s.parseDirective(strings.ToLower(t.value))
+		case s.syntax == triGSyntax && t.kind == keywordToken && strings.EqualFold(t.value, "graph"):
+			s.next()
+			graph, err := s.parseTerm()
+			if err != nil {
+				return err
+			}
+			return s.parseGraph(graph)
+		case s.syntax == triGSyntax && isPunctuation(t, "{"):
+			return s.parseGraph(NewDefaultGraph())
+		}
+	}
+	if !s.extended() {
+		return s.parseLine()
+	}
+
+	if isPunctuation(t, "[") {
+		subject, err := s.parseBlankNodePropertyList()
+		if err != nil {
+			return err
+		}
+		if t, err = s.peek(); err != nil {
+			return err
+		}
+		if !isPunctuation(t, ".") {
+			if err := s.parsePredicateObjectList(subject); err != nil {
+				return err
+			}
+		}
+		return s.expectPunctuation(".")
+	}
+
+	subject, err := s.parseObject()
+	if err != nil {
+		return err
+	}
+	if s.syntax == triGSyntax {
+		if t, err = s.peek(); err != nil {
+			return err
+		}
+		if isPunctuation(t, "{") {
+			return s.parseGraph(subject)
+		}
+	}
+	if err := s.parsePredicateObjectList(subject); err != nil {
+		return err
+	}
+	return s.expectPunctuation(".")
+}
+
+func (s *parserState) parseLine() error {
+	var terms []interfaces.ITerm
+	for {
+		t, err := s.peek()
+		if err != nil {
+			return err
+		}
+		if t.line != s.statementLine {
+			return s.errorf(t, "expected '.' at the end of line %d", s.statementLine)
+		}
+		if isPunctuation(t, ".") {
+			s.next()
+			break
+		}
+		if len(terms) == 4 || (len(terms) == 3 && s.syntax == nTriplesSyntax) {
+			return s.errorf(t, "expected '.'")
+		}
+		term, err := s.parseTerm()
+		if err != nil {
+			return err
+		}
+		terms = append(terms, term)
+	}
+	if len(terms) < 3 {
+		return s.errorf(s.current, "expected a subject, predicate and object")
+	}
+	graph := interfaces.ITerm(NewDefaultGraph())
+	if len(terms) == 4 {
+		graph = terms[3]
+		if graph.GetType() == interfaces.LiteralType {
+			return s.errorf(s.current, "a graph can not be a literal")
+		}
+	}
+	return s.emitQuad(terms[0], terms[1], terms[2], graph)
+}
+
+func (s *parserState) emitQuad(subject interfaces.ITerm, predicate interfaces.ITerm, object interfaces.ITerm, graph interfaces.ITerm) error {
+	quad, err := NewQuad(subject, predicate, object, graph)
+	if err != nil {
+		return s.wrap(s.current, err)
+	}
+	if !s.emit(quad) {
+		return cancelledError
+	}
+	return nil
+}
+
+func (s *parserState) parseDirective(kind string) error {
+	if kind == "prefix" {
+		t, err := s.next()
+		if err != nil {
+			return err
+		}
+		if t.kind != prefixedNameToken || t.value != "" {
+			return s.errorf(t, "expected a prefix name")
+		}
+		iri, err := s.next()
+		if err != nil {
+			return err
+		}
+		if iri.kind != iriToken {
+			return s.errorf(iri, "expected an IRI")
+		}
+		s.prefixes[t.prefix] = s.resolve(iri.value)
+		return nil
+	}
+	iri, err := s.next()
+	if err != nil {
+		return err
+	}
+	if iri.kind != iriToken {
+		return s.errorf(iri, "expected an IRI")
+	}
+	s.base = s.resolve(iri.value)
+	if _, err = rdfiri.Parse(s.base); err != nil {
+		return s.wrap(iri, err)
+	}
+	return nil
+}
+
+func (s *parserState) parseGraph(graph interfaces.ITerm) error {
+	if graph.GetType() == interfaces.LiteralType {
+		return s.errorf(s.current, "a graph can not be a literal")
+	}
+	if err := s.expectPunctuation("{"); err != nil {
+		return err
+	}
+	s.graph = graph
+	defer func() {
+		s.graph = NewDefaultGraph()
+	}()
+	for {
+		t, err := s.peek()
+		if err != nil {
+			return err
+		}
+		if isPunctuation(t, "}") {
+			s.next()
+			return nil
+		}
+		if t.kind == eofToken {
+			return s.errorf(t, "expected '}'")
+		}
+		end, err := s.parseGraphStatement()
+		if err != nil {
+			if err := s.report(err); err != nil {
+				return err
+			}
+			if err := s.skipStatement(true); err != nil {
+				return err
+			}
+			continue
+		}
+		if end {
+			return nil
+		}
+	}
+}
+
+// parseGraphStatement parses a statement inside a graph and reports whether
+// it was terminated by the closing '}' of the graph.
+func (s *parserState) parseGraphStatement() (bool, error) {
+	t, err := s.peek()
+	if err != nil {
+		return false, err
+	}
+	var subject interfaces.ITerm
+	if isPunctuation(t, "[") {
+		if subject, err = s.parseBlankNodePropertyList(); err != nil {
+			return false, err
+		}
+		if t, err = s.peek(); err != nil {
+			return false, err
+		}
+		if !isPunctuation(t, ".") && !isPunctuation(t, "}") {
+			if err := s.parsePredicateObjectList(subject); err != nil {
+				return false, err
+			}
+		}
+	} else {
+		if subject, err = s.parseObject(); err != nil {
+			return false, err
+		}
+		if err := s.parsePredicateObjectList(subject); err != nil {
+			return false, err
+		}
+	}
+
+	if t, err = s.next(); err != nil {
+		return false, err
+	}
+	if isPunctuation(t, "}") {
+		return true, nil
+	}
+	if !isPunctuation(t, ".") {
+		return false, s.errorf(t, "expected '.' or '}'")
+	}
+	return false, nil
+}
+
+func (s *parserState) parsePredicateObjectList(subject interfaces.ITerm) error {
+	for {
+		t, err := s.peek()
+		if err != nil {
+			return err
+		}
+		var predicate interfaces.ITerm
+		if t.kind == keywordToken && t.value == "a" {
+			s.next()
+			predicate = rdfType
+		} else if predicate, err = s.parseTerm(); err != nil {
+			return err
+		}
+		if predicate.GetType() != interfaces.NamedNodeType {
+			return s.errorf(t, "a predicate needs to be an IRI")
+		}
+
+		for {
+			object, err := s.parseObject()
+			if err != nil {
+				return err
+			}
+			if err := s.emitQuad(subject, predicate, object, s.graph); err != nil {
+				return err
+			}
+			if t, err = s.peek(); err != nil {
+				return err
+			}
+			if !isPunctuation(t, ",") {
+				break
+			}
+			s.next()
+		}
+
+		if !isPunctuation(t, ";") {
+			return nil
+		}
+		for isPunctuation(t, ";") {
+			s.next()
+			if t, err = s.peek(); err != nil {
+				return err
+			}
+		}
+		if isPunctuation(t, ".") || isPunctuation(t, "]") || isPunctuation(t, "}") {
+			return nil
+		}
+	}
+}
+
+func (s *parserState) parseObject() (interfaces.ITerm, error) {
+	t, err := s.peek()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case isPunctuation(t, "[") && s.extended():
+		return s.parseBlankNodePropertyList()
+	case isPunctuation(t, "(") && s.extended():
+		return s.parseCollection()
+	}
+	return s.parseTerm()
+}
+
+func (s *parserState) parseBlankNodePropertyList() (interfaces.ITerm, error) {
+	if err := s.expectPunctuation("["); err != nil {
+		return nil, err
+	}
+	node := NewBlankNode("")
+	t, err := s.peek()
+	if err != nil {
+		return nil, err
+	}
+	if !isPunctuation(t, "]") {
+		if err := s.parsePredicateObjectList(node); err != nil {
+			return nil, err
+		}
+	}
+	return node, s.expectPunctuation("]")
+}
+
+func (s *parserState) parseCollection() (interfaces.ITerm, error) {
+	if err := s.expectPunctuation("("); err != nil {
+		return nil, err
+	}
+	var head interfaces.ITerm = rdfNil
+	var previous interfaces.ITerm
+	for {
+		t, err := s.peek()
+		if err != nil {
+			return nil, err
+		}
+		if isPunctuation(t, ")") {
+			s.next()
+			if previous != nil {
+				if err := s.emitQuad(previous, rdfRest, rdfNil, s.graph); err != nil {
+					return nil, err
+				}
+			}
+			return head, nil
+		}
+		item, err := s.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		node := NewBlankNode("")
+		if previous == nil {
+			head = node
+		} else if err := s.emitQuad(previous, rdfRest, node, s.graph); err != nil {
+			return nil, err
+		}
+		if err := s.emitQuad(node, rdfFirst, item, s.graph); err != nil {
+			return nil, err
+		}
+		previous = node
+	}
+}
+
+func (s *parserState) parseTerm() (interfaces.ITerm, error) {
+	t, err := s.next()
+	if err != nil {
+		return nil, err
+	}
+	switch t.kind {
+	case iriToken:
+		return NewNamedNode(s.resolve(t.value)), nil
+	case blankNodeToken:
+		if s.options.BlankNodeScope != nil {
+			return s.options.BlankNodeScope.Get(t.value), nil
+		}
+		return NewBlankNode(t.value), nil
+	case stringToken:
+		return s.parseLiteral(t)
+	}
+	if !s.extended() {
+		return nil, s.errorf(t, "unexpected %s", describe(t))
+	}
+	switch t.kind {
+	case prefixedNameToken:
+		return s.expandPrefixedName(t)
+	case integerToken:
+		return NewLiteral(t.value, "", IRI.XSD.Integer), nil
+	case decimalToken:
+		return NewLiteral(t.value, "", IRI.XSD.Decimal), nil
+	case doubleToken:
+		return NewLiteral(t.value, "", IRI.XSD.Double), nil
+	case keywordToken:
+		if t.value == "true" || t.value == "false" {
+			return NewLiteral(t.value, "", IRI.XSD.Boolean), nil
+		}
+	case punctuationToken:
+		if t.value == "[" {
+			if err := s.expectPunctuation("]"); err != nil {
+				return nil, err
+			}
+			return NewBlankNode(""), nil
+		}
+	}
+	return nil, s.errorf(t, "unexpected %s", describe(t))
+}
+
+func (s *parserState) parseLiteral(t token) (interfaces.ITerm, error) {
+	next, err := s.peek()
+	if err != nil {
+		return nil, err
+	}
+	switch next.kind {
+	case langTagToken:
+		s.next()
+		literal, err := NewStrictStringLiteral(t.value, next.value)
+		if err != nil {
+			return nil, s.wrap(next, err)
+		}
+		return literal, nil
+	case datatypeToken:
+		s.next()
+		datatype, err := s.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case datatype.kind == iriToken:
+			return NewLiteral(t.value, "", NewNamedNode(s.resolve(datatype.value))), nil
+		case datatype.kind == prefixedNameToken && s.extended():
+			iri, err := s.expandPrefixedName(datatype)
+			if err != nil {
+				return nil, err
+			}
+			return NewLiteral(t.value, "", iri), nil
+		}
+		return nil, s.errorf(datatype, "expected a datatype IRI")
+	}
+	return NewLiteral(t.value, "", IRI.XSD.String), nil
+}
+
+func (s *parserState) expandPrefixedName(t token) (interfaces.INamedNode, error) {
+	namespace, ok := s.prefixes[t.prefix]
+	if !ok {
+		return nil, s.errorf(t, "undefined prefix %q", t.prefix)
+	}
+	return NewNamedNode(namespace + t.value), nil
+}
+
+func (s *parserState) resolve(iri string) string {
+	if s.base == "" || hasScheme(iri) {
+		return iri
+	}
+	resolved, err := rdfiri.Resolve(s.base, iri)
+	if err != nil {
+		return iri
+	}
+	return resolved
+}
+
+func describe(t token) string {
+	switch t.kind {
+	case eofToken:
+		return "end of file"
+	case prefixedNameToken:
+		return fmt.Sprintf("prefixed name %s:%s", t.prefix, t.value)
+	case langTagToken:
+		return fmt.Sprintf("language tag @%s", t.value)
+	case datatypeToken:
+		return "'^^'"
+	case stringToken:
+		return "string"
+	default:
+		return fmt.Sprintf("'%s'", t.value)
+	}
+}
+
+func hasScheme(iri string) bool {
+	end := strings.IndexAny(iri, ":/?#")
+	return end > 0 && iri[end] == ':'
+}
@@ -0,0 +1,264 @@
+package rdfgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	. "github.com/maartyman/rdfgo/lib/stream"
+)
+
+func parseString(t *testing.T, syntax syntax, document string, baseIRI string) ([]interfaces.IQuad, error) {
+	t.Helper()
+	var quads []interfaces.IQuad
+	state := newParserState(syntax, strings.NewReader(document), baseIRI, func(quad interfaces.IQuad) bool {
+		quads = append(quads, quad)
+		return true
+	})
+	return quads, state.parse()
+}
+
+func quadStrings(quads []interfaces.IQuad) []string {
+	var strings []string
+	for _, quad := range quads {
+		strings = append(strings, quad.ToString())
+	}
+	return strings
+}
+
+func TestParser_NTriples(t *testing.T) {
+	document := `# comment
+<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+_:b0 <http://example.com/p> "hello"@en-gb .
+_:b0 <http://example.com/p> "hello"@en--ltr .
+<http://example.com/s> <http://example.com/p> "tab\there \u00E9 \"quoted\""^^<http://example.com/type> . # trailing comment
+<http://example.com/s> <http://example.com/p> "plain" .
+`
+	quads, err := parseString(t, nTriplesSyntax, document, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{
+		`<http://example.com/s> <http://example.com/p> <http://example.com/o> <>`,
+		`_:b0 <http://example.com/p> "hello"@en-GB <>`,
+		`_:b0 <http://example.com/p> "hello"@en--ltr <>`,
+		"<http://example.com/s> <http://example.com/p> \"tab\there é \"quoted\"\"^^<http://example.com/type> <>",
+		`<http://example.com/s> <http://example.com/p> "plain"^^<http://www.w3.org/2001/XMLSchema#string> <>`,
+	}
+	if strings.Join(quadStrings(quads), "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected quads:\n%s", strings.Join(quadStrings(quads), "\n"))
+	}
+}
+
+func TestParser_NQuads(t *testing.T) {
+	document := `<http://example.com/s> <http://example.com/p> <http://example.com/o> <http://example.com/g> .
+<http://example.com/s> <http://example.com/p> <http://example.com/o> _:g .
+<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+`
+	quads, err := parseString(t, nQuadsSyntax, document, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(quads) != 3 {
+		t.Fatalf("Expected 3 quads, got %d", len(quads))
+	}
+	if !quads[0].GetGraph().Equals(NewNamedNode("http://example.com/g")) ||
+		!quads[1].GetGraph().Equals(NewBlankNode("g")) ||
+		!quads[2].GetGraph().Equals(NewDefaultGraph()) {
+		t.Errorf("Unexpected graphs: %v", quadStrings(quads))
+	}
+}
+
+func TestParser_Turtle(t *testing.T) {
+	document := `@base <http://example.com/> .
+@prefix ex: <http://example.com/ns#> .
+PREFIX foaf: <http://xmlns.com/foaf/0.1/>
+
+<alice> a foaf:Person ;
+    foaf:name "Alice"@en, 'Alicia'@es ;
+    foaf:age 42 ;
+    ex:height 1.75 ;
+    ex:weight 6.5e1 ;
+    ex:active true ;
+    ex:knows [ foaf:name "Bob" ] ;
+    ex:list ( 1 ex:two "three" ) ;
+    ex:empty () ;
+    ex:note """multi
+line""" ;
+    ex:typed "x"^^ex:type ;
+    ex:escaped ex:a\.b ;
+    .
+[] ex:p ex:o .
+[ ex:p ex:o ] .
+`
+	quads, err := parseString(t, turtleSyntax, document, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	store := ArrayToStream(quads).ToStore()
+	alice := NewNamedNode("http://example.com/alice")
+	checks := []struct {
+		predicate string
+		object    interfaces.ITerm
+	}{
+		{rdfNamespace + "type", NewNamedNode("http://xmlns.com/foaf/0.1/Person")},
+		{"http://xmlns.com/foaf/0.1/name", NewStringLiteral("Alice", "en")},
+		{"http://xmlns.com/foaf/0.1/name", NewStringLiteral("Alicia", "es")},
+		{"http://xmlns.com/foaf/0.1/age", NewLiteral("42", "", IRI.XSD.Integer)},
+		{"http://example.com/ns#height", NewLiteral("1.75", "", IRI.XSD.Decimal)},
+		{"http://example.com/ns#weight", NewLiteral("6.5e1", "", IRI.XSD.Double)},
+		{"http://example.com/ns#active", NewLiteral("true", "", IRI.XSD.Boolean)},
+		{"http://example.com/ns#empty", rdfNil},
+		{"http://example.com/ns#note", NewLiteral("multi\nline", "", IRI.XSD.String)},
+		{"http://example.com/ns#typed", NewLiteral("x", "", NewNamedNode("http://example.com/ns#type"))},
+		{"http://example.com/ns#escaped", NewNamedNode("http://example.com/ns#a.b")},
+	}
+	for _, check := range checks {
+		if Stream(store.Match(alice, NewNamedNode(check.predicate), check.object, nil)).Count() != 1 {
+			t.Errorf("Expected <alice> <%s> %s", check.predicate, check.object.ToString())
+		}
+	}
+	if Stream(store.Match(nil, rdfFirst, nil, nil)).Count() != 3 || Stream(store.Match(nil, rdfRest, rdfNil, nil)).Count() != 1 {
+		t.Errorf("Expected the collection to produce three rdf:first and one terminating rdf:rest")
+	}
+	if len(quads) != 22 {
+		t.Errorf("Expected 22 quads, got %d:\n%s", len(quads), strings.Join(quadStrings(quads), "\n"))
+	}
+}
+
+func TestParser_TriG(t *testing.T) {
+	document := `@prefix ex: <http://example.com/> .
+ex:s ex:p ex:o .
+ex:g1 { ex:s ex:p ex:o1 . ex:s ex:p ex:o2 }
+GRAPH ex:g2 { [ ex:p ex:o ] . }
+{ ex:s ex:p ex:o3 . }
+_:g { ex:s ex:p ex:o4 }
+`
+	quads, err := parseString(t, triGSyntax, document, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	graphs := []string{"<>", "<http://example.com/g1>", "<http://example.com/g1>", "<http://example.com/g2>", "<>", "_:g"}
+	if len(quads) != len(graphs) {
+		t.Fatalf("Expected %d quads, got %d", len(graphs), len(quads))
+	}
+	for i, graph := range graphs {
+		if quads[i].GetGraph().ToString() != graph {
+			t.Errorf("Expected quad %d to be in graph %s, got %s", i, graph, quads[i].GetGraph().ToString())
+		}
+	}
+}
+
+func TestParser_RelativeIRIs(t *testing.T) {
+	quads, err := parseString(t, turtleSyntax, `<s> <../p> <#o> . <http://other.com/s> <p> <?q> .`, "http://example.com/a/b")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{
+		"<http://example.com/a/s> <http://example.com/p> <http://example.com/a/b#o> <>",
+		"<http://other.com/s> <http://example.com/a/p> <http://example.com/a/b?q> <>",
+	}
+	if strings.Join(quadStrings(quads), "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected quads:\n%s", strings.Join(quadStrings(quads), "\n"))
+	}
+}
+
+func TestParser_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		syntax   syntax
+		document string
+	}{
+		{"MissingDot", nTriplesSyntax, `<s> <p> <o>`},
+		{"TooManyTerms", nTriplesSyntax, `<s> <p> <o> <g> .`},
+		{"TooManyQuadTerms", nQuadsSyntax, `<s> <p> <o> <g> <x> .`},
+		{"TooFewTerms", nQuadsSyntax, `<s> <p> .`},
+		{"LiteralGraph", nQuadsSyntax, `<s> <p> <o> "g" .`},
+		{"PrefixedNameInNTriples", nTriplesSyntax, `ex:s <p> <o> .`},
+		{"LiteralSubject", nTriplesSyntax, `"s" <p> <o> .`},
+		{"UnterminatedIRI", nTriplesSyntax, `<s <p> <o> .`},
+		{"SpaceInIRI", nTriplesSyntax, `<s s> <p> <o> .`},
+		{"InvalidIRIEscape", nTriplesSyntax, `<s\n> <p> <o> .`},
+		{"InvalidUnicodeEscape", nTriplesSyntax, `<s\u00ZZ> <p> <o> .`},
+		{"UnterminatedString", nTriplesSyntax, `<s> <p> "o .`},
+		{"LineBreakInString", nTriplesSyntax, "<s> <p> \"o\n\" ."},
+		{"InvalidStringEscape", nTriplesSyntax, `<s> <p> "\q" .`},
+		{"InvalidLanguage", nTriplesSyntax, `<s> <p> "o"@1 .`},
+		{"IllFormedLanguage", nTriplesSyntax, `<s> <p> "o"@en-toolongsubtag .`},
+		{"InvalidDirection", nTriplesSyntax, `<s> <p> "o"@en--up .`},
+		{"InvalidDatatypeMarker", nTriplesSyntax, `<s> <p> "o"^<t> .`},
+		{"PrefixedDatatypeInNTriples", nTriplesSyntax, `<s> <p> "o"^^ex:t .`},
+		{"InvalidBlankNode", nTriplesSyntax, `_:-b <p> <o> .`},
+		{"UnexpectedCharacter", turtleSyntax, `<s> <p> <o> ! .`},
+		{"UndefinedPrefix", turtleSyntax, `ex:s <p> <o> .`},
+		{"LiteralPredicate", turtleSyntax, `<s> "p" <o> .`},
+		{"BlankNodePredicate", turtleSyntax, `<s> _:p <o> .`},
+		{"InvalidPrefixDirective", turtleSyntax, `@prefix <ex> .`},
+		{"InvalidPrefixIRI", turtleSyntax, `@prefix ex: ex:ns .`},
+		{"InvalidBaseDirective", turtleSyntax, `@base ex:ns .`},
+		{"InvalidBaseIRI", turtleSyntax, `@base <http://[x]/> .`},
+		{"UnterminatedCollection", turtleSyntax, `<s> <p> ( <o> `},
+		{"UnterminatedPropertyList", turtleSyntax, `<s> <p> [ <p> <o> .`},
+		{"InvalidNumber", turtleSyntax, `<s> <p> + .`},
+		{"InvalidPercentEncoding", turtleSyntax, `@prefix ex: <ns#> . ex:a%G1 <p> <o> .`},
+		{"InvalidLocalEscape", turtleSyntax, `@prefix ex: <ns#> . ex:a\q <p> <o> .`},
+		{"LiteralGraphName", triGSyntax, `"g" { <s> <p> <o> }`},
+		{"MissingGraphBrace", triGSyntax, `GRAPH <g> <s> <p> <o> .`},
+		{"UnterminatedGraph", triGSyntax, `<g> { <s> <p> <o> . `},
+		{"InvalidGraphSeparator", triGSyntax, `<g> { <s> <p> <o> ; ; <x> }`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseString(t, tt.syntax, tt.document, "")
+			if err == nil {
+				t.Errorf("Expected an error parsing %q", tt.document)
+			}
+		})
+	}
+}
+
+func TestParser_ErrorPosition(t *testing.T) {
+	_, err := parseString(t, nTriplesSyntax, "<s> <p> <o> .\n<s> <p> ex:o .\n", "")
+	if err == nil || !strings.HasPrefix(err.Error(), "line 2, column 9:") {
+		t.Errorf("Expected an error on line 2, column 9, got %v", err)
+	}
+}
+
+func TestTurtleParser_Parse(t *testing.T) {
+	parser := &turtleParser{syntax: turtleSyntax}
+	stream, err := parser.Parse(strings.NewReader("<s> <p> <o1>, <o2> . <s> <p> error"), "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if Stream(stream).Count() != 2 {
+		t.Errorf("Expected the quads before the error to be streamed")
+	}
+}
+
+func TestParser_BlankNodeScope(t *testing.T) {
+	scope := NewBlankNodeScope()
+	parse := func(options ParseOptions) []interfaces.IQuad {
+		var quads []interfaces.IQuad
+		state := newParserState(turtleSyntax, strings.NewReader("_:a <p> _:b . _:a <p> [] ."), "http://example.com/", func(quad interfaces.IQuad) bool {
+			quads = append(quads, quad)
+			return true
+		})
+		state.options = options
+		if err := state.parse(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return quads
+	}
+
+	quads := parse(ParseOptions{BlankNodeScope: scope})
+	if !quads[0].GetSubject().Equals(scope.Get("a")) || !quads[1].GetSubject().Equals(scope.Get("a")) || !quads[0].GetObject().Equals(scope.Get("b")) {
+		t.Errorf("Expected the labels to get the blank nodes of the scope, got %v", quadStrings(quads))
+	}
+	if other := parse(ParseOptions{BlankNodeScope: NewBlankNodeScope()}); other[0].GetSubject().Equals(quads[0].GetSubject()) {
+		t.Errorf("Expected documents parsed with different scopes to share no blank nodes")
+	}
+	if plain := parse(ParseOptions{}); !plain[0].GetSubject().Equals(NewBlankNode("a")) {
+		t.Errorf("Expected the labels to be kept without a scope, got %v", quadStrings(plain))
+	}
+}
@@ -0,0 +1,248 @@
+package rdfgo
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	stream "github.com/maartyman/rdfgo/lib/stream"
+)
+
+var UnsupportedMediaTypeError = errors.New("unsupported media type")
+var UnknownFormatError = errors.New("could not detect the format")
+
+const sniffSize = 4096
+
+type Format struct {
+	Name       string
+	MediaTypes []string
+	Extensions []string
+	Parser     interfaces.IParser
+	Serializer interfaces.ISerializer
+	Sniff      func(head []byte) bool
+}
+
+// Registry maps media types and file extensions to formats. Formats that are
+// registered later take precedence over earlier ones, so third parties can
+// replace the built-in implementations.
+type Registry struct {
+	formats    []*Format
+	mediaTypes map[string]*Format
+	extensions map[string]*Format
+	mux        sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		mediaTypes: make(map[string]*Format),
+		extensions: make(map[string]*Format),
+	}
+}
+
+var DefaultRegistry = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	registry := NewRegistry()
+	for _, format := range builtinFormats() {
+		registry.Register(format)
+	}
+	return registry
+}
+
+func normalizeMediaType(mediaType string) string {
+	parsed, _, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(mediaType))
+	}
+	return parsed
+}
+
+func normalizeExtension(extension string) string {
+	extension = strings.ToLower(extension)
+	if extension != "" && extension[0] != '.' {
+		extension = "." + extension
+	}
+	return extension
+}
+
+func (r *Registry) Register(format Format) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	registered := &format
+	r.formats = append(r.formats, registered)
+	for _, mediaType := range format.MediaTypes {
+		r.mediaTypes[normalizeMediaType(mediaType)] = registered
+	}
+	for _, extension := range format.Extensions {
+		r.extensions[normalizeExtension(extension)] = registered
+	}
+}
+
+func (r *Registry) Formats() []Format {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	formats := make([]Format, 0, len(r.formats))
+	for _, format := range r.formats {
+		formats = append(formats, *format)
+	}
+	return formats
+}
+
+func (r *Registry) Lookup(mediaType string) (Format, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	format, ok := r.mediaTypes[normalizeMediaType(mediaType)]
+	if !ok {
+		return Format{}, false
+	}
+	return *format, true
+}
+
+func (r *Registry) LookupFile(path string) (Format, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	format, ok := r.extensions[normalizeExtension(filepath.Ext(path))]
+	if !ok {
+		return Format{}, false
+	}
+	return *format, true
+}
+
+// Detect returns the most recently registered format whose sniffer accepts
+// the start of a document.
+func (r *Registry) Detect(head []byte) (Format, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	for i := len(r.formats) - 1; i >= 0; i-- {
+		format := r.formats[i]
+		if format.Sniff != nil && format.Parser != nil && format.Sniff(head) {
+			return *format, true
+		}
+	}
+	return Format{}, false
+}
+
+// Parse parses reader using the parser registered for mediaType. When the
+// media type is empty or unknown, the format is detected from the content.
+func (r *Registry) Parse(reader io.Reader, mediaType string, baseIRI string) (interfaces.IStream, error) {
+	format, reader, err := r.resolve(reader, mediaType)
+	if err != nil {
+		return nil, err
+	}
+	return format.Parser.Parse(reader, baseIRI)
+}
+
+// ParseContext is like Parse, but can be cancelled with ctx and reports
+// syntax errors as configured by options. Parsers that do not implement
+// ContextParser parse strictly.
+func (r *Registry) ParseContext(
+	ctx context.Context,
+	reader io.Reader,
+	mediaType string,
+	baseIRI string,
+	options ParseOptions,
+) interfaces.IQuadStream {
+	format, reader, err := r.resolve(reader, mediaType)
+	if err != nil {
+		return stream.FailedQuadStream(err)
+	}
+	if parser, ok := format.Parser.(ContextParser); ok {
+		return parser.ParseContext(ctx, reader, baseIRI, options)
+	}
+	quadStream, err := format.Parser.Parse(reader, baseIRI)
+	if err != nil {
+		return stream.FailedQuadStream(err)
+	}
+	return stream.FromIStream(ctx, quadStream)
+}
+
+func (r *Registry) resolve(reader io.Reader, mediaType string) (Format, io.Reader, error) {
+	format, ok := r.Lookup(mediaType)
+	if ok && format.Parser != nil {
+		return format, reader, nil
+	}
+	buffered := bufio.NewReaderSize(reader, sniffSize)
+	head, err := buffered.Peek(sniffSize)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return Format{}, nil, err
+	}
+	if format, ok = r.Detect(head); !ok {
+		if mediaType != "" {
+			return Format{}, nil, fmt.Errorf("%w: %s", UnsupportedMediaTypeError, mediaType)
+		}
+		return Format{}, nil, UnknownFormatError
+	}
+	return format, buffered, nil
+}
+
+func (r *Registry) Serialize(
+	writer io.Writer,
+	stream interfaces.IStream,
+	mediaType string,
+	prefixes map[string]string,
+) error {
+	format, ok := r.Lookup(mediaType)
+	if !ok || format.Serializer == nil {
+		for range stream {
+		}
+		return fmt.Errorf("%w: %s", UnsupportedMediaTypeError, mediaType)
+	}
+	return format.Serializer.Serialize(writer, stream, prefixes)
+}
+
+// SerializeSorted is like Serialize, but writes the quads in the order of
+// stream.CompareQuads, so the same quads give the same document whatever
+// order they come in. It reads all quads before it writes any.
+func (r *Registry) SerializeSorted(
+	writer io.Writer,
+	quadStream interfaces.IStream,
+	mediaType string,
+	prefixes map[string]string,
+) error {
+	return r.Serialize(writer, stream.Stream(quadStream).SortedStream().ToIStream(), mediaType, prefixes)
+}
+
+func Register(format Format) {
+	DefaultRegistry.Register(format)
+}
+
+func Lookup(mediaType string) (Format, bool) {
+	return DefaultRegistry.Lookup(mediaType)
+}
+
+func LookupFile(path string) (Format, bool) {
+	return DefaultRegistry.LookupFile(path)
+}
+
+func Detect(head []byte) (Format, bool) {
+	return DefaultRegistry.Detect(head)
+}
+
+func Parse(reader io.Reader, mediaType string, baseIRI string) (interfaces.IStream, error) {
+	return DefaultRegistry.Parse(reader, mediaType, baseIRI)
+}
+
+func ParseContext(
+	ctx context.Context,
+	reader io.Reader,
+	mediaType string,
+	baseIRI string,
+	options ParseOptions,
+) interfaces.IQuadStream {
+	return DefaultRegistry.ParseContext(ctx, reader, mediaType, baseIRI, options)
+}
+
+func Serialize(writer io.Writer, stream interfaces.IStream, mediaType string, prefixes map[string]string) error {
+	return DefaultRegistry.Serialize(writer, stream, mediaType, prefixes)
+}
+
+func SerializeSorted(writer io.Writer, stream interfaces.IStream, mediaType string, prefixes map[string]string) error {
+	return DefaultRegistry.SerializeSorted(writer, stream, mediaType, prefixes)
+}
@@ -0,0 +1,190 @@
+package rdfgo
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	. "github.com/maartyman/rdfgo/lib/stream"
+)
+
+func TestRegistry_Lookup(t *testing.T) {
+	tests := []struct {
+		mediaType string
+		name      string
+	}{
+		{"text/turtle", "Turtle"},
+		{"text/turtle; charset=utf-8", "Turtle"},
+		{"Application/N-Quads", "N-Quads"},
+		{"application/n-triples", "N-Triples"},
+		{"application/trig", "TriG"},
+		{"application/vnd.hdt", "HDT"},
+	}
+	for _, tt := range tests {
+		format, ok := Lookup(tt.mediaType)
+		if !ok || format.Name != tt.name {
+			t.Errorf("Expected %s to map to %s, got %s", tt.mediaType, tt.name, format.Name)
+		}
+	}
+	if format, ok := Lookup("application/ld+json; charset=utf-8"); !ok || format.Name != "JSON-LD" {
+		t.Errorf("Expected JSON-LD to be registered by default")
+	}
+
+	for path, name := range map[string]string{"data.ttl": "Turtle", "DATA.NQ": "N-Quads", "/tmp/x.nt": "N-Triples", "a.trig": "TriG", "a.hdt": "HDT", "a.jsonld": "JSON-LD"} {
+		format, ok := LookupFile(path)
+		if !ok || format.Name != name {
+			t.Errorf("Expected %s to map to %s, got %s", path, name, format.Name)
+		}
+	}
+	if _, ok := LookupFile("data.unknown"); ok {
+		t.Errorf("Expected an unknown extension not to map to a format")
+	}
+}
+
+func TestRegistry_Detect(t *testing.T) {
+	var hdtBuffer bytes.Buffer
+	if err := Serialize(&hdtBuffer, ArrayToStream(serializerQuads()[:2]).ToIStream(), HDTMediaType, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	long := strings.Repeat("<http://example.com/s> <http://example.com/p> \"a long literal value\" .\n", 200)
+	tests := []struct {
+		name     string
+		document string
+		expected string
+	}{
+		{"NTriples", "<s> <p> <o> .\n<s> <p> \"o\"@en .\n", "N-Triples"},
+		{"NTriplesTruncated", long[:sniffSize+10], "N-Triples"},
+		{"NQuads", "<s> <p> <o> .\n<s> <p> <o> <g> .\n", "N-Quads"},
+		{"Turtle", "@prefix ex: <http://example.com/> .\nex:s a ex:Type .\n", "Turtle"},
+		{"TurtlePrefixesOnly", "PREFIX ex: <http://example.com/>\n", "Turtle"},
+		{"TriG", "@prefix ex: <http://example.com/> .\nex:g { ex:s a ex:Type }\n", "TriG"},
+		{"HDT", hdtBuffer.String(), "HDT"},
+		{"JSONLD", "{\"@context\": {}}", "JSON-LD"},
+		{"JSONLDArray", "\n[{\"@id\": \"http://example.com/s\"}]", "JSON-LD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, ok := Detect([]byte(tt.document))
+			if !ok || format.Name != tt.expected {
+				t.Errorf("Expected %s, got %s (%t)", tt.expected, format.Name, ok)
+			}
+		})
+	}
+	for _, document := range []string{"", "{\"a\": 1}", "<?xml version=\"1.0\"?>", "just some text"} {
+		if format, ok := Detect([]byte(document)); ok {
+			t.Errorf("Expected %q not to be detected, got %s", document, format.Name)
+		}
+	}
+}
+
+func TestRegistry_ParseSerialize(t *testing.T) {
+	stream, err := Parse(strings.NewReader("<http://example.com/s> <http://example.com/p> <http://example.com/o> ."), "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var buffer bytes.Buffer
+	if err := Serialize(&buffer, stream, "application/n-quads", nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if buffer.String() != "<http://example.com/s> <http://example.com/p> <http://example.com/o> .\n" {
+		t.Errorf("Unexpected output %q", buffer.String())
+	}
+
+	stream, err = Parse(bytes.NewReader(buffer.Bytes()), "text/turtle", "")
+	if err != nil || Stream(stream).Count() != 1 {
+		t.Errorf("Expected the document to be parsed as Turtle, got %v", err)
+	}
+	stream, err = Parse(bytes.NewReader(buffer.Bytes()), "application/x-unknown", "")
+	if err != nil || Stream(stream).Count() != 1 {
+		t.Errorf("Expected the format of an unknown media type to be detected, got %v", err)
+	}
+
+	var hdtBuffer bytes.Buffer
+	_ = Serialize(&hdtBuffer, ArrayToStream(serializerQuads()[:3]).ToIStream(), HDTMediaType, nil)
+	stream, err = Parse(&hdtBuffer, HDTMediaType, "")
+	if err != nil || Stream(stream).Count() != 3 {
+		t.Errorf("Expected the HDT document to be parsed, got %v", err)
+	}
+}
+
+func TestRegistry_Errors(t *testing.T) {
+	if _, err := Parse(strings.NewReader("not rdf"), "", ""); !errors.Is(err, UnknownFormatError) {
+		t.Errorf("Expected UnknownFormatError, got %v", err)
+	}
+	if _, err := Parse(strings.NewReader("not rdf"), "application/x-unknown", ""); !errors.Is(err, UnsupportedMediaTypeError) {
+		t.Errorf("Expected UnsupportedMediaTypeError, got %v", err)
+	}
+	if _, err := Parse(failingReader{}, "", ""); err == nil || errors.Is(err, UnknownFormatError) {
+		t.Errorf("Expected the read error, got %v", err)
+	}
+	if _, err := Parse(strings.NewReader("$HDT broken"), HDTMediaType, ""); err == nil {
+		t.Errorf("Expected an error parsing a broken HDT file")
+	}
+	if _, err := Parse(failingReader{}, HDTMediaType, ""); err == nil {
+		t.Errorf("Expected the read error parsing an HDT file")
+	}
+	err := Serialize(io.Discard, ArrayToStream(serializerQuads()).ToIStream(), "application/x-unknown", nil)
+	if !errors.Is(err, UnsupportedMediaTypeError) {
+		t.Errorf("Expected UnsupportedMediaTypeError, got %v", err)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+type upperCaseParser struct{}
+
+func (upperCaseParser) Parse(reader io.Reader, _ string) (interfaces.IStream, error) {
+	data, _ := io.ReadAll(reader)
+	quad, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewLiteral(strings.ToUpper(string(data)), "", IRI.XSD.String), nil)
+	return ArrayToStream([]interfaces.IQuad{quad}).ToIStream(), nil
+}
+
+func TestRegistry_ThirdPartyFormat(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(Format{
+		Name:       "Shout",
+		MediaTypes: []string{"text/x-shout"},
+		Extensions: []string{"shout"},
+		Parser:     upperCaseParser{},
+		Sniff: func(head []byte) bool {
+			return bytes.HasPrefix(head, []byte("!"))
+		},
+	})
+
+	if format, ok := registry.LookupFile("file.SHOUT"); !ok || format.Name != "Shout" {
+		t.Errorf("Expected the extension to be registered")
+	}
+	if len(registry.Formats()) != 1 {
+		t.Errorf("Expected one registered format, got %d", len(registry.Formats()))
+	}
+	stream, err := registry.Parse(strings.NewReader("!hello"), "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	quads := Stream(stream).ToArray()
+	if len(quads) != 1 || quads[0].GetObject().GetValue() != "!HELLO" {
+		t.Errorf("Expected the third party parser to be used")
+	}
+	if err := registry.Serialize(io.Discard, ArrayToStream(nil).ToIStream(), "text/x-shout", nil); !errors.Is(err, UnsupportedMediaTypeError) {
+		t.Errorf("Expected a format without serializer to be unsupported, got %v", err)
+	}
+}
+
+func TestRegistry_Override(t *testing.T) {
+	registry := newDefaultRegistry()
+	registry.Register(Format{Name: "Custom Turtle", MediaTypes: []string{TurtleMediaType}, Parser: upperCaseParser{}})
+	if format, _ := registry.Lookup(TurtleMediaType); format.Name != "Custom Turtle" {
+		t.Errorf("Expected the later registration to take precedence, got %s", format.Name)
+	}
+	if format, _ := registry.Lookup("application/x-turtle"); format.Name != "Turtle" {
+		t.Errorf("Expected other media types to keep their format, got %s", format.Name)
+	}
+}
@@ -0,0 +1,214 @@
+package rdfgo
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	langtag "github.com/maartyman/rdfgo/lib/langtag"
+)
+
+var UnsupportedTermError = errors.New("term can not be serialized")
+
+var integerPattern = regexp.MustCompile(`^[+-]?[0-9]+$`)
+var localNamePattern = regexp.MustCompile(`^[A-Za-z_0-9]([A-Za-z_0-9.-]*[A-Za-z_0-9-])?$`)
+
+type nQuadsSerializer struct {
+	syntax syntax
+}
+
+func (s *nQuadsSerializer) Serialize(writer io.Writer, stream interfaces.IStream, _ map[string]string) error {
+	w := bufio.NewWriter(writer)
+	var err error
+	for quad := range stream {
+		if quad == nil || err != nil {
+			continue
+		}
+		terms := []interfaces.ITerm{quad.GetSubject(), quad.GetPredicate(), quad.GetObject()}
+		if s.syntax == nQuadsSyntax && quad.GetGraph().GetType() != interfaces.DefaultGraphType {
+			terms = append(terms, quad.GetGraph())
+		}
+		for _, term := range terms {
+			var value string
+			if value, err = formatTerm(term, nil, false); err != nil {
+				break
+			}
+			_, _ = w.WriteString(value)
+			_ = w.WriteByte(' ')
+		}
+		_, _ = w.WriteString(".\n")
+	}
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// turtleSerializer writes quads in the order they are received, grouping
+// consecutive quads that share a subject (and predicate) with ';' and ','.
+type turtleSerializer struct {
+	syntax syntax
+}
+
+func (s *turtleSerializer) Serialize(writer io.Writer, stream interfaces.IStream, prefixes map[string]string) error {
+	w := bufio.NewWriter(writer)
+	names := make([]string, 0, len(prefixes))
+	for name := range prefixes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		_, _ = fmt.Fprintf(w, "@prefix %s: <%s> .\n", name, escapeIRI(prefixes[name]))
+	}
+	if len(names) > 0 {
+		_ = w.WriteByte('\n')
+	}
+
+	var graph, subject, predicate interfaces.ITerm
+	var err error
+	closeStatement := func() {
+		if subject != nil {
+			_, _ = w.WriteString(" .\n")
+		}
+		if graph != nil && graph.GetType() != interfaces.DefaultGraphType {
+			_, _ = w.WriteString("}\n")
+		}
+	}
+	for quad := range stream {
+		if quad == nil || err != nil {
+			continue
+		}
+		var subjectString, predicateString, objectString string
+		if subjectString, err = formatTerm(quad.GetSubject(), prefixes, true); err != nil {
+			continue
+		}
+		if predicateString, err = formatTerm(quad.GetPredicate(), prefixes, true); err != nil {
+			continue
+		}
+		if rdfType.Equals(quad.GetPredicate()) {
+			predicateString = "a"
+		}
+		if objectString, err = formatTerm(quad.GetObject(), prefixes, true); err != nil {
+			continue
+		}
+
+		if s.syntax == triGSyntax && (graph == nil || !graph.Equals(quad.GetGraph())) {
+			closeStatement()
+			subject, predicate = nil, nil
+			graph = quad.GetGraph()
+			if graph.GetType() != interfaces.DefaultGraphType {
+				var graphString string
+				if graphString, err = formatTerm(graph, prefixes, true); err != nil {
+					continue
+				}
+				_, _ = fmt.Fprintf(w, "%s {\n", graphString)
+			}
+		}
+
+		switch {
+		case subject != nil && subject.Equals(quad.GetSubject()) && predicate.Equals(quad.GetPredicate()):
+			_, _ = fmt.Fprintf(w, ",\n        %s", objectString)
+		case subject != nil && subject.Equals(quad.GetSubject()):
+			_, _ = fmt.Fprintf(w, " ;\n    %s %s", predicateString, objectString)
+		default:
+			if subject != nil {
+				_, _ = w.WriteString(" .\n")
+			}
+			_, _ = fmt.Fprintf(w, "%s %s %s", subjectString, predicateString, objectString)
+		}
+		subject, predicate = quad.GetSubject(), quad.GetPredicate()
+	}
+	if err != nil {
+		return err
+	}
+	closeStatement()
+	return w.Flush()
+}
+
+func formatTerm(term interfaces.ITerm, prefixes map[string]string, abbreviate bool) (string, error) {
+	switch term.GetType() {
+	case interfaces.NamedNodeType:
+		if prefixed := formatPrefixed(term.GetValue(), prefixes); prefixed != "" {
+			return prefixed, nil
+		}
+		return "<" + escapeIRI(term.GetValue()) + ">", nil
+	case interfaces.BlankNodeType:
+		return "_:" + term.GetValue(), nil
+	case interfaces.LiteralType:
+		literal := term.(interfaces.ILiteral)
+		value := "\"" + escapeString(literal.GetValue()) + "\""
+		if literal.GetLanguage() != "" {
+			return value + "@" + langtag.JoinDirection(literal.GetLanguage(), literal.GetDirection()), nil
+		}
+		datatype := literal.GetDatatype()
+		if datatype == nil || datatype.Equals(IRI.XSD.String) {
+			return value, nil
+		}
+		if abbreviate {
+			if datatype.Equals(IRI.XSD.Integer) && integerPattern.MatchString(literal.GetValue()) {
+				return literal.GetValue(), nil
+			}
+			if datatype.Equals(IRI.XSD.Boolean) && (literal.GetValue() == "true" || literal.GetValue() == "false") {
+				return literal.GetValue(), nil
+			}
+		}
+		datatypeString, err := formatTerm(datatype, prefixes, abbreviate)
+		if err != nil {
+			return "", err
+		}
+		return value + "^^" + datatypeString, nil
+	default:
+		return "", fmt.Errorf("%w: %s", UnsupportedTermError, term.ToString())
+	}
+}
+
+func formatPrefixed(iri string, prefixes map[string]string) string {
+	best := ""
+	for name, namespace := range prefixes {
+		if !strings.HasPrefix(iri, namespace) || !localNamePattern.MatchString(iri[len(namespace):]) {
+			continue
+		}
+		candidate := name + ":" + iri[len(namespace):]
+		if best == "" || len(candidate) < len(best) || (len(candidate) == len(best) && candidate < best) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+func escapeIRI(iri string) string {
+	var builder strings.Builder
+	for _, r := range iri {
+		if r <= ' ' || strings.ContainsRune("<>\"{}|^`\\", r) {
+			_, _ = fmt.Fprintf(&builder, "\\u%04X", r)
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+func escapeString(value string) string {
+	var builder strings.Builder
+	for _, r := range value {
+		switch r {
+		case '"':
+			builder.WriteString("\\\"")
+		case '\\':
+			builder.WriteString("\\\\")
+		case '\n':
+			builder.WriteString("\\n")
+		case '\r':
+			builder.WriteString("\\r")
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,132 @@
+package rdfgo
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	. "github.com/maartyman/rdfgo/lib/stream"
+)
+
+func serializerQuads() []interfaces.IQuad {
+	var quads []interfaces.IQuad
+	add := func(s interfaces.ITerm, p interfaces.ITerm, o interfaces.ITerm, g interfaces.ITerm) {
+		quad, _ := NewQuad(s, p, o, g)
+		quads = append(quads, quad)
+	}
+	s := NewNamedNode("http://example.com/s")
+	p := NewNamedNode("http://example.com/p")
+	g := NewNamedNode("http://example.com/g")
+	add(s, rdfType, NewNamedNode("http://example.com/Type"), nil)
+	add(s, p, NewNamedNode("http://example.com/o"), nil)
+	add(s, p, NewLiteral("line\nbreak \"quoted\" \\", "", IRI.XSD.String), nil)
+	add(s, p, NewStringLiteral("hallo", "nl"), nil)
+	add(s, p, NewStringLiteral("مرحبا", "ar--rtl"), nil)
+	add(s, NewNamedNode("http://example.com/q"), NewIntegerLiteral(7), nil)
+	add(NewBlankNode("b0"), p, NewBooleanLiteral(false), nil)
+	add(NewBlankNode("b0"), p, NewDecimalLiteral(1.5), g)
+	add(NewNamedNode("http://example.com/weird iri{"), p, NewLiteral("x", "", NewNamedNode("http://example.com/other#t")), g)
+	add(s, p, NewNamedNode("http://example.com/o"), NewBlankNode("g"))
+	return quads
+}
+
+func roundTrip(t *testing.T, serializer interfaces.ISerializer, parser interfaces.IParser, prefixes map[string]string) (string, []interfaces.IQuad) {
+	t.Helper()
+	var buffer bytes.Buffer
+	if err := serializer.Serialize(&buffer, ArrayToStream(serializerQuads()).ToIStream(), prefixes); err != nil {
+		t.Fatalf("Unexpected error serializing: %v", err)
+	}
+	stream, err := parser.Parse(bytes.NewReader(buffer.Bytes()), "")
+	if err != nil {
+		t.Fatalf("Unexpected error parsing: %v", err)
+	}
+	return buffer.String(), Stream(stream).ToArray()
+}
+
+func TestSerializer_RoundTrip(t *testing.T) {
+	prefixes := map[string]string{"ex": "http://example.com/", "xsd": "http://www.w3.org/2001/XMLSchema#"}
+	tests := []struct {
+		name       string
+		serializer interfaces.ISerializer
+		parser     interfaces.IParser
+		graphs     bool
+	}{
+		{"NQuads", &nQuadsSerializer{syntax: nQuadsSyntax}, &turtleParser{syntax: nQuadsSyntax}, true},
+		{"NTriples", &nQuadsSerializer{syntax: nTriplesSyntax}, &turtleParser{syntax: nTriplesSyntax}, false},
+		{"Turtle", &turtleSerializer{syntax: turtleSyntax}, &turtleParser{syntax: turtleSyntax}, false},
+		{"TriG", &turtleSerializer{syntax: triGSyntax}, &turtleParser{syntax: triGSyntax}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, parsed := roundTrip(t, tt.serializer, tt.parser, prefixes)
+			expected := serializerQuads()
+			if len(parsed) != len(expected) {
+				t.Fatalf("Expected %d quads, got %d from:\n%s", len(expected), len(parsed), document)
+			}
+			for i, quad := range expected {
+				if !tt.graphs {
+					quad, _ = NewQuad(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), nil)
+				}
+				if !quad.Equals(parsed[i]) {
+					t.Errorf("Expected %s, got %s from:\n%s", quad.ToString(), parsed[i].ToString(), document)
+				}
+			}
+		})
+	}
+}
+
+func TestTurtleSerializer_Abbreviations(t *testing.T) {
+	prefixes := map[string]string{"ex": "http://example.com/"}
+	document, _ := roundTrip(t, &turtleSerializer{syntax: turtleSyntax}, &turtleParser{syntax: turtleSyntax}, prefixes)
+	for _, expected := range []string{
+		"@prefix ex: <http://example.com/> .\n",
+		"ex:s a ex:Type ;\n    ex:p ex:o,\n",
+		"ex:q 7 .\n",
+		"_:b0 ex:p false,\n        \"1.5\"^^<http://www.w3.org/2001/XMLSchema#decimal> .\n",
+		"<http://example.com/weird\\u0020iri\\u007B> ex:p \"x\"^^<http://example.com/other#t> .\n",
+	} {
+		if !strings.Contains(document, expected) {
+			t.Errorf("Expected the document to contain %q:\n%s", expected, document)
+		}
+	}
+}
+
+func TestSerializer_UnsupportedTerm(t *testing.T) {
+	inner, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil)
+	quad, _ := NewQuad(inner, NewNamedNode("p"), NewNamedNode("o"), nil)
+	literal, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewLiteral("o", "", NewNamedNode("t")), nil)
+	for _, serializer := range []interfaces.ISerializer{
+		&nQuadsSerializer{syntax: nQuadsSyntax},
+		&turtleSerializer{syntax: turtleSyntax},
+	} {
+		stream := ArrayToStream([]interfaces.IQuad{literal, nil, quad, quad})
+		err := serializer.Serialize(&bytes.Buffer{}, stream.ToIStream(), nil)
+		if !errors.Is(err, UnsupportedTermError) {
+			t.Errorf("Expected UnsupportedTermError, got %v", err)
+		}
+	}
+}
+
+func TestSerializeSorted(t *testing.T) {
+	quads := serializerQuads()
+	reversed := make([]interfaces.IQuad, len(quads))
+	for i, quad := range quads {
+		reversed[len(quads)-1-i] = quad
+	}
+	var first, second bytes.Buffer
+	if err := SerializeSorted(&first, ArrayToStream(quads).ToIStream(), "application/trig", nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := SerializeSorted(&second, ArrayToStream(reversed).ToIStream(), "application/trig", nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first.String() != second.String() {
+		t.Errorf("Expected the same document for the quads in another order, got\n%s\nand\n%s", first.String(), second.String())
+	}
+	if !strings.HasPrefix(first.String(), "_:b0 <http://example.com/p> false .\n") || strings.Count(first.String(), "<http://example.com/g> {") != 1 {
+		t.Errorf("Expected the quads of the default graph first and the quads of a graph together, got\n%s", first.String())
+	}
+}
@@ -0,0 +1,59 @@
+package rdfgo
+
+import (
+	"bytes"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+type sniffResult struct {
+	valid       bool
+	statements  int
+	namedGraphs bool
+}
+
+// sniffSyntax parses the start of a document in the given syntax. A document
+// that is cut off at the end of head is still considered valid when every
+// complete statement before the cut could be parsed.
+func sniffSyntax(head []byte, syntax syntax) sniffResult {
+	truncated := len(head) >= sniffSize
+	if truncated {
+		if end := bytes.LastIndexByte(head, '\n'); end >= 0 {
+			head = head[:end+1]
+		}
+	}
+	result := sniffResult{}
+	state := newParserState(syntax, bytes.NewReader(head), "", func(quad interfaces.IQuad) bool {
+		result.statements++
+		if quad.GetGraph().GetType() != interfaces.DefaultGraphType {
+			result.namedGraphs = true
+		}
+		return true
+	})
+	err := state.parse()
+	result.valid = err == nil || (truncated && state.lexer.err != nil)
+	if syntax == turtleSyntax || syntax == triGSyntax {
+		result.valid = result.valid && (result.statements > 0 || len(state.prefixes) > 0)
+	} else {
+		result.valid = result.valid && result.statements > 0
+	}
+	return result
+}
+
+func sniffNTriples(head []byte) bool {
+	return sniffSyntax(head, nTriplesSyntax).valid
+}
+
+func sniffNQuads(head []byte) bool {
+	result := sniffSyntax(head, nQuadsSyntax)
+	return result.valid && result.namedGraphs
+}
+
+func sniffTriG(head []byte) bool {
+	result := sniffSyntax(head, triGSyntax)
+	return result.valid && result.namedGraphs
+}
+
+func sniffTurtle(head []byte) bool {
+	return sniffSyntax(head, turtleSyntax).valid
+}
@@ -0,0 +1,103 @@
+package rdfgo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+var CRSMismatchError = errors.New("geometries have different coordinate reference systems")
+var UnsupportedUnitError = errors.New("unsupported unit")
+var ArgumentError = errors.New("invalid arguments")
+
+// The units of measure supported by geof:distance.
+const (
+	UnitNamespace = "http://www.opengis.net/def/uom/OGC/1.0/"
+	Metre         = UnitNamespace + "metre"
+	Degree        = UnitNamespace + "degree"
+)
+
+// earthRadius is the mean radius of the earth in metres.
+const earthRadius = 6371008.8
+
+// Function is a filter function that a query engine can call with the terms
+// its arguments evaluate to.
+type Function func(arguments ...interfaces.ITerm) (interfaces.ITerm, error)
+
+// Functions holds the GeoSPARQL functions by IRI.
+var Functions = map[string]Function{
+	FunctionNamespace + "sfIntersects": relation(Geometry.Intersects),
+	FunctionNamespace + "sfContains":   relation(Geometry.Contains),
+	FunctionNamespace + "sfWithin":     relation(Geometry.Within),
+	FunctionNamespace + "distance":     distance,
+}
+
+// geometries parses the first count arguments as wktLiterals with the same
+// coordinate reference system.
+func geometries(arguments []interfaces.ITerm, count int) ([]Geometry, error) {
+	if len(arguments) != count {
+		return nil, fmt.Errorf("%w: expected %d arguments, got %d", ArgumentError, count, len(arguments))
+	}
+	result := make([]Geometry, 0, 2)
+	for _, argument := range arguments[:2] {
+		literal, ok := argument.(interfaces.ILiteral)
+		if !ok || argument.GetType() != interfaces.LiteralType {
+			return nil, fmt.Errorf("%w: %s", NotGeometryError, argument.ToString())
+		}
+		geometry, err := ParseLiteral(literal)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, geometry)
+	}
+	if result[0].CRS != result[1].CRS {
+		return nil, fmt.Errorf("%w: %s and %s", CRSMismatchError, result[0].CRS, result[1].CRS)
+	}
+	return result, nil
+}
+
+func relation(test func(Geometry, Geometry) bool) Function {
+	return func(arguments ...interfaces.ITerm) (interfaces.ITerm, error) {
+		geometries, err := geometries(arguments, 2)
+		if err != nil {
+			return nil, err
+		}
+		return NewBooleanLiteral(test(geometries[0], geometries[1])), nil
+	}
+}
+
+// distance is geof:distance. It measures in the plane of the coordinates, so
+// for CRS84 in degrees. In metres it returns the great circle distance
+// between the points that are closest in that plane.
+func distance(arguments ...interfaces.ITerm) (interfaces.ITerm, error) {
+	geometries, err := geometries(arguments, 3)
+	if err != nil {
+		return nil, err
+	}
+	a, b := geometries[0], geometries[1]
+	if a.IsEmpty() || b.IsEmpty() {
+		return nil, fmt.Errorf("%w: the distance to an empty geometry", ArgumentError)
+	}
+	unit := arguments[2].GetValue()
+	if a.CRS != CRS84 || unit != Degree && unit != Metre {
+		return nil, fmt.Errorf("%w: %s in %s", UnsupportedUnitError, unit, a.CRS)
+	}
+	degrees, from, to := a.closest(b)
+	if unit == Degree {
+		return NewDoubleLiteral(degrees), nil
+	}
+	return NewDoubleLiteral(greatCircle(from, to)), nil
+}
+
+// greatCircle returns the distance in metres between two points with a
+// longitude and a latitude in degrees.
+func greatCircle(from Point, to Point) float64 {
+	radians := math.Pi / 180
+	latitude := (to.Y - from.Y) * radians
+	longitude := (to.X - from.X) * radians
+	h := math.Pow(math.Sin(latitude/2), 2) + math.Cos(from.Y*radians)*math.Cos(to.Y*radians)*math.Pow(math.Sin(longitude/2), 2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(min(1, h)))
+}
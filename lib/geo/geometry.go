@@ -0,0 +1,352 @@
+package rdfgo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+var InvalidWKTError = errors.New("invalid WKT")
+var NotGeometryError = errors.New("not a geometry literal")
+
+const (
+	Namespace         = "http://www.opengis.net/ont/geosparql#"
+	FunctionNamespace = "http://www.opengis.net/def/function/geosparql/"
+	WKTLiteral        = Namespace + "wktLiteral"
+	// CRS84 is the coordinate reference system of a wktLiteral without one,
+	// with longitude before latitude.
+	CRS84 = "http://www.opengis.net/def/crs/OGC/1.3/CRS84"
+)
+
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Geometry is a geometry of a wktLiteral. Collections are flattened, so a
+// geometry is a set of points, lines and polygons. A line is a list of at
+// least two points, a polygon a list of closed rings of which the first is
+// the exterior and the others are holes. Z and M coordinates are dropped.
+type Geometry struct {
+	CRS      string
+	Points   []Point
+	Lines    [][]Point
+	Polygons [][][]Point
+}
+
+// Box is an axis-aligned rectangle.
+type Box struct {
+	MinX float64
+	MinY float64
+	MaxX float64
+	MaxY float64
+}
+
+// NewBox returns a polygon in CRS84 that covers a box.
+func NewBox(box Box) Geometry {
+	return Geometry{CRS: CRS84, Polygons: [][][]Point{{{
+		{box.MinX, box.MinY}, {box.MaxX, box.MinY}, {box.MaxX, box.MaxY}, {box.MinX, box.MaxY}, {box.MinX, box.MinY},
+	}}}}
+}
+
+func (g Geometry) IsEmpty() bool {
+	return len(g.Points) == 0 && len(g.Lines) == 0 && len(g.Polygons) == 0
+}
+
+// Bounds returns the smallest box that covers the geometry.
+func (g Geometry) Bounds() Box {
+	box := Box{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	extend := func(points []Point) {
+		for _, point := range points {
+			box.MinX, box.MinY = min(box.MinX, point.X), min(box.MinY, point.Y)
+			box.MaxX, box.MaxY = max(box.MaxX, point.X), max(box.MaxY, point.Y)
+		}
+	}
+	extend(g.Points)
+	for _, line := range g.Lines {
+		extend(line)
+	}
+	for _, polygon := range g.Polygons {
+		extend(polygon[0])
+	}
+	return box
+}
+
+func (b Box) Intersects(other Box) bool {
+	return b.MinX <= other.MaxX && other.MinX <= b.MaxX && b.MinY <= other.MaxY && other.MinY <= b.MaxY
+}
+
+func (b Box) union(other Box) Box {
+	return Box{min(b.MinX, other.MinX), min(b.MinY, other.MinY), max(b.MaxX, other.MaxX), max(b.MaxY, other.MaxY)}
+}
+
+func (b Box) area() float64 {
+	return (b.MaxX - b.MinX) * (b.MaxY - b.MinY)
+}
+
+// ParseLiteral parses a geo:wktLiteral.
+func ParseLiteral(literal interfaces.ILiteral) (Geometry, error) {
+	if literal.GetDatatype() == nil || literal.GetDatatype().GetValue() != WKTLiteral {
+		return Geometry{}, fmt.Errorf("%w: %s", NotGeometryError, literal.ToString())
+	}
+	return ParseWKT(literal.GetValue())
+}
+
+// ParseWKT parses the lexical form of a wktLiteral: a WKT geometry, optionally
+// preceded by the IRI of its coordinate reference system between angle
+// brackets.
+func ParseWKT(text string) (Geometry, error) {
+	geometry := Geometry{CRS: CRS84}
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "<") {
+		end := strings.Index(text, ">")
+		if end == -1 {
+			return Geometry{}, fmt.Errorf("%w: unterminated CRS IRI", InvalidWKTError)
+		}
+		geometry.CRS, text = text[1:end], text[end+1:]
+	}
+	parser := &wktParser{tokens: wktTokens(text)}
+	if err := parser.geometry(&geometry); err != nil {
+		return Geometry{}, fmt.Errorf("%w: %v", InvalidWKTError, err)
+	}
+	if parser.position != len(parser.tokens) {
+		return Geometry{}, fmt.Errorf("%w: unexpected %q", InvalidWKTError, parser.tokens[parser.position])
+	}
+	return geometry, nil
+}
+
+// wktTokens splits WKT into words, numbers and the characters ( ) and ,.
+func wktTokens(text string) []string {
+	var tokens []string
+	start := -1
+	for i, r := range text {
+		separator := unicode.IsSpace(r) || r == '(' || r == ')' || r == ','
+		if separator && start != -1 {
+			tokens = append(tokens, text[start:i])
+			start = -1
+		}
+		if r == '(' || r == ')' || r == ',' {
+			tokens = append(tokens, string(r))
+		} else if !separator && start == -1 {
+			start = i
+		}
+	}
+	if start != -1 {
+		tokens = append(tokens, text[start:])
+	}
+	return tokens
+}
+
+type wktParser struct {
+	tokens   []string
+	position int
+}
+
+func (p *wktParser) peek() string {
+	if p.position < len(p.tokens) {
+		return p.tokens[p.position]
+	}
+	return ""
+}
+
+func (p *wktParser) expect(token string) error {
+	if p.peek() != token {
+		return fmt.Errorf("expected %q, got %q", token, p.peek())
+	}
+	p.position++
+	return nil
+}
+
+// list parses a parenthesized, comma separated list.
+func (p *wktParser) list(item func() error) error {
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+		if p.peek() != "," {
+			return p.expect(")")
+		}
+		p.position++
+	}
+}
+
+func (p *wktParser) geometry(geometry *Geometry) error {
+	kind := strings.ToUpper(p.peek())
+	p.position++
+	switch strings.ToUpper(p.peek()) {
+	case "Z", "M", "ZM":
+		p.position++
+	}
+	if strings.ToUpper(p.peek()) == "EMPTY" {
+		p.position++
+		return nil
+	}
+	switch kind {
+	case "POINT":
+		return p.list(func() error {
+			point, err := p.point()
+			geometry.Points = append(geometry.Points, point)
+			return err
+		})
+	case "LINESTRING":
+		line, err := p.line(2)
+		geometry.Lines = append(geometry.Lines, line)
+		return err
+	case "POLYGON":
+		polygon, err := p.polygon()
+		geometry.Polygons = append(geometry.Polygons, polygon)
+		return err
+	case "MULTIPOINT":
+		return p.list(func() error {
+			point, err := p.point()
+			if p.peek() == "(" {
+				err = p.list(func() error {
+					point, err = p.point()
+					return err
+				})
+			}
+			geometry.Points = append(geometry.Points, point)
+			return err
+		})
+	case "MULTILINESTRING":
+		return p.list(func() error {
+			line, err := p.line(2)
+			geometry.Lines = append(geometry.Lines, line)
+			return err
+		})
+	case "MULTIPOLYGON":
+		return p.list(func() error {
+			polygon, err := p.polygon()
+			geometry.Polygons = append(geometry.Polygons, polygon)
+			return err
+		})
+	case "GEOMETRYCOLLECTION":
+		return p.list(func() error {
+			return p.geometry(geometry)
+		})
+	}
+	return fmt.Errorf("unknown geometry type %q", kind)
+}
+
+// point parses two to four coordinates and keeps the first two.
+func (p *wktParser) point() (Point, error) {
+	var coordinates []float64
+	for len(coordinates) < 4 {
+		value, err := strconv.ParseFloat(p.peek(), 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			break
+		}
+		coordinates = append(coordinates, value)
+		p.position++
+	}
+	if len(coordinates) < 2 {
+		return Point{}, fmt.Errorf("expected coordinates, got %q", p.peek())
+	}
+	return Point{coordinates[0], coordinates[1]}, nil
+}
+
+func (p *wktParser) line(size int) ([]Point, error) {
+	var line []Point
+	err := p.list(func() error {
+		point, err := p.point()
+		line = append(line, point)
+		return err
+	})
+	if err == nil && len(line) < size {
+		err = fmt.Errorf("expected at least %d points, got %d", size, len(line))
+	}
+	return line, err
+}
+
+func (p *wktParser) polygon() ([][]Point, error) {
+	var rings [][]Point
+	err := p.list(func() error {
+		ring, err := p.line(4)
+		if err == nil && ring[0] != ring[len(ring)-1] {
+			err = errors.New("ring is not closed")
+		}
+		rings = append(rings, ring)
+		return err
+	})
+	return rings, err
+}
+
+// Literal returns the geometry as a wktLiteral.
+func (g Geometry) Literal() interfaces.ILiteral {
+	return NewLiteral(g.String(), "", NewNamedNode(WKTLiteral))
+}
+
+// String writes the geometry as WKT, with its CRS unless it is CRS84.
+func (g Geometry) String() string {
+	var builder strings.Builder
+	if g.CRS != "" && g.CRS != CRS84 {
+		builder.WriteString("<" + g.CRS + "> ")
+	}
+	points := func(points []Point) {
+		builder.WriteString("(")
+		for i, point := range points {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(strconv.FormatFloat(point.X, 'f', -1, 64) + " " + strconv.FormatFloat(point.Y, 'f', -1, 64))
+		}
+		builder.WriteString(")")
+	}
+	polygon := func(rings [][]Point) {
+		builder.WriteString("(")
+		for i, ring := range rings {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			points(ring)
+		}
+		builder.WriteString(")")
+	}
+	parts := len(g.Points) + len(g.Lines) + len(g.Polygons)
+	switch {
+	case parts == 0:
+		builder.WriteString("GEOMETRYCOLLECTION EMPTY")
+	case parts == len(g.Points):
+		if parts == 1 {
+			builder.WriteString("POINT ")
+		} else {
+			builder.WriteString("MULTIPOINT ")
+		}
+		points(g.Points)
+	case parts == 1 && len(g.Lines) == 1:
+		builder.WriteString("LINESTRING ")
+		points(g.Lines[0])
+	case parts == 1:
+		builder.WriteString("POLYGON ")
+		polygon(g.Polygons[0])
+	default:
+		builder.WriteString("GEOMETRYCOLLECTION (")
+		separator := ""
+		for _, point := range g.Points {
+			builder.WriteString(separator + "POINT ")
+			points([]Point{point})
+			separator = ", "
+		}
+		for _, line := range g.Lines {
+			builder.WriteString(separator + "LINESTRING ")
+			points(line)
+			separator = ", "
+		}
+		for _, rings := range g.Polygons {
+			builder.WriteString(separator + "POLYGON ")
+			polygon(rings)
+			separator = ", "
+		}
+		builder.WriteString(")")
+	}
+	return builder.String()
+}
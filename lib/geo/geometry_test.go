@@ -0,0 +1,66 @@
+package rdfgo
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func TestParseWKT(t *testing.T) {
+	tests := []struct {
+		wkt      string
+		expected string
+		crs      string
+	}{
+		{"POINT(1 2)", "POINT (1 2)", CRS84},
+		{"point z (1.5 -2 3)", "POINT (1.5 -2)", CRS84},
+		{"<http://www.opengis.net/def/crs/EPSG/0/4326> POINT(52 4)", "<http://www.opengis.net/def/crs/EPSG/0/4326> POINT (52 4)", "http://www.opengis.net/def/crs/EPSG/0/4326"},
+		{"LINESTRING (0 0, 1 1, 2 0)", "LINESTRING (0 0, 1 1, 2 0)", CRS84},
+		{"POLYGON ((0 0, 4 0, 4 4, 0 4, 0 0), (1 1, 2 1, 2 2, 1 1))", "POLYGON ((0 0, 4 0, 4 4, 0 4, 0 0), (1 1, 2 1, 2 2, 1 1))", CRS84},
+		{"MULTIPOINT ((1 2), 3 4)", "MULTIPOINT (1 2, 3 4)", CRS84},
+		{"MULTILINESTRING ((0 0, 1 1), (2 2, 3 3))", "GEOMETRYCOLLECTION (LINESTRING (0 0, 1 1), LINESTRING (2 2, 3 3))", CRS84},
+		{"MULTIPOLYGON (((0 0, 1 0, 1 1, 0 0)))", "POLYGON ((0 0, 1 0, 1 1, 0 0))", CRS84},
+		{"GEOMETRYCOLLECTION (POINT (1 2), LINESTRING EMPTY)", "POINT (1 2)", CRS84},
+		{"POLYGON EMPTY", "GEOMETRYCOLLECTION EMPTY", CRS84},
+	}
+	for _, test := range tests {
+		geometry, err := ParseWKT(test.wkt)
+		if err != nil || geometry.String() != test.expected || geometry.CRS != test.crs {
+			t.Errorf("Expected %q in %s, got %q in %s and %v", test.expected, test.crs, geometry.String(), geometry.CRS, err)
+		}
+	}
+
+	for _, wkt := range []string{
+		"",
+		"POINT",
+		"POINT (1)",
+		"POINT (1 2",
+		"POINT (1 2) POINT (3 4)",
+		"LINESTRING (0 0)",
+		"POLYGON ((0 0, 1 0, 1 1, 0 1))",
+		"POLYGON ((0 0, 1 0, 0 0))",
+		"CIRCLE (0 0, 1)",
+		"<http://example.org/crs POINT (1 2)",
+	} {
+		if _, err := ParseWKT(wkt); !errors.Is(err, InvalidWKTError) {
+			t.Errorf("Expected %q to be invalid, got %v", wkt, err)
+		}
+	}
+}
+
+func TestParseLiteral(t *testing.T) {
+	geometry, err := ParseLiteral(NewLiteral("POINT (1 2)", "", NewNamedNode(WKTLiteral)))
+	if err != nil || len(geometry.Points) != 1 || geometry.Points[0] != (Point{1, 2}) {
+		t.Errorf("Expected POINT (1 2), got %+v and %v", geometry, err)
+	}
+	if _, err := ParseLiteral(NewStringLiteral("POINT (1 2)", "")); !errors.Is(err, NotGeometryError) {
+		t.Errorf("Expected a string not to be a geometry, got %v", err)
+	}
+	if literal := geometry.Literal(); literal.GetValue() != "POINT (1 2)" || literal.GetDatatype().GetValue() != WKTLiteral {
+		t.Errorf("Expected a wktLiteral, got %s", literal.ToString())
+	}
+	if box := NewBox(Box{0, 1, 2, 3}).Bounds(); box != (Box{0, 1, 2, 3}) {
+		t.Errorf("Expected the bounds of a box to be the box, got %+v", box)
+	}
+}
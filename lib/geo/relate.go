@@ -0,0 +1,349 @@
+package rdfgo
+
+import (
+	"math"
+	"slices"
+)
+
+// The functions below work in the plane of the coordinates of the
+// geometries, and test each point, line and polygon of a geometry on its
+// own. A geometry contains another one when each part of the other one is
+// contained in one of its parts, so a polygon that is covered by two
+// adjacent polygons together is not contained in them.
+
+// location is where a point is relative to a part of a geometry.
+type location int
+
+const (
+	exterior location = iota
+	boundary
+	interior
+)
+
+func cross(a Point, b Point, c Point) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+// onSegment reports whether p is on the segment from a to b.
+func onSegment(p Point, a Point, b Point) bool {
+	return cross(a, b, p) == 0 &&
+		min(a.X, b.X) <= p.X && p.X <= max(a.X, b.X) &&
+		min(a.Y, b.Y) <= p.Y && p.Y <= max(a.Y, b.Y)
+}
+
+func sign(value float64) int {
+	switch {
+	case value > 0:
+		return 1
+	case value < 0:
+		return -1
+	}
+	return 0
+}
+
+func segmentsIntersect(a Point, b Point, c Point, d Point) bool {
+	d1, d2 := sign(cross(c, d, a)), sign(cross(c, d, b))
+	d3, d4 := sign(cross(a, b, c)), sign(cross(a, b, d))
+	if d1*d2 < 0 && d3*d4 < 0 {
+		return true
+	}
+	return onSegment(a, c, d) || onSegment(b, c, d) || onSegment(c, a, b) || onSegment(d, a, b)
+}
+
+// locateInRing returns whether p is inside, on or outside a closed ring.
+func locateInRing(p Point, ring []Point) location {
+	inside := false
+	for i := 1; i < len(ring); i++ {
+		a, b := ring[i-1], ring[i]
+		if onSegment(p, a, b) {
+			return boundary
+		}
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < a.X+(p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+			inside = !inside
+		}
+	}
+	if inside {
+		return interior
+	}
+	return exterior
+}
+
+func locateInPolygon(p Point, rings [][]Point) location {
+	outer := locateInRing(p, rings[0])
+	if outer != interior {
+		return outer
+	}
+	for _, hole := range rings[1:] {
+		switch locateInRing(p, hole) {
+		case interior:
+			return exterior
+		case boundary:
+			return boundary
+		}
+	}
+	return interior
+}
+
+// locateInLine returns the boundary for the end points of a line that is
+// not closed.
+func locateInLine(p Point, line []Point) location {
+	if line[0] != line[len(line)-1] && (p == line[0] || p == line[len(line)-1]) {
+		return boundary
+	}
+	for i := 1; i < len(line); i++ {
+		if onSegment(p, line[i-1], line[i]) {
+			return interior
+		}
+	}
+	return exterior
+}
+
+// part is a point, line or polygon of a geometry.
+type part struct {
+	points    []Point
+	line      []Point
+	rings     [][]Point
+	dimension int
+}
+
+func (g Geometry) parts() []part {
+	parts := make([]part, 0, len(g.Points)+len(g.Lines)+len(g.Polygons))
+	for _, point := range g.Points {
+		parts = append(parts, part{points: []Point{point}, dimension: 0})
+	}
+	for _, line := range g.Lines {
+		parts = append(parts, part{line: line, dimension: 1})
+	}
+	for _, rings := range g.Polygons {
+		parts = append(parts, part{rings: rings, dimension: 2})
+	}
+	return parts
+}
+
+func (p part) locate(point Point) location {
+	switch p.dimension {
+	case 0:
+		if point == p.points[0] {
+			return interior
+		}
+		return exterior
+	case 1:
+		return locateInLine(point, p.line)
+	}
+	return locateInPolygon(point, p.rings)
+}
+
+// segments returns the segments of the boundary of a part, or of the part
+// itself for points and lines. A point is a segment of length zero.
+func (p part) segments() [][2]Point {
+	var segments [][2]Point
+	switch p.dimension {
+	case 0:
+		return [][2]Point{{p.points[0], p.points[0]}}
+	case 1:
+		for i := 1; i < len(p.line); i++ {
+			segments = append(segments, [2]Point{p.line[i-1], p.line[i]})
+		}
+	default:
+		for _, ring := range p.rings {
+			for i := 1; i < len(ring); i++ {
+				segments = append(segments, [2]Point{ring[i-1], ring[i]})
+			}
+		}
+	}
+	return segments
+}
+
+func (p part) vertex() Point {
+	switch p.dimension {
+	case 0:
+		return p.points[0]
+	case 1:
+		return p.line[0]
+	}
+	return p.rings[0][0]
+}
+
+func (p part) intersects(other part) bool {
+	for _, a := range p.segments() {
+		for _, b := range other.segments() {
+			if segmentsIntersect(a[0], a[1], b[0], b[1]) {
+				return true
+			}
+		}
+	}
+	return other.locate(p.vertex()) != exterior || p.locate(other.vertex()) != exterior
+}
+
+// samples returns points of the segments of a part: their end points, the
+// points where they cross the segments of another part and the middles of the
+// pieces between those. The pieces do not cross the boundary of the other
+// part, so the samples tell where the segments are relative to it. Middle
+// reports whether a sample is in the middle of a segment.
+func (p part) samples(other part) (samples []Point, middle []bool) {
+	cuts := other.segments()
+	for _, segment := range p.segments() {
+		a, b := segment[0], segment[1]
+		positions := []float64{0, 1}
+		for _, cut := range cuts {
+			positions = append(positions, crossings(a, b, cut[0], cut[1])...)
+		}
+		slices.Sort(positions)
+		positions = slices.Compact(positions)
+		at := func(t float64) Point {
+			return Point{a.X + t*(b.X-a.X), a.Y + t*(b.Y-a.Y)}
+		}
+		for i, t := range positions {
+			samples, middle = append(samples, at(t)), append(middle, t > 0 && t < 1)
+			if i > 0 {
+				samples, middle = append(samples, at((positions[i-1]+t)/2)), append(middle, true)
+			}
+		}
+	}
+	return samples, middle
+}
+
+// crossings returns the positions on the segment from a to b, from 0 to 1,
+// where the segment from c to d meets it or, when they overlap, starts and
+// ends overlapping it.
+func crossings(a Point, b Point, c Point, d Point) []float64 {
+	direction := Point{b.X - a.X, b.Y - a.Y}
+	length := direction.X*direction.X + direction.Y*direction.Y
+	if length == 0 {
+		return nil
+	}
+	project := func(p Point) float64 {
+		return ((p.X-a.X)*direction.X + (p.Y-a.Y)*direction.Y) / length
+	}
+	var positions []float64
+	for _, p := range []Point{c, d} {
+		if onSegment(p, a, b) {
+			positions = append(positions, project(p))
+		}
+	}
+	denominator := direction.X*(d.Y-c.Y) - direction.Y*(d.X-c.X)
+	if denominator != 0 {
+		t := ((c.X-a.X)*(d.Y-c.Y) - (c.Y-a.Y)*(d.X-c.X)) / denominator
+		u := ((c.X-a.X)*direction.Y - (c.Y-a.Y)*direction.X) / denominator
+		if t > 0 && t < 1 && u >= 0 && u <= 1 {
+			positions = append(positions, t)
+		}
+	}
+	return positions
+}
+
+// contains reports whether no point of other is outside p and some point
+// inside other is inside p.
+func (p part) contains(other part) bool {
+	if other.dimension > p.dimension {
+		return false
+	}
+	samples, middle := other.samples(p)
+	inside := false
+	for i, sample := range samples {
+		where := p.locate(sample)
+		if where == exterior {
+			return false
+		}
+		if where == interior && (other.dimension != 1 || middle[i] || other.locate(sample) == interior) {
+			inside = true
+		}
+	}
+	if other.dimension == 2 {
+		// The samples only cover the boundary of other, so a hole of p can
+		// still be inside it.
+		for _, hole := range p.rings[1:] {
+			for _, point := range hole {
+				if other.locate(point) == interior {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return inside
+}
+
+// Intersects reports whether the geometries share a point, like
+// geof:sfIntersects.
+func (g Geometry) Intersects(other Geometry) bool {
+	if !g.Bounds().Intersects(other.Bounds()) {
+		return false
+	}
+	for _, a := range g.parts() {
+		for _, b := range other.parts() {
+			if a.intersects(b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Contains reports whether no point of other is outside g and the interiors
+// of the geometries share a point, like geof:sfContains.
+func (g Geometry) Contains(other Geometry) bool {
+	if g.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+	parts := g.parts()
+	for _, b := range other.parts() {
+		if !slices.ContainsFunc(parts, func(a part) bool { return a.contains(b) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// Within is the inverse of Contains, like geof:sfWithin.
+func (g Geometry) Within(other Geometry) bool {
+	return other.Contains(g)
+}
+
+// Distance returns the smallest distance between the points of the
+// geometries in the units of their coordinates, or NaN when one of them is
+// empty.
+func (g Geometry) Distance(other Geometry) float64 {
+	distance, _, _ := g.closest(other)
+	return distance
+}
+
+// closest returns the distance between the geometries and their closest
+// points, which are the same point when the geometries intersect.
+func (g Geometry) closest(other Geometry) (float64, Point, Point) {
+	best, from, to := math.NaN(), Point{}, Point{}
+	for _, a := range g.parts() {
+		for _, b := range other.parts() {
+			if a.intersects(b) {
+				return 0, a.vertex(), a.vertex()
+			}
+			for _, segment := range a.segments() {
+				for _, cut := range b.segments() {
+					candidates := [][2]Point{
+						{segment[0], closestOnSegment(segment[0], cut[0], cut[1])},
+						{segment[1], closestOnSegment(segment[1], cut[0], cut[1])},
+						{closestOnSegment(cut[0], segment[0], segment[1]), cut[0]},
+						{closestOnSegment(cut[1], segment[0], segment[1]), cut[1]},
+					}
+					for _, candidate := range candidates {
+						distance := math.Hypot(candidate[0].X-candidate[1].X, candidate[0].Y-candidate[1].Y)
+						if math.IsNaN(best) || distance < best {
+							best, from, to = distance, candidate[0], candidate[1]
+						}
+					}
+				}
+			}
+		}
+	}
+	return best, from, to
+}
+
+func closestOnSegment(p Point, a Point, b Point) Point {
+	direction := Point{b.X - a.X, b.Y - a.Y}
+	length := direction.X*direction.X + direction.Y*direction.Y
+	if length == 0 {
+		return a
+	}
+	t := max(0, min(1, ((p.X-a.X)*direction.X+(p.Y-a.Y)*direction.Y)/length))
+	return Point{a.X + t*direction.X, a.Y + t*direction.Y}
+}
@@ -0,0 +1,136 @@
+package rdfgo
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func mustParseWKT(t *testing.T, wkt string) Geometry {
+	t.Helper()
+	geometry, err := ParseWKT(wkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return geometry
+}
+
+const (
+	square      = "POLYGON ((0 0, 10 0, 10 10, 0 10, 0 0))"
+	squareHole  = "POLYGON ((0 0, 10 0, 10 10, 0 10, 0 0), (4 4, 6 4, 6 6, 4 6, 4 4))"
+	smallSquare = "POLYGON ((2 2, 3 2, 3 3, 2 3, 2 2))"
+)
+
+func TestGeometry_Relations(t *testing.T) {
+	tests := []struct {
+		a          string
+		b          string
+		intersects bool
+		contains   bool
+		within     bool
+	}{
+		{square, "POINT (5 5)", true, true, false},
+		{square, "POINT (10 5)", true, false, false},
+		{square, "POINT (11 5)", false, false, false},
+		{squareHole, "POINT (5 5)", false, false, false},
+		{squareHole, "POINT (4 5)", true, false, false},
+		{square, smallSquare, true, true, false},
+		{smallSquare, square, true, false, true},
+		{square, square, true, true, true},
+		{squareHole, smallSquare, true, true, false},
+		{squareHole, "POLYGON ((3 3, 7 3, 7 7, 3 7, 3 3))", true, false, false},
+		{square, "POLYGON ((5 5, 15 5, 15 15, 5 15, 5 5))", true, false, false},
+		{square, "POLYGON ((10 0, 20 0, 20 10, 10 10, 10 0))", true, false, false},
+		{square, "POLYGON ((20 20, 30 20, 30 30, 20 20))", false, false, false},
+		{square, "LINESTRING (1 1, 9 9)", true, true, false},
+		{square, "LINESTRING (0 0, 10 0)", true, false, false},
+		{square, "LINESTRING (5 5, 15 5)", true, false, false},
+		{squareHole, "LINESTRING (1 5, 9 5)", true, false, false},
+		{"LINESTRING (0 0, 10 0)", "LINESTRING (2 0, 5 0)", true, true, false},
+		{"LINESTRING (0 0, 10 0)", "LINESTRING (5 -5, 5 5)", true, false, false},
+		{"LINESTRING (0 0, 10 0)", "POINT (10 0)", true, false, false},
+		{"LINESTRING (0 0, 10 0)", "POINT (3 0)", true, true, false},
+		{"POINT (1 1)", "POINT (1 1)", true, true, true},
+		{"MULTIPOINT (1 1, 20 20)", square, true, false, false},
+		{"MULTIPOLYGON (((0 0, 1 0, 1 1, 0 1, 0 0)), ((5 5, 6 5, 6 6, 5 6, 5 5)))", "MULTIPOINT (0.5 0.5, 5.5 5.5)", true, true, false},
+		{square, "GEOMETRYCOLLECTION EMPTY", false, false, false},
+	}
+	for _, test := range tests {
+		a, b := mustParseWKT(t, test.a), mustParseWKT(t, test.b)
+		if a.Intersects(b) != test.intersects || b.Intersects(a) != test.intersects {
+			t.Errorf("Expected %s and %s to intersect: %t", test.a, test.b, test.intersects)
+		}
+		if a.Contains(b) != test.contains {
+			t.Errorf("Expected %s to contain %s: %t", test.a, test.b, test.contains)
+		}
+		if a.Within(b) != test.within {
+			t.Errorf("Expected %s to be within %s: %t", test.a, test.b, test.within)
+		}
+	}
+}
+
+func TestGeometry_Distance(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected float64
+	}{
+		{"POINT (0 0)", "POINT (3 4)", 5},
+		{square, "POINT (13 14)", 5},
+		{square, "POINT (5 12)", 2},
+		{square, "POINT (5 5)", 0},
+		{"LINESTRING (0 0, 10 0)", "LINESTRING (5 3, 5 1)", 1},
+		{square, "POLYGON ((12 0, 20 0, 20 10, 12 0))", 2},
+	}
+	for _, test := range tests {
+		a, b := mustParseWKT(t, test.a), mustParseWKT(t, test.b)
+		if distance := a.Distance(b); math.Abs(distance-test.expected) > 1e-9 {
+			t.Errorf("Expected a distance of %g between %s and %s, got %g", test.expected, test.a, test.b, distance)
+		}
+	}
+	if distance := mustParseWKT(t, square).Distance(Geometry{}); !math.IsNaN(distance) {
+		t.Errorf("Expected NaN for an empty geometry, got %g", distance)
+	}
+}
+
+func TestFunctions(t *testing.T) {
+	wkt := func(text string) interfaces.ITerm {
+		return NewLiteral(text, "", NewNamedNode(WKTLiteral))
+	}
+	call := func(name string, arguments ...interfaces.ITerm) (interfaces.ITerm, error) {
+		return Functions[FunctionNamespace+name](arguments...)
+	}
+	result, err := call("sfWithin", wkt("POINT (5 5)"), wkt(square))
+	if err != nil || !result.Equals(NewBooleanLiteral(true)) {
+		t.Errorf("Expected true, got %v and %v", result, err)
+	}
+	result, err = call("sfIntersects", wkt("POINT (50 5)"), wkt(square))
+	if err != nil || !result.Equals(NewBooleanLiteral(false)) {
+		t.Errorf("Expected false, got %v and %v", result, err)
+	}
+	result, err = call("distance", wkt("POINT (0 0)"), wkt("POINT (1 0)"), NewNamedNode(Metre))
+	if metres, _ := strconv.ParseFloat(result.GetValue(), 64); err != nil || math.Abs(metres-111195) > 1 {
+		t.Errorf("Expected about 111195 metres, got %v and %v", result, err)
+	}
+	result, err = call("distance", wkt("POINT (0 0)"), wkt("POINT (3 4)"), NewNamedNode(Degree))
+	if err != nil || result.GetValue() != "5" {
+		t.Errorf("Expected 5 degrees, got %v and %v", result, err)
+	}
+
+	if _, err := call("sfContains", wkt(square), wkt("<http://www.opengis.net/def/crs/EPSG/0/4326> POINT (5 5)")); !errors.Is(err, CRSMismatchError) {
+		t.Errorf("Expected a CRS mismatch, got %v", err)
+	}
+	if _, err := call("distance", wkt(square), wkt(square), NewNamedNode(UnitNamespace+"foot")); !errors.Is(err, UnsupportedUnitError) {
+		t.Errorf("Expected an unsupported unit, got %v", err)
+	}
+	if _, err := call("sfContains", wkt(square), NewStringLiteral("POINT (5 5)", "")); !errors.Is(err, NotGeometryError) {
+		t.Errorf("Expected a string not to be a geometry, got %v", err)
+	}
+	if _, err := call("sfContains", wkt(square)); !errors.Is(err, ArgumentError) {
+		t.Errorf("Expected too few arguments, got %v", err)
+	}
+}
@@ -0,0 +1,212 @@
+package rdfgo
+
+import "math"
+
+// rtreeMaxEntries and rtreeMinEntries bound the entries of a node of an
+// RTree other than the root.
+const (
+	rtreeMaxEntries = 16
+	rtreeMinEntries = 4
+)
+
+// RTree indexes values by the box they cover. A value can be in the tree
+// with several boxes.
+type RTree[T comparable] struct {
+	root *rtreeNode[T]
+	size int
+}
+
+type rtreeEntry[T comparable] struct {
+	box   Box
+	child *rtreeNode[T]
+	value T
+}
+
+type rtreeNode[T comparable] struct {
+	leaf    bool
+	entries []rtreeEntry[T]
+}
+
+func (n *rtreeNode[T]) bounds() Box {
+	box := n.entries[0].box
+	for _, entry := range n.entries[1:] {
+		box = box.union(entry.box)
+	}
+	return box
+}
+
+func (t *RTree[T]) Len() int {
+	return t.size
+}
+
+// Insert adds a value with the box it covers.
+func (t *RTree[T]) Insert(box Box, value T) {
+	if t.root == nil {
+		t.root = &rtreeNode[T]{leaf: true}
+	}
+	if sibling := t.insert(t.root, rtreeEntry[T]{box: box, value: value}, t.height()); sibling != nil {
+		t.root = &rtreeNode[T]{entries: []rtreeEntry[T]{
+			{box: t.root.bounds(), child: t.root},
+			{box: sibling.bounds(), child: sibling},
+		}}
+	}
+	t.size++
+}
+
+func (t *RTree[T]) height() int {
+	height := 0
+	for node := t.root; node != nil && !node.leaf; node = node.entries[0].child {
+		height++
+	}
+	return height
+}
+
+// insert adds an entry at a level above the leaves and returns the new
+// sibling of node when node had to be split.
+func (t *RTree[T]) insert(node *rtreeNode[T], entry rtreeEntry[T], level int) *rtreeNode[T] {
+	if level == 0 {
+		node.entries = append(node.entries, entry)
+	} else {
+		best := 0
+		growth, area := math.Inf(1), math.Inf(1)
+		for i, candidate := range node.entries {
+			size := candidate.box.area()
+			enlargement := candidate.box.union(entry.box).area() - size
+			if enlargement < growth || enlargement == growth && size < area {
+				best, growth, area = i, enlargement, size
+			}
+		}
+		child := node.entries[best].child
+		sibling := t.insert(child, entry, level-1)
+		node.entries[best].box = child.bounds()
+		if sibling != nil {
+			node.entries = append(node.entries, rtreeEntry[T]{box: sibling.bounds(), child: sibling})
+		}
+	}
+	if len(node.entries) <= rtreeMaxEntries {
+		return nil
+	}
+	return node.split()
+}
+
+// split moves part of the entries of node to a new node with the quadratic
+// split: the two entries that would waste the most area together seed the
+// nodes, and every other entry goes to the node that grows least.
+func (n *rtreeNode[T]) split() *rtreeNode[T] {
+	entries := n.entries
+	first, second, worst := 0, 1, math.Inf(-1)
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			waste := entries[i].box.union(entries[j].box).area() - entries[i].box.area() - entries[j].box.area()
+			if waste > worst {
+				first, second, worst = i, j, waste
+			}
+		}
+	}
+	groups := [2][]rtreeEntry[T]{{entries[first]}, {entries[second]}}
+	boxes := [2]Box{entries[first].box, entries[second].box}
+	for i, entry := range entries {
+		if i == first || i == second {
+			continue
+		}
+		remaining := len(entries) - i
+		group := 0
+		switch {
+		case len(groups[0])+remaining <= rtreeMinEntries:
+			group = 0
+		case len(groups[1])+remaining <= rtreeMinEntries:
+			group = 1
+		case boxes[1].union(entry.box).area()-boxes[1].area() < boxes[0].union(entry.box).area()-boxes[0].area():
+			group = 1
+		}
+		groups[group] = append(groups[group], entry)
+		boxes[group] = boxes[group].union(entry.box)
+	}
+	n.entries = groups[0]
+	return &rtreeNode[T]{leaf: n.leaf, entries: groups[1]}
+}
+
+// Remove deletes a value with a box and reports whether it was in the tree.
+func (t *RTree[T]) Remove(box Box, value T) bool {
+	if t.root == nil {
+		return false
+	}
+	var orphans []rtreeEntry[T]
+	if !t.remove(t.root, box, value, &orphans) {
+		return false
+	}
+	t.size--
+	for !t.root.leaf && len(t.root.entries) == 1 {
+		t.root = t.root.entries[0].child
+	}
+	if len(t.root.entries) == 0 {
+		t.root = nil
+	}
+	// The entries of nodes that became too small are added again.
+	t.size -= len(orphans)
+	for _, orphan := range orphans {
+		t.Insert(orphan.box, orphan.value)
+	}
+	return true
+}
+
+func (t *RTree[T]) remove(node *rtreeNode[T], box Box, value T, orphans *[]rtreeEntry[T]) bool {
+	for i, entry := range node.entries {
+		if !entry.box.Intersects(box) {
+			continue
+		}
+		if node.leaf {
+			if entry.value == value && entry.box == box {
+				node.entries = append(node.entries[:i], node.entries[i+1:]...)
+				return true
+			}
+			continue
+		}
+		if !t.remove(entry.child, box, value, orphans) {
+			continue
+		}
+		if len(entry.child.entries) < rtreeMinEntries {
+			collect(entry.child, orphans)
+			node.entries = append(node.entries[:i], node.entries[i+1:]...)
+		} else {
+			node.entries[i].box = entry.child.bounds()
+		}
+		return true
+	}
+	return false
+}
+
+// collect appends the leaf entries under a node to entries.
+func collect[T comparable](node *rtreeNode[T], entries *[]rtreeEntry[T]) {
+	if node.leaf {
+		*entries = append(*entries, node.entries...)
+		return
+	}
+	for _, entry := range node.entries {
+		collect(entry.child, entries)
+	}
+}
+
+// Search calls yield for every value with a box that intersects box, until
+// yield returns false.
+func (t *RTree[T]) Search(box Box, yield func(Box, T) bool) {
+	if t.root != nil {
+		search(t.root, box, yield)
+	}
+}
+
+func search[T comparable](node *rtreeNode[T], box Box, yield func(Box, T) bool) bool {
+	for _, entry := range node.entries {
+		if !entry.box.Intersects(box) {
+			continue
+		}
+		if node.leaf {
+			if !yield(entry.box, entry.value) {
+				return false
+			}
+		} else if !search(entry.child, box, yield) {
+			return false
+		}
+	}
+	return true
+}
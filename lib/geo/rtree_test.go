@@ -0,0 +1,66 @@
+package rdfgo
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestRTree(t *testing.T) {
+	tree := &RTree[int]{}
+	random := rand.New(rand.NewSource(1))
+	boxes := make([]Box, 1000)
+	for i := range boxes {
+		x, y := random.Float64()*100, random.Float64()*100
+		boxes[i] = Box{x, y, x + random.Float64()*5, y + random.Float64()*5}
+		tree.Insert(boxes[i], i)
+	}
+	check := func(query Box) {
+		t.Helper()
+		var found, expected []int
+		tree.Search(query, func(_ Box, value int) bool {
+			found = append(found, value)
+			return true
+		})
+		for i, box := range boxes {
+			if box != (Box{}) && box.Intersects(query) {
+				expected = append(expected, i)
+			}
+		}
+		slices.Sort(found)
+		if !slices.Equal(found, expected) {
+			t.Errorf("Expected %d values in %+v, got %d", len(expected), query, len(found))
+		}
+	}
+	for i := 0; i < 20; i++ {
+		x, y := random.Float64()*100, random.Float64()*100
+		check(Box{x, y, x + 10, y + 10})
+	}
+
+	for i := 0; i < len(boxes); i += 2 {
+		if !tree.Remove(boxes[i], i) || tree.Remove(boxes[i], i) {
+			t.Fatalf("Expected to remove %d once", i)
+		}
+		boxes[i] = Box{}
+	}
+	if tree.Len() != 500 {
+		t.Errorf("Expected 500 values, got %d", tree.Len())
+	}
+	check(Box{0, 0, 105, 105})
+	check(Box{40, 40, 60, 60})
+
+	count := 0
+	tree.Search(Box{0, 0, 105, 105}, func(Box, int) bool {
+		count++
+		return count < 3
+	})
+	if count != 3 {
+		t.Errorf("Expected the search to stop after 3 values, got %d", count)
+	}
+	for i := 1; i < len(boxes); i += 2 {
+		tree.Remove(boxes[i], i)
+	}
+	if tree.Len() != 0 || tree.root != nil {
+		t.Errorf("Expected an empty tree, got %d values", tree.Len())
+	}
+}
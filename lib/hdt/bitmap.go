@@ -0,0 +1,127 @@
+package rdfgo
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+const bitmapPlainType = 1
+const wordsPerBlock = 8
+
+// bitmap is a plain bit sequence with a rank directory that stores the
+// number of set bits preceding every block of eight words.
+type bitmap struct {
+	length uint64
+	data   []byte
+	blocks []uint64
+	ones   uint64
+}
+
+func readBitmap(r *reader) (*bitmap, error) {
+	start := r.pos
+	kind, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if kind != bitmapPlainType {
+		return nil, fmt.Errorf("%w: unsupported bitmap type %d", FormatError, kind)
+	}
+	length, err := r.readVByte()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkCRC8(start); err != nil {
+		return nil, err
+	}
+	data, err := r.readBytes((length + 7) / 8)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkCRC32(data); err != nil {
+		return nil, err
+	}
+	return newBitmap(data, length), nil
+}
+
+func newBitmap(data []byte, length uint64) *bitmap {
+	numWords := (length + 63) / 64
+	b := &bitmap{
+		length: length,
+		data:   data,
+		blocks: make([]uint64, 0, numWords/wordsPerBlock+1),
+	}
+	for i := uint64(0); i < numWords; i++ {
+		if i%wordsPerBlock == 0 {
+			b.blocks = append(b.blocks, b.ones)
+		}
+		b.ones += uint64(bits.OnesCount64(b.word(i)))
+	}
+	return b
+}
+
+func (b *bitmap) word(index uint64) uint64 {
+	w := word(b.data, index)
+	if remaining := b.length - index*64; remaining < 64 {
+		w &= 1<<remaining - 1
+	}
+	return w
+}
+
+func (b *bitmap) access(position uint64) bool {
+	return word(b.data, position/64)>>(position%64)&1 == 1
+}
+
+// rank returns the number of set bits in positions [0, position).
+func (b *bitmap) rank(position uint64) uint64 {
+	if position >= b.length {
+		return b.ones
+	}
+	w := position / 64
+	block := w / wordsPerBlock
+	count := b.blocks[block]
+	for i := block * wordsPerBlock; i < w; i++ {
+		count += uint64(bits.OnesCount64(b.word(i)))
+	}
+	return count + uint64(bits.OnesCount64(b.word(w)&(1<<(position%64)-1)))
+}
+
+// selectOne returns the position of the n-th set bit, counting from one.
+func (b *bitmap) selectOne(n uint64) uint64 {
+	if n == 0 || n > b.ones {
+		return b.length
+	}
+	block := uint64(sort.Search(len(b.blocks), func(i int) bool {
+		return b.blocks[i] >= n
+	})) - 1
+	count := b.blocks[block]
+	w := block * wordsPerBlock
+	for {
+		ones := uint64(bits.OnesCount64(b.word(w)))
+		if count+ones >= n {
+			break
+		}
+		count += ones
+		w++
+	}
+	current := b.word(w)
+	for i := count + 1; i < n; i++ {
+		current &= current - 1
+	}
+	return w*64 + uint64(bits.TrailingZeros64(current))
+}
+
+func appendBitmap(buffer []byte, values []bool) []byte {
+	start := len(buffer)
+	buffer = append(buffer, bitmapPlainType)
+	buffer = appendVByte(buffer, uint64(len(values)))
+	buffer = append(buffer, crc8(buffer[start:]))
+
+	words := make([]uint64, (len(values)+63)/64)
+	for i, value := range values {
+		if value {
+			words[i/64] |= 1 << (uint(i) % 64)
+		}
+	}
+	return appendWords(buffer, words, uint64(len(values)+7)/8)
+}
@@ -0,0 +1,44 @@
+package rdfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBitmap_RankSelect(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	values := make([]bool, 5000)
+	for i := range values {
+		values[i] = random.Intn(3) == 0
+	}
+	r := &reader{data: appendBitmap(nil, values)}
+	b, err := readBitmap(r)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if b.length != uint64(len(values)) {
+		t.Fatalf("Expected length %d, got %d", len(values), b.length)
+	}
+
+	ones := uint64(0)
+	for i, value := range values {
+		if b.rank(uint64(i)) != ones {
+			t.Fatalf("Expected rank(%d) to be %d, got %d", i, ones, b.rank(uint64(i)))
+		}
+		if b.access(uint64(i)) != value {
+			t.Fatalf("Expected access(%d) to be %t", i, value)
+		}
+		if value {
+			ones++
+			if b.selectOne(ones) != uint64(i) {
+				t.Fatalf("Expected select(%d) to be %d, got %d", ones, i, b.selectOne(ones))
+			}
+		}
+	}
+	if b.rank(uint64(len(values))) != ones || b.ones != ones {
+		t.Errorf("Expected %d ones in total", ones)
+	}
+	if b.selectOne(0) != b.length || b.selectOne(ones+1) != b.length {
+		t.Errorf("Expected out of range select to return the bitmap length")
+	}
+}
@@ -0,0 +1,102 @@
+package rdfgo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const hdtCookie = "$HDT"
+
+const (
+	globalFormat     = "<http://purl.org/HDT/hdt#HDTv1>"
+	headerFormat     = "ntriples"
+	dictionaryFormat = "<http://purl.org/HDT/hdt#dictionaryFour>"
+	triplesFormat    = "<http://purl.org/HDT/hdt#triplesBitmap>"
+)
+
+type controlType byte
+
+const (
+	unknownControl controlType = iota
+	globalControl
+	headerControl
+	dictionaryControl
+	triplesControl
+	indexControl
+)
+
+type controlInformation struct {
+	controlType controlType
+	format      string
+	properties  map[string]string
+}
+
+func readControlInformation(r *reader, expected controlType) (*controlInformation, error) {
+	start := r.pos
+	cookie, err := r.readBytes(uint64(len(hdtCookie)))
+	if err != nil {
+		return nil, err
+	}
+	if string(cookie) != hdtCookie {
+		return nil, fmt.Errorf("%w: missing %s cookie at offset %d", FormatError, hdtCookie, start)
+	}
+	kind, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if controlType(kind) != expected {
+		return nil, fmt.Errorf("%w: expected control type %d, got %d", FormatError, expected, kind)
+	}
+	format, err := r.readCString()
+	if err != nil {
+		return nil, err
+	}
+	properties, err := r.readCString()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkCRC16(start); err != nil {
+		return nil, err
+	}
+
+	ci := &controlInformation{
+		controlType: expected,
+		format:      format,
+		properties:  make(map[string]string),
+	}
+	for _, property := range strings.Split(properties, ";") {
+		key, value, found := strings.Cut(property, "=")
+		if found {
+			ci.properties[key] = value
+		}
+	}
+	return ci, nil
+}
+
+func (ci *controlInformation) getInt(key string) (uint64, error) {
+	value, ok := ci.properties[key]
+	if !ok {
+		return 0, fmt.Errorf("%w: missing property %q", FormatError, key)
+	}
+	number, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: property %q is not a number", FormatError, key)
+	}
+	return number, nil
+}
+
+func appendControlInformation(buffer []byte, kind controlType, format string, properties ...string) []byte {
+	start := len(buffer)
+	buffer = append(buffer, hdtCookie...)
+	buffer = append(buffer, byte(kind))
+	buffer = append(buffer, format...)
+	buffer = append(buffer, 0)
+	for _, property := range properties {
+		buffer = append(buffer, property...)
+		buffer = append(buffer, ';')
+	}
+	buffer = append(buffer, 0)
+	return binary.LittleEndian.AppendUint16(buffer, crc16(buffer[start:]))
+}
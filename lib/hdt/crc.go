@@ -0,0 +1,59 @@
+package rdfgo
+
+import "hash/crc32"
+
+var crc8Table = makeCRC8Table(0x07)
+var crc16Table = makeCRC16Table(0xA001)
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+func makeCRC8Table(polynomial byte) [256]byte {
+	var table [256]byte
+	for i := 0; i < 256; i++ {
+		crc := byte(i)
+		for j := 0; j < 8; j++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ polynomial
+			} else {
+				crc <<= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}
+
+func makeCRC16Table(polynomial uint16) [256]uint16 {
+	var table [256]uint16
+	for i := 0; i < 256; i++ {
+		crc := uint16(i)
+		for j := 0; j < 8; j++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ polynomial
+			} else {
+				crc >>= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}
+
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc = crc8Table[crc^b]
+	}
+	return crc
+}
+
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc = crc>>8 ^ crc16Table[byte(crc)^b]
+	}
+	return crc
+}
+
+func crc32c(data []byte) uint32 {
+	return crc32.Checksum(data, crc32cTable)
+}
@@ -0,0 +1,16 @@
+package rdfgo
+
+import "testing"
+
+func TestCRC_CheckValues(t *testing.T) {
+	data := []byte("123456789")
+	if crc8(data) != 0xF4 {
+		t.Errorf("Expected crc8 check value 0xF4, got %#x", crc8(data))
+	}
+	if crc16(data) != 0xBB3D {
+		t.Errorf("Expected crc16 check value 0xBB3D, got %#x", crc16(data))
+	}
+	if crc32c(data) != 0xE3069283 {
+		t.Errorf("Expected crc32c check value 0xE3069283, got %#x", crc32c(data))
+	}
+}
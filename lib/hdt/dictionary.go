@@ -0,0 +1,296 @@
+package rdfgo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	langtag "github.com/maartyman/rdfgo/lib/langtag"
+)
+
+const sectionPFCType = 2
+const defaultBlockSize = 16
+
+type role int
+
+const (
+	subjectRole role = iota
+	predicateRole
+	objectRole
+)
+
+// section is a plain front coded string section. Strings are sorted and
+// split into blocks; the first string of a block is stored verbatim and the
+// following strings store the length of the prefix shared with their
+// predecessor and the remaining suffix.
+type section struct {
+	length    uint64
+	blockSize uint64
+	blocks    *logArray
+	text      []byte
+}
+
+func readSection(r *reader) (*section, error) {
+	start := r.pos
+	kind, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if kind != sectionPFCType {
+		return nil, fmt.Errorf("%w: unsupported dictionary section type %d", FormatError, kind)
+	}
+	length, err := r.readVByte()
+	if err != nil {
+		return nil, err
+	}
+	size, err := r.readVByte()
+	if err != nil {
+		return nil, err
+	}
+	blockSize, err := r.readVByte()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkCRC8(start); err != nil {
+		return nil, err
+	}
+	if blockSize == 0 && length > 0 {
+		return nil, fmt.Errorf("%w: dictionary section with block size 0", FormatError)
+	}
+	blocks, err := readLogArray(r)
+	if err != nil {
+		return nil, err
+	}
+	text, err := r.readBytes(size)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkCRC32(text); err != nil {
+		return nil, err
+	}
+	if length > 0 && blocks.length < (length+blockSize-1)/blockSize {
+		return nil, fmt.Errorf("%w: dictionary section is missing block pointers", FormatError)
+	}
+	return &section{
+		length:    length,
+		blockSize: blockSize,
+		blocks:    blocks,
+		text:      text,
+	}, nil
+}
+
+func (s *section) blockStart(block uint64) ([]byte, uint64) {
+	offset := s.blocks.get(block)
+	if offset >= uint64(len(s.text)) {
+		return nil, offset
+	}
+	end := bytes.IndexByte(s.text[offset:], 0)
+	if end < 0 {
+		end = len(s.text) - int(offset)
+	}
+	return s.text[offset : offset+uint64(end)], offset + uint64(end) + 1
+}
+
+func (s *section) nextInBlock(previous []byte, offset uint64) ([]byte, uint64) {
+	shared, n, err := decodeVByte(s.text[min(offset, uint64(len(s.text))):])
+	if err != nil || shared > uint64(len(previous)) {
+		return nil, uint64(len(s.text))
+	}
+	offset += uint64(n)
+	end := bytes.IndexByte(s.text[offset:], 0)
+	if end < 0 {
+		end = len(s.text) - int(offset)
+	}
+	current := make([]byte, 0, int(shared)+end)
+	current = append(current, previous[:shared]...)
+	current = append(current, s.text[offset:offset+uint64(end)]...)
+	return current, offset + uint64(end) + 1
+}
+
+// extract returns the string with the given one-based id.
+func (s *section) extract(id uint64) (string, bool) {
+	if id == 0 || id > s.length {
+		return "", false
+	}
+	block := (id - 1) / s.blockSize
+	current, offset := s.blockStart(block)
+	for i := uint64(0); i < (id-1)%s.blockSize; i++ {
+		current, offset = s.nextInBlock(current, offset)
+	}
+	return string(current), true
+}
+
+// locate returns the one-based id of value, or 0 when it is not present.
+func (s *section) locate(value string) uint64 {
+	if s.length == 0 {
+		return 0
+	}
+	target := []byte(value)
+	numBlocks := (s.length + s.blockSize - 1) / s.blockSize
+	block := uint64(sort.Search(int(numBlocks), func(i int) bool {
+		first, _ := s.blockStart(uint64(i))
+		return bytes.Compare(first, target) > 0
+	}))
+	if block == 0 {
+		return 0
+	}
+	block--
+
+	current, offset := s.blockStart(block)
+	id := block*s.blockSize + 1
+	for i := uint64(0); ; i++ {
+		comparison := bytes.Compare(current, target)
+		if comparison == 0 {
+			return id + i
+		}
+		if comparison > 0 || i+1 >= s.blockSize || id+i+1 > s.length {
+			return 0
+		}
+		current, offset = s.nextInBlock(current, offset)
+	}
+}
+
+func appendSection(buffer []byte, values []string, blockSize int) []byte {
+	var text []byte
+	var pointers []uint64
+	var previous string
+	for i, value := range values {
+		if i%blockSize == 0 {
+			pointers = append(pointers, uint64(len(text)))
+			text = append(text, value...)
+		} else {
+			shared := 0
+			for shared < len(previous) && shared < len(value) && previous[shared] == value[shared] {
+				shared++
+			}
+			text = appendVByte(text, uint64(shared))
+			text = append(text, value[shared:]...)
+		}
+		text = append(text, 0)
+		previous = value
+	}
+	pointers = append(pointers, uint64(len(text)))
+
+	start := len(buffer)
+	buffer = append(buffer, sectionPFCType)
+	buffer = appendVByte(buffer, uint64(len(values)))
+	buffer = appendVByte(buffer, uint64(len(text)))
+	buffer = appendVByte(buffer, uint64(blockSize))
+	buffer = append(buffer, crc8(buffer[start:]))
+	buffer = appendLogArray(buffer, pointers)
+	buffer = append(buffer, text...)
+	return binary.LittleEndian.AppendUint32(buffer, crc32c(text))
+}
+
+// dictionary is the four section dictionary: terms that appear both as
+// subject and object are stored once in the shared section and take the
+// lowest subject and object ids.
+type dictionary struct {
+	shared     *section
+	subjects   *section
+	predicates *section
+	objects    *section
+}
+
+func readDictionary(r *reader) (*dictionary, error) {
+	ci, err := readControlInformation(r, dictionaryControl)
+	if err != nil {
+		return nil, err
+	}
+	if ci.format != dictionaryFormat {
+		return nil, fmt.Errorf("%w: unsupported dictionary %s", FormatError, ci.format)
+	}
+	d := &dictionary{}
+	for _, target := range []**section{&d.shared, &d.subjects, &d.predicates, &d.objects} {
+		if *target, err = readSection(r); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (d *dictionary) idToString(id uint64, position role) (string, bool) {
+	switch position {
+	case predicateRole:
+		return d.predicates.extract(id)
+	case subjectRole:
+		if id <= d.shared.length {
+			return d.shared.extract(id)
+		}
+		return d.subjects.extract(id - d.shared.length)
+	default:
+		if id <= d.shared.length {
+			return d.shared.extract(id)
+		}
+		return d.objects.extract(id - d.shared.length)
+	}
+}
+
+func (d *dictionary) stringToID(value string, position role) uint64 {
+	if position == predicateRole {
+		return d.predicates.locate(value)
+	}
+	if id := d.shared.locate(value); id != 0 {
+		return id
+	}
+	own := d.subjects
+	if position == objectRole {
+		own = d.objects
+	}
+	if id := own.locate(value); id != 0 {
+		return id + d.shared.length
+	}
+	return 0
+}
+
+func (d *dictionary) idToTerm(id uint64, position role) interfaces.ITerm {
+	value, _ := d.idToString(id, position)
+	return stringToTerm(value)
+}
+
+func termToString(term interfaces.ITerm) (string, bool) {
+	switch term.GetType() {
+	case interfaces.NamedNodeType:
+		return term.GetValue(), true
+	case interfaces.BlankNodeType:
+		return "_:" + term.GetValue(), true
+	case interfaces.LiteralType:
+		literal := term.(interfaces.ILiteral)
+		value := "\"" + literal.GetValue() + "\""
+		if literal.GetLanguage() != "" {
+			return value + "@" + langtag.JoinDirection(literal.GetLanguage(), literal.GetDirection()), true
+		}
+		if datatype := literal.GetDatatype(); datatype != nil && !datatype.Equals(IRI.XSD.String) {
+			return value + "^^<" + datatype.GetValue() + ">", true
+		}
+		return value, true
+	default:
+		return "", false
+	}
+}
+
+func stringToTerm(value string) interfaces.ITerm {
+	if strings.HasPrefix(value, "_:") {
+		return NewBlankNode(value[2:])
+	}
+	if !strings.HasPrefix(value, "\"") {
+		return NewNamedNode(value)
+	}
+	end := strings.LastIndexByte(value, '"')
+	if end <= 0 {
+		return NewLiteral(value[1:], "", IRI.XSD.String)
+	}
+	lexical, suffix := value[1:end], value[end+1:]
+	switch {
+	case strings.HasPrefix(suffix, "@"):
+		return NewStringLiteral(lexical, suffix[1:])
+	case strings.HasPrefix(suffix, "^^"):
+		return NewLiteral(lexical, "", NewNamedNode(suffix[2:]))
+	default:
+		return NewLiteral(lexical, "", IRI.XSD.String)
+	}
+}
@@ -0,0 +1,81 @@
+package rdfgo
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func TestSection_ExtractLocate(t *testing.T) {
+	var values []string
+	for i := 0; i < 100; i++ {
+		values = append(values, fmt.Sprintf("http://example.com/resource/%03d", i))
+	}
+	values = append(values, "http://example.com/resource/zzz", "http://example.org/")
+	sort.Strings(values)
+
+	s, err := readSection(&reader{data: appendSection(nil, values, defaultBlockSize)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i, value := range values {
+		extracted, ok := s.extract(uint64(i + 1))
+		if !ok || extracted != value {
+			t.Errorf("Expected id %d to be %s, got %s", i+1, value, extracted)
+		}
+		if s.locate(value) != uint64(i+1) {
+			t.Errorf("Expected %s to have id %d, got %d", value, i+1, s.locate(value))
+		}
+	}
+	for _, missing := range []string{"", "a", "http://example.com/resource/0005", "zzz"} {
+		if s.locate(missing) != 0 {
+			t.Errorf("Expected %q not to be located", missing)
+		}
+	}
+	if _, ok := s.extract(0); ok {
+		t.Errorf("Expected id 0 not to be extracted")
+	}
+	if _, ok := s.extract(uint64(len(values) + 1)); ok {
+		t.Errorf("Expected an id past the end not to be extracted")
+	}
+}
+
+func TestSection_Empty(t *testing.T) {
+	s, err := readSection(&reader{data: appendSection(nil, nil, defaultBlockSize)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s.locate("anything") != 0 {
+		t.Errorf("Expected an empty section not to locate anything")
+	}
+}
+
+func TestTermString_RoundTrip(t *testing.T) {
+	tests := []interfaces.ITerm{
+		NewNamedNode("http://example.com/s"),
+		NewBlankNode("b0"),
+		NewLiteral("plain", "", IRI.XSD.String),
+		NewStringLiteral("hallo", "nl"),
+		NewStringLiteral("مرحبا", "ar--rtl"),
+		NewIntegerLiteral(42),
+		NewLiteral("with \"quotes\"", "", NewNamedNode("http://example.com/type")),
+	}
+	for _, term := range tests {
+		value, ok := termToString(term)
+		if !ok {
+			t.Fatalf("Expected %s to be encodable", term.ToString())
+		}
+		if !stringToTerm(value).Equals(term) {
+			t.Errorf("Expected %s to round trip, got %s", term.ToString(), stringToTerm(value).ToString())
+		}
+	}
+	if _, ok := termToString(NewVariable("v")); ok {
+		t.Errorf("Expected a variable not to be encodable")
+	}
+	if !stringToTerm("\"unterminated").Equals(NewLiteral("unterminated", "", IRI.XSD.String)) {
+		t.Errorf("Expected an unterminated literal to be read as a plain literal")
+	}
+}
@@ -0,0 +1,192 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	stream "github.com/maartyman/rdfgo/lib/stream"
+)
+
+var ClosedError = errors.New("hdt: closed")
+
+type HDT struct {
+	header     string
+	dictionary *dictionary
+	triples    *bitmapTriples
+	unmap      func() error
+	// mux guards closed. Matches hold a read lock while they read the
+	// triples, so Close waits for them before it unmaps the file. Close first
+	// cancels closing, which stops the matches that wait for their consumer.
+	mux           sync.RWMutex
+	closed        bool
+	closing       context.Context
+	cancelClosing context.CancelFunc
+}
+
+func Open(path string) (*HDT, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, unmap, err := mapFile(file)
+	if err != nil {
+		return nil, err
+	}
+	hdt, err := Load(data)
+	if err != nil {
+		_ = unmap()
+		return nil, err
+	}
+	hdt.unmap = unmap
+	return hdt, nil
+}
+
+func Load(data []byte) (*HDT, error) {
+	r := &reader{data: data}
+
+	ci, err := readControlInformation(r, globalControl)
+	if err != nil {
+		return nil, err
+	}
+	if ci.format != globalFormat {
+		return nil, fmt.Errorf("%w: unsupported HDT version %s", FormatError, ci.format)
+	}
+
+	ci, err = readControlInformation(r, headerControl)
+	if err != nil {
+		return nil, err
+	}
+	length, err := ci.getInt("length")
+	if err != nil {
+		return nil, err
+	}
+	header, err := r.readBytes(length)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := readDictionary(r)
+	if err != nil {
+		return nil, err
+	}
+	t, err := readTriples(r)
+	if err != nil {
+		return nil, err
+	}
+	closing, cancelClosing := context.WithCancel(context.Background())
+	return &HDT{
+		header:        string(header),
+		dictionary:    d,
+		triples:       t,
+		closing:       closing,
+		cancelClosing: cancelClosing,
+	}, nil
+}
+
+// Close unmaps the file of an HDT that was opened with Open. The matches that
+// are running stop and fail with ClosedError, like the matches that start
+// later.
+func (h *HDT) Close() error {
+	h.cancelClosing()
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.closed = true
+	if h.unmap == nil {
+		return nil
+	}
+	unmap := h.unmap
+	h.unmap = nil
+	return unmap()
+}
+
+func (h *HDT) Header() string {
+	return h.header
+}
+
+// Size returns the number of triples, or 0 once the HDT is closed.
+func (h *HDT) Size() int {
+	h.mux.RLock()
+	defer h.mux.RUnlock()
+	if h.closed {
+		return 0
+	}
+	return int(h.triples.size())
+}
+
+func (h *HDT) termID(term interfaces.ITerm, position role) (uint64, bool) {
+	if term == nil || term.GetType() == interfaces.VariableType {
+		return 0, true
+	}
+	value, ok := termToString(term)
+	if !ok {
+		return 0, false
+	}
+	id := h.dictionary.stringToID(value, position)
+	return id, id != 0
+}
+
+func (h *HDT) Match(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) interfaces.IStream {
+	return h.MatchContext(context.Background(), subject, predicate, object, graph).ToIStream()
+}
+
+// MatchContext is like Match, but stops producing triples when ctx is
+// cancelled or the returned stream is closed. The stream fails with
+// ClosedError when the HDT is closed.
+func (h *HDT) MatchContext(
+	ctx context.Context,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) *stream.QuadStream {
+	matchContext, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(h.closing, cancel)
+	return stream.NewQuadStream(matchContext, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		defer stop()
+		if graph != nil && graph.GetType() != interfaces.VariableType && graph.GetType() != interfaces.DefaultGraphType {
+			return nil
+		}
+		h.mux.RLock()
+		defer h.mux.RUnlock()
+		if h.closed || h.closing.Err() != nil {
+			return ClosedError
+		}
+		subjectID, subjectFound := h.termID(subject, subjectRole)
+		predicateID, predicateFound := h.termID(predicate, predicateRole)
+		objectID, objectFound := h.termID(object, objectRole)
+		if !subjectFound || !predicateFound || !objectFound {
+			return nil
+		}
+
+		var err error
+		h.triples.match(subjectID, predicateID, objectID, func(s uint64, p uint64, o uint64) bool {
+			var quad interfaces.IQuad
+			quad, err = NewQuad(
+				h.dictionary.idToTerm(s, subjectRole),
+				h.dictionary.idToTerm(p, predicateRole),
+				h.dictionary.idToTerm(o, objectRole),
+				NewDefaultGraph(),
+			)
+			return err == nil && emit(quad)
+		})
+		if err != nil {
+			return err
+		}
+		if h.closing.Err() != nil {
+			return ClosedError
+		}
+		return ctx.Err()
+	})
+}
@@ -0,0 +1,247 @@
+package rdfgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	. "github.com/maartyman/rdfgo/lib/stream"
+)
+
+func testQuads() []interfaces.IQuad {
+	var quads []interfaces.IQuad
+	add := func(s interfaces.ITerm, p interfaces.ITerm, o interfaces.ITerm) {
+		quad, _ := NewQuad(s, p, o, nil)
+		quads = append(quads, quad)
+	}
+	knows := NewNamedNode("http://xmlns.com/foaf/0.1/knows")
+	name := NewNamedNode("http://xmlns.com/foaf/0.1/name")
+	rdfType := NewNamedNode("http://www.w3.org/1999/02/22-rdf-syntax-ns#type")
+	person := NewNamedNode("http://xmlns.com/foaf/0.1/Person")
+	for i := 0; i < 40; i++ {
+		subject := NewNamedNode("http://example.com/person/" + strconv.Itoa(i))
+		add(subject, rdfType, person)
+		add(subject, name, NewStringLiteral("Person "+strconv.Itoa(i), "en"))
+		add(subject, knows, NewNamedNode("http://example.com/person/"+strconv.Itoa((i+1)%40)))
+		add(subject, knows, NewNamedNode("http://example.com/person/"+strconv.Itoa((i+7)%40)))
+	}
+	add(NewBlankNode("b0"), name, NewLiteral("anonymous", "", IRI.XSD.String))
+	add(NewBlankNode("b0"), knows, NewBlankNode("b1"))
+	add(NewNamedNode("http://example.com/age"), rdfType, NewNamedNode("http://example.com/Property"))
+	add(NewNamedNode("http://example.com/person/0"), NewNamedNode("http://example.com/age"), NewIntegerLiteral(31))
+	return quads
+}
+
+func writeTestHDT(t *testing.T, quads []interfaces.IQuad) *HDT {
+	var buffer bytes.Buffer
+	if err := Write(&buffer, ArrayToStream(quads).ToIStream(), "http://example.com/dataset"); err != nil {
+		t.Fatalf("Unexpected error writing HDT: %v", err)
+	}
+	hdt, err := Load(buffer.Bytes())
+	if err != nil {
+		t.Fatalf("Unexpected error loading HDT: %v", err)
+	}
+	return hdt
+}
+
+func TestHDT_RoundTrip(t *testing.T) {
+	quads := testQuads()
+	hdt := writeTestHDT(t, quads)
+	defer hdt.Close()
+
+	if hdt.Size() != len(quads) {
+		t.Errorf("Expected %d triples, got %d", len(quads), hdt.Size())
+	}
+	if !strings.Contains(hdt.Header(), "<http://rdfs.org/ns/void#triples> \""+strconv.Itoa(len(quads))+"\"") {
+		t.Errorf("Expected the header to contain the number of triples, got %s", hdt.Header())
+	}
+	matched := make(map[string]bool)
+	for quad := range hdt.Match(nil, nil, nil, nil) {
+		matched[quad.ToString()] = true
+	}
+	if len(matched) != len(quads) {
+		t.Errorf("Expected %d distinct triples, got %d", len(quads), len(matched))
+	}
+	for _, quad := range quads {
+		if !matched[quad.ToString()] {
+			t.Errorf("Expected %s to be in the HDT", quad.ToString())
+		}
+	}
+}
+
+func TestHDT_Match(t *testing.T) {
+	quads := testQuads()
+	hdt := writeTestHDT(t, quads)
+	store := ArrayToStream(quads).ToStore()
+
+	terms := [][]interfaces.ITerm{
+		{nil, NewNamedNode("http://example.com/person/3"), NewBlankNode("b0"), NewNamedNode("http://example.com/age"), NewNamedNode("http://example.com/missing")},
+		{nil, NewNamedNode("http://xmlns.com/foaf/0.1/knows"), NewNamedNode("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), NewNamedNode("http://example.com/age"), NewNamedNode("http://example.com/missing")},
+		{nil, NewNamedNode("http://example.com/person/4"), NewNamedNode("http://xmlns.com/foaf/0.1/Person"), NewStringLiteral("Person 3", "en"), NewBlankNode("b1"), NewIntegerLiteral(31), NewNamedNode("http://example.com/missing")},
+	}
+	for _, subject := range terms[0] {
+		for _, predicate := range terms[1] {
+			for _, object := range terms[2] {
+				expected := Stream(store.Match(subject, predicate, object, nil)).Count()
+				got := Stream(hdt.Match(subject, predicate, object, nil)).Count()
+				if expected != got {
+					t.Errorf("Expected %d matches for (%v, %v, %v), got %d", expected, subject, predicate, object, got)
+				}
+			}
+		}
+	}
+}
+
+func TestHDT_MatchGraph(t *testing.T) {
+	hdt := writeTestHDT(t, testQuads())
+	if Stream(hdt.Match(nil, nil, nil, NewNamedNode("http://example.com/graph"))).Count() != 0 {
+		t.Errorf("Expected a named graph not to match anything")
+	}
+	if Stream(hdt.Match(nil, nil, nil, NewDefaultGraph())).Count() != hdt.Size() {
+		t.Errorf("Expected the default graph to match every triple")
+	}
+	if Stream(hdt.Match(NewVariable("s"), NewVariable("p"), NewVariable("o"), NewVariable("g"))).Count() != hdt.Size() {
+		t.Errorf("Expected variables to match every triple")
+	}
+	if Stream(hdt.Match(NewDefaultGraph(), nil, nil, nil)).Count() != 0 {
+		t.Errorf("Expected a term without a dictionary encoding not to match anything")
+	}
+}
+
+func TestHDT_MatchContext(t *testing.T) {
+	hdt := writeTestHDT(t, testQuads())
+	quadStream := hdt.MatchContext(context.Background(), nil, nil, nil, nil)
+	<-quadStream.Quads()
+	quadStream.Close()
+	count := 1
+	for range quadStream.Quads() {
+		count++
+	}
+	if count >= hdt.Size() || quadStream.Err() != nil {
+		t.Errorf("Expected the match to stop without an error, got %d triples and %v", count, quadStream.Err())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := hdt.MatchContext(ctx, nil, nil, nil, nil).Count(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestHDT_Empty(t *testing.T) {
+	hdt := writeTestHDT(t, nil)
+	if hdt.Size() != 0 {
+		t.Errorf("Expected an empty HDT, got %d triples", hdt.Size())
+	}
+	if Stream(hdt.Match(nil, nil, nil, nil)).Count() != 0 {
+		t.Errorf("Expected an empty HDT not to match anything")
+	}
+}
+
+func TestHDT_OpenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.hdt")
+	quads := testQuads()
+	if err := WriteFile(path, ArrayToStream(quads).ToIStream(), ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	hdt, err := Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if Stream(hdt.Match(NewNamedNode("http://example.com/person/1"), nil, nil, nil)).Count() != 4 {
+		t.Errorf("Expected 4 triples for person 1")
+	}
+	if err := hdt.Close(); err != nil {
+		t.Errorf("Unexpected error closing HDT: %v", err)
+	}
+	if err := hdt.Close(); err != nil {
+		t.Errorf("Expected a second Close to be a no-op, got %v", err)
+	}
+	if _, err := hdt.MatchContext(context.Background(), nil, nil, nil, nil).Count(); !errors.Is(err, ClosedError) {
+		t.Errorf("Expected ClosedError matching a closed HDT, got %v", err)
+	}
+	if Stream(hdt.Match(nil, nil, nil, nil)).Count() != 0 || hdt.Size() != 0 {
+		t.Errorf("Expected a closed HDT to be empty")
+	}
+
+	if _, err := Open(filepath.Join(t.TempDir(), "missing.hdt")); err == nil {
+		t.Errorf("Expected an error opening a missing file")
+	}
+	empty := filepath.Join(t.TempDir(), "empty.hdt")
+	_ = os.WriteFile(empty, nil, 0o644)
+	if _, err := Open(empty); !errors.Is(err, TruncatedFileError) {
+		t.Errorf("Expected TruncatedFileError for an empty file, got %v", err)
+	}
+}
+
+func TestHDT_CloseDuringMatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.hdt")
+	if err := WriteFile(path, ArrayToStream(testQuads()).ToIStream(), ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	hdt, err := Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	quadStream := hdt.MatchContext(context.Background(), nil, nil, nil, nil)
+	<-quadStream.Quads()
+	closed := make(chan error)
+	go func() {
+		closed <- hdt.Close()
+	}()
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Errorf("Unexpected error closing HDT: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected Close not to wait for a match that is not consumed")
+	}
+	for range quadStream.Quads() {
+	}
+	if !errors.Is(quadStream.Err(), ClosedError) {
+		t.Errorf("Expected the running match to fail with ClosedError, got %v", quadStream.Err())
+	}
+}
+
+func TestHDT_WriteUnsupportedTerm(t *testing.T) {
+	inner, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil)
+	quad, _ := NewQuad(inner, NewNamedNode("p"), NewNamedNode("o"), nil)
+	err := Write(&bytes.Buffer{}, ArrayToStream([]interfaces.IQuad{quad, nil, quad}).ToIStream(), "")
+	if !errors.Is(err, UnsupportedTermError) {
+		t.Errorf("Expected UnsupportedTermError, got %v", err)
+	}
+}
+
+func TestHDT_LoadCorrupted(t *testing.T) {
+	var buffer bytes.Buffer
+	_ = Write(&buffer, ArrayToStream(testQuads()).ToIStream(), "")
+	data := buffer.Bytes()
+	hdt, _ := Load(data)
+	headerStart := bytes.Index(data, []byte(hdt.Header()))
+	headerEnd := headerStart + len(hdt.Header())
+
+	for i := 0; i < len(data); i += 7 {
+		if i >= headerStart && i < headerEnd {
+			continue
+		}
+		corrupted := append([]byte{}, data...)
+		corrupted[i] ^= 0x5A
+		if _, err := Load(corrupted); err == nil {
+			t.Fatalf("Expected an error when byte %d is corrupted", i)
+		}
+	}
+	for i := 0; i < len(data); i += 13 {
+		if _, err := Load(data[:i]); err == nil {
+			t.Fatalf("Expected an error when the file is truncated to %d bytes", i)
+		}
+	}
+}
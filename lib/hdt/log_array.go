@@ -0,0 +1,147 @@
+package rdfgo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+const sequenceLogType = 1
+
+// logArray is a sequence of fixed width integers packed in little endian
+// 64-bit words, read directly from the (memory mapped) file contents.
+type logArray struct {
+	bits    uint
+	length  uint64
+	data    []byte
+	maximum uint64
+}
+
+func readLogArray(r *reader) (*logArray, error) {
+	start := r.pos
+	kind, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if kind != sequenceLogType {
+		return nil, fmt.Errorf("%w: unsupported sequence type %d", FormatError, kind)
+	}
+	numBits, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if numBits > 64 {
+		return nil, fmt.Errorf("%w: sequence entries of %d bits", FormatError, numBits)
+	}
+	length, err := r.readVByte()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkCRC8(start); err != nil {
+		return nil, err
+	}
+	if numBits > 0 && length > uint64(len(r.data))*8/uint64(numBits) {
+		return nil, TruncatedFileError
+	}
+	data, err := r.readBytes((uint64(numBits)*length + 7) / 8)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkCRC32(data); err != nil {
+		return nil, err
+	}
+	return &logArray{
+		bits:    uint(numBits),
+		length:  length,
+		data:    data,
+		maximum: maskFor(uint(numBits)),
+	}, nil
+}
+
+func maskFor(numBits uint) uint64 {
+	if numBits >= 64 {
+		return ^uint64(0)
+	}
+	return 1<<numBits - 1
+}
+
+func word(data []byte, index uint64) uint64 {
+	offset := index * 8
+	if offset+8 <= uint64(len(data)) {
+		return binary.LittleEndian.Uint64(data[offset:])
+	}
+	var buffer [8]byte
+	if offset < uint64(len(data)) {
+		copy(buffer[:], data[offset:])
+	}
+	return binary.LittleEndian.Uint64(buffer[:])
+}
+
+func (a *logArray) get(index uint64) uint64 {
+	if a.bits == 0 {
+		return 0
+	}
+	position := index * uint64(a.bits)
+	w := position / 64
+	offset := uint(position % 64)
+	value := word(a.data, w) >> offset
+	if offset+a.bits > 64 {
+		value |= word(a.data, w+1) << (64 - offset)
+	}
+	return value & a.maximum
+}
+
+// search returns the first index in [low, high) holding value, assuming the
+// entries in that range are sorted.
+func (a *logArray) search(low uint64, high uint64, value uint64) (uint64, bool) {
+	end := high
+	for low < high {
+		middle := low + (high-low)/2
+		if a.get(middle) < value {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return low, low < end && a.get(low) == value
+}
+
+func appendLogArray(buffer []byte, values []uint64) []byte {
+	var maximum uint64
+	for _, value := range values {
+		if value > maximum {
+			maximum = value
+		}
+	}
+	numBits := uint(bits.Len64(maximum))
+
+	start := len(buffer)
+	buffer = append(buffer, sequenceLogType, byte(numBits))
+	buffer = appendVByte(buffer, uint64(len(values)))
+	buffer = append(buffer, crc8(buffer[start:]))
+
+	words := make([]uint64, (uint64(numBits)*uint64(len(values))+63)/64)
+	for i, value := range values {
+		if numBits == 0 {
+			break
+		}
+		position := uint64(i) * uint64(numBits)
+		w := position / 64
+		offset := uint(position % 64)
+		words[w] |= value << offset
+		if offset+numBits > 64 {
+			words[w+1] |= value >> (64 - offset)
+		}
+	}
+	return appendWords(buffer, words, (uint64(numBits)*uint64(len(values))+7)/8)
+}
+
+func appendWords(buffer []byte, words []uint64, size uint64) []byte {
+	data := make([]byte, 0, len(words)*8)
+	for _, w := range words {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+	data = data[:size]
+	buffer = append(buffer, data...)
+	return binary.LittleEndian.AppendUint32(buffer, crc32c(data))
+}
@@ -0,0 +1,73 @@
+package rdfgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLogArray_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []uint64
+		bits   uint
+	}{
+		{"Empty", nil, 0},
+		{"Zeros", []uint64{0, 0, 0}, 0},
+		{"Small", []uint64{1, 2, 3, 4, 5, 6, 7}, 3},
+		{"CrossesWords", []uint64{1000, 5, 999, 1023, 0, 512, 77, 3, 8, 1000, 1, 2, 3}, 10},
+		{"Wide", []uint64{1<<64 - 1, 1, 1 << 63}, 64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &reader{data: appendLogArray(nil, tt.values)}
+			array, err := readLogArray(r)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if array.bits != tt.bits || array.length != uint64(len(tt.values)) {
+				t.Errorf("Expected %d entries of %d bits, got %d of %d", len(tt.values), tt.bits, array.length, array.bits)
+			}
+			for i, value := range tt.values {
+				if array.get(uint64(i)) != value {
+					t.Errorf("Expected entry %d to be %d, got %d", i, value, array.get(uint64(i)))
+				}
+			}
+		})
+	}
+}
+
+func TestLogArray_Search(t *testing.T) {
+	r := &reader{data: appendLogArray(nil, []uint64{1, 3, 5, 7, 9})}
+	array, _ := readLogArray(r)
+	if index, found := array.search(0, 5, 7); !found || index != 3 {
+		t.Errorf("Expected to find 7 at index 3, got %d (%t)", index, found)
+	}
+	if _, found := array.search(0, 5, 4); found {
+		t.Errorf("Expected 4 not to be found")
+	}
+	if _, found := array.search(0, 2, 7); found {
+		t.Errorf("Expected 7 not to be found outside the searched range")
+	}
+}
+
+func TestLogArray_Corrupted(t *testing.T) {
+	data := appendLogArray(nil, []uint64{1, 2, 3})
+	data[len(data)-5] ^= 0xFF
+	_, err := readLogArray(&reader{data: data})
+	if !errors.Is(err, ChecksumError) {
+		t.Errorf("Expected ChecksumError, got %v", err)
+	}
+
+	data = appendLogArray(nil, []uint64{1, 2, 3})
+	data[0] = 9
+	_, err = readLogArray(&reader{data: data})
+	if !errors.Is(err, FormatError) {
+		t.Errorf("Expected FormatError, got %v", err)
+	}
+
+	data = appendLogArray(nil, []uint64{1, 2, 3})
+	_, err = readLogArray(&reader{data: data[:5]})
+	if !errors.Is(err, TruncatedFileError) {
+		t.Errorf("Expected TruncatedFileError, got %v", err)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !unix
+
+package rdfgo
+
+import (
+	"io"
+	"os"
+)
+
+func mapFile(file *os.File) ([]byte, func() error, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, func() error { return nil }, nil
+}
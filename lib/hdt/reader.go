@@ -0,0 +1,88 @@
+package rdfgo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var TruncatedFileError = errors.New("hdt: unexpected end of file")
+var FormatError = errors.New("hdt: unsupported or invalid format")
+var ChecksumError = errors.New("hdt: checksum mismatch")
+
+type reader struct {
+	data []byte
+	pos  int
+}
+
+func (r *reader) readByte() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, TruncatedFileError
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *reader) readVByte() (uint64, error) {
+	value, n, err := decodeVByte(r.data[r.pos:])
+	if err != nil {
+		return 0, err
+	}
+	r.pos += n
+	return value, nil
+}
+
+func (r *reader) readBytes(n uint64) ([]byte, error) {
+	if n > uint64(len(r.data)-r.pos) {
+		return nil, TruncatedFileError
+	}
+	data := r.data[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return data, nil
+}
+
+func (r *reader) readCString() (string, error) {
+	end := bytes.IndexByte(r.data[r.pos:], 0)
+	if end < 0 {
+		return "", TruncatedFileError
+	}
+	value := string(r.data[r.pos : r.pos+end])
+	r.pos += end + 1
+	return value, nil
+}
+
+func (r *reader) checkCRC8(start int) error {
+	crc, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	if crc8(r.data[start:r.pos-1]) != crc {
+		return fmt.Errorf("%w: crc8 at offset %d", ChecksumError, start)
+	}
+	return nil
+}
+
+func (r *reader) checkCRC16(start int) error {
+	end := r.pos
+	crc, err := r.readBytes(2)
+	if err != nil {
+		return err
+	}
+	if crc16(r.data[start:end]) != binary.LittleEndian.Uint16(crc) {
+		return fmt.Errorf("%w: crc16 at offset %d", ChecksumError, start)
+	}
+	return nil
+}
+
+func (r *reader) checkCRC32(data []byte) error {
+	crc, err := r.readBytes(4)
+	if err != nil {
+		return err
+	}
+	if crc32c(data) != binary.LittleEndian.Uint32(crc) {
+		return fmt.Errorf("%w: crc32 before offset %d", ChecksumError, r.pos)
+	}
+	return nil
+}
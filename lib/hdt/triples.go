@@ -0,0 +1,215 @@
+package rdfgo
+
+import (
+	"fmt"
+	"sync"
+)
+
+const orderSPO = 1
+
+// bitmapTriples stores the triples as a forest of (subject, predicate,
+// object) trees: arrayY holds the predicates of every subject, arrayZ the
+// objects of every (subject, predicate) pair, and the bitmaps mark the last
+// child of every parent.
+type bitmapTriples struct {
+	bitmapY *bitmap
+	bitmapZ *bitmap
+	arrayY  *logArray
+	arrayZ  *logArray
+
+	predicateOnce  sync.Once
+	predicateIndex *positionIndex
+	objectOnce     sync.Once
+	objectIndex    *positionIndex
+}
+
+// positionIndex lists, for every id, the positions in an array holding that
+// id. It is built on first use to answer patterns without a bound subject.
+type positionIndex struct {
+	offsets   []uint64
+	positions []uint64
+}
+
+func readTriples(r *reader) (*bitmapTriples, error) {
+	ci, err := readControlInformation(r, triplesControl)
+	if err != nil {
+		return nil, err
+	}
+	if ci.format != triplesFormat {
+		return nil, fmt.Errorf("%w: unsupported triples %s", FormatError, ci.format)
+	}
+	order, err := ci.getInt("order")
+	if err != nil {
+		return nil, err
+	}
+	if order != orderSPO {
+		return nil, fmt.Errorf("%w: unsupported triple order %d", FormatError, order)
+	}
+
+	t := &bitmapTriples{}
+	if t.bitmapY, err = readBitmap(r); err != nil {
+		return nil, err
+	}
+	if t.bitmapZ, err = readBitmap(r); err != nil {
+		return nil, err
+	}
+	if t.arrayY, err = readLogArray(r); err != nil {
+		return nil, err
+	}
+	if t.arrayZ, err = readLogArray(r); err != nil {
+		return nil, err
+	}
+	if t.arrayY.length != t.bitmapY.length ||
+		t.arrayZ.length != t.bitmapZ.length ||
+		t.bitmapZ.ones != t.arrayY.length {
+		return nil, fmt.Errorf("%w: inconsistent bitmap triples", FormatError)
+	}
+	return t, nil
+}
+
+func (t *bitmapTriples) size() uint64 {
+	return t.arrayZ.length
+}
+
+func (t *bitmapTriples) numSubjects() uint64 {
+	return t.bitmapY.ones
+}
+
+func (t *bitmapTriples) predicateRange(subject uint64) (uint64, uint64) {
+	start := uint64(0)
+	if subject > 1 {
+		start = t.bitmapY.selectOne(subject-1) + 1
+	}
+	return start, t.bitmapY.selectOne(subject) + 1
+}
+
+func (t *bitmapTriples) objectRange(y uint64) (uint64, uint64) {
+	start := uint64(0)
+	if y > 0 {
+		start = t.bitmapZ.selectOne(y) + 1
+	}
+	return start, t.bitmapZ.selectOne(y+1) + 1
+}
+
+func (t *bitmapTriples) subjectOf(y uint64) uint64 {
+	return t.bitmapY.rank(y) + 1
+}
+
+func (t *bitmapTriples) parentOf(z uint64) uint64 {
+	return t.bitmapZ.rank(z)
+}
+
+func buildPositionIndex(array *logArray) *positionIndex {
+	var maximum uint64
+	for i := uint64(0); i < array.length; i++ {
+		maximum = max(maximum, array.get(i))
+	}
+	index := &positionIndex{
+		offsets:   make([]uint64, maximum+2),
+		positions: make([]uint64, array.length),
+	}
+	for i := uint64(0); i < array.length; i++ {
+		index.offsets[array.get(i)+1]++
+	}
+	for i := 1; i < len(index.offsets); i++ {
+		index.offsets[i] += index.offsets[i-1]
+	}
+	next := make([]uint64, len(index.offsets))
+	copy(next, index.offsets)
+	for i := uint64(0); i < array.length; i++ {
+		value := array.get(i)
+		index.positions[next[value]] = i
+		next[value]++
+	}
+	return index
+}
+
+func (index *positionIndex) get(id uint64) []uint64 {
+	if id+1 >= uint64(len(index.offsets)) {
+		return nil
+	}
+	return index.positions[index.offsets[id]:index.offsets[id+1]]
+}
+
+// match calls callback with the ids of every triple matching the pattern,
+// where 0 marks an unbound position, until callback returns false.
+func (t *bitmapTriples) match(subject uint64, predicate uint64, object uint64, callback func(uint64, uint64, uint64) bool) {
+	switch {
+	case subject != 0:
+		if subject > t.numSubjects() {
+			return
+		}
+		start, end := t.predicateRange(subject)
+		if predicate != 0 {
+			y, found := t.arrayY.search(start, end, predicate)
+			if found {
+				t.matchObjects(subject, predicate, y, object, callback)
+			}
+			return
+		}
+		for y := start; y < end; y++ {
+			if !t.matchObjects(subject, t.arrayY.get(y), y, object, callback) {
+				return
+			}
+		}
+	case predicate != 0:
+		t.predicateOnce.Do(func() {
+			t.predicateIndex = buildPositionIndex(t.arrayY)
+		})
+		for _, y := range t.predicateIndex.get(predicate) {
+			if !t.matchObjects(t.subjectOf(y), predicate, y, object, callback) {
+				return
+			}
+		}
+	case object != 0:
+		t.objectOnce.Do(func() {
+			t.objectIndex = buildPositionIndex(t.arrayZ)
+		})
+		for _, z := range t.objectIndex.get(object) {
+			y := t.parentOf(z)
+			if !callback(t.subjectOf(y), t.arrayY.get(y), object) {
+				return
+			}
+		}
+	default:
+		subject = 1
+		z := uint64(0)
+		for y := uint64(0); y < t.arrayY.length; y++ {
+			predicate = t.arrayY.get(y)
+			for ; z < t.arrayZ.length; z++ {
+				if !callback(subject, predicate, t.arrayZ.get(z)) {
+					return
+				}
+				if t.bitmapZ.access(z) {
+					z++
+					break
+				}
+			}
+			if t.bitmapY.access(y) {
+				subject++
+			}
+		}
+	}
+}
+
+func (t *bitmapTriples) matchObjects(
+	subject uint64,
+	predicate uint64,
+	y uint64,
+	object uint64,
+	callback func(uint64, uint64, uint64) bool,
+) bool {
+	start, end := t.objectRange(y)
+	if object != 0 {
+		if _, found := t.arrayZ.search(start, end, object); found {
+			return callback(subject, predicate, object)
+		}
+		return true
+	}
+	for z := start; z < end; z++ {
+		if !callback(subject, predicate, t.arrayZ.get(z)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,22 @@
+package rdfgo
+
+// HDT encodes variable length integers in groups of seven bits, least
+// significant group first, with the high bit set on the final byte.
+func appendVByte(buffer []byte, value uint64) []byte {
+	for value > 127 {
+		buffer = append(buffer, byte(value&127))
+		value >>= 7
+	}
+	return append(buffer, byte(value)|0x80)
+}
+
+func decodeVByte(data []byte) (uint64, int, error) {
+	var value uint64
+	for i := 0; i < len(data) && i < 10; i++ {
+		value |= uint64(data[i]&127) << (7 * uint(i))
+		if data[i]&0x80 != 0 {
+			return value, i + 1, nil
+		}
+	}
+	return 0, 0, TruncatedFileError
+}
@@ -0,0 +1,29 @@
+package rdfgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVByte_RoundTrip(t *testing.T) {
+	for _, value := range []uint64{0, 1, 127, 128, 300, 1 << 32, 1<<64 - 1} {
+		encoded := appendVByte(nil, value)
+		if encoded[len(encoded)-1]&0x80 == 0 {
+			t.Errorf("Expected the last byte of %d to have the high bit set", value)
+		}
+		decoded, n, err := decodeVByte(encoded)
+		if err != nil {
+			t.Fatalf("Unexpected error decoding %d: %v", value, err)
+		}
+		if decoded != value || n != len(encoded) {
+			t.Errorf("Expected %d in %d bytes, got %d in %d bytes", value, len(encoded), decoded, n)
+		}
+	}
+}
+
+func TestVByte_Truncated(t *testing.T) {
+	_, _, err := decodeVByte([]byte{0x01, 0x02})
+	if !errors.Is(err, TruncatedFileError) {
+		t.Errorf("Expected TruncatedFileError, got %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+package rdfgo
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+var UnsupportedTermError = errors.New("hdt: term can not be stored in a dictionary")
+
+func WriteFile(path string, stream interfaces.IStream, baseIRI string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(file)
+	if err := Write(writer, stream, baseIRI); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Write drains stream and writes it as an HDT file. HDT only stores triples,
+// so the graph of every quad is ignored and duplicate triples are merged.
+func Write(w io.Writer, stream interfaces.IStream, baseIRI string) error {
+	triples := make(map[[3]string]struct{})
+	var termError error
+	for quad := range stream {
+		if quad == nil || termError != nil {
+			continue
+		}
+		var triple [3]string
+		for i, term := range []interfaces.ITerm{quad.GetSubject(), quad.GetPredicate(), quad.GetObject()} {
+			value, ok := termToString(term)
+			if !ok {
+				termError = fmt.Errorf("%w: %s", UnsupportedTermError, term.ToString())
+				break
+			}
+			triple[i] = value
+		}
+		triples[triple] = struct{}{}
+	}
+	if termError != nil {
+		return termError
+	}
+
+	subjects := make(map[string]struct{})
+	predicates := make(map[string]struct{})
+	objects := make(map[string]struct{})
+	for triple := range triples {
+		subjects[triple[0]] = struct{}{}
+		predicates[triple[1]] = struct{}{}
+		objects[triple[2]] = struct{}{}
+	}
+	var shared []string
+	for subject := range subjects {
+		if _, ok := objects[subject]; ok {
+			shared = append(shared, subject)
+			delete(subjects, subject)
+			delete(objects, subject)
+		}
+	}
+	sort.Strings(shared)
+	subjectSection := sortedKeys(subjects)
+	predicateSection := sortedKeys(predicates)
+	objectSection := sortedKeys(objects)
+
+	subjectIDs := make(map[string]uint64, len(shared)+len(subjectSection))
+	objectIDs := make(map[string]uint64, len(shared)+len(objectSection))
+	predicateIDs := make(map[string]uint64, len(predicateSection))
+	for i, value := range shared {
+		subjectIDs[value] = uint64(i + 1)
+		objectIDs[value] = uint64(i + 1)
+	}
+	for i, value := range subjectSection {
+		subjectIDs[value] = uint64(len(shared) + i + 1)
+	}
+	for i, value := range objectSection {
+		objectIDs[value] = uint64(len(shared) + i + 1)
+	}
+	for i, value := range predicateSection {
+		predicateIDs[value] = uint64(i + 1)
+	}
+
+	encoded := make([][3]uint64, 0, len(triples))
+	for triple := range triples {
+		encoded = append(encoded, [3]uint64{subjectIDs[triple[0]], predicateIDs[triple[1]], objectIDs[triple[2]]})
+	}
+	sort.Slice(encoded, func(i, j int) bool {
+		a, b := encoded[i], encoded[j]
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		if a[1] != b[1] {
+			return a[1] < b[1]
+		}
+		return a[2] < b[2]
+	})
+
+	buffer := appendHeader(nil, baseIRI, len(encoded), len(predicateSection), len(subjectIDs), len(objectIDs))
+	buffer = appendDictionary(buffer, shared, subjectSection, predicateSection, objectSection)
+	buffer = appendTriples(buffer, encoded)
+	_, err := w.Write(buffer)
+	return err
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func appendHeader(buffer []byte, baseIRI string, triples int, predicates int, subjects int, objects int) []byte {
+	var properties []string
+	subject := "_:dataset"
+	if baseIRI != "" {
+		properties = append(properties, "BaseUri="+baseIRI)
+		subject = "<" + baseIRI + ">"
+	}
+	buffer = appendControlInformation(buffer, globalControl, globalFormat, properties...)
+
+	header := subject + " <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://purl.org/HDT/hdt#Dataset> .\n"
+	for _, statistic := range []struct {
+		property string
+		value    int
+	}{
+		{"triples", triples},
+		{"properties", predicates},
+		{"distinctSubjects", subjects},
+		{"distinctObjects", objects},
+	} {
+		header += subject + " <http://rdfs.org/ns/void#" + statistic.property + "> \"" +
+			strconv.Itoa(statistic.value) + "\" .\n"
+	}
+	buffer = appendControlInformation(buffer, headerControl, headerFormat, "length="+strconv.Itoa(len(header)))
+	return append(buffer, header...)
+}
+
+func appendDictionary(buffer []byte, sections ...[]string) []byte {
+	size := 0
+	for _, section := range sections {
+		for _, value := range section {
+			size += len(value)
+		}
+	}
+	buffer = appendControlInformation(
+		buffer,
+		dictionaryControl,
+		dictionaryFormat,
+		"mapping=1",
+		"sizeStrings="+strconv.Itoa(size),
+	)
+	for _, section := range sections {
+		buffer = appendSection(buffer, section, defaultBlockSize)
+	}
+	return buffer
+}
+
+func appendTriples(buffer []byte, triples [][3]uint64) []byte {
+	var arrayY, arrayZ []uint64
+	var bitmapY, bitmapZ []bool
+	for i, triple := range triples {
+		last := i+1 == len(triples)
+		if i == 0 || triple[0] != triples[i-1][0] || triple[1] != triples[i-1][1] {
+			arrayY = append(arrayY, triple[1])
+			bitmapY = append(bitmapY, false)
+		}
+		arrayZ = append(arrayZ, triple[2])
+		bitmapZ = append(bitmapZ, last || triple[0] != triples[i+1][0] || triple[1] != triples[i+1][1])
+		if last || triple[0] != triples[i+1][0] {
+			bitmapY[len(bitmapY)-1] = true
+		}
+	}
+
+	buffer = appendControlInformation(buffer, triplesControl, triplesFormat, "order="+strconv.Itoa(orderSPO))
+	buffer = appendBitmap(buffer, bitmapY)
+	buffer = appendBitmap(buffer, bitmapZ)
+	buffer = appendLogArray(buffer, arrayY)
+	return appendLogArray(buffer, arrayZ)
+}
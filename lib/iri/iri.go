@@ -0,0 +1,219 @@
+package rdfgo
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"unicode/utf8"
+)
+
+var InvalidIRIError = errors.New("invalid IRI")
+var RelativeIRIError = errors.New("IRI is relative")
+
+// IRI is an IRI reference split into the components of RFC 3987. The Has
+// fields tell an empty component from a missing one.
+type IRI struct {
+	Scheme       string
+	Authority    string
+	Path         string
+	Query        string
+	Fragment     string
+	HasAuthority bool
+	HasQuery     bool
+	HasFragment  bool
+}
+
+// IsAbsolute reports whether the reference has a scheme.
+func (i IRI) IsAbsolute() bool {
+	return i.Scheme != ""
+}
+
+func (i IRI) String() string {
+	var builder strings.Builder
+	if i.Scheme != "" {
+		builder.WriteString(i.Scheme + ":")
+	}
+	if i.HasAuthority {
+		builder.WriteString("//" + i.Authority)
+	}
+	builder.WriteString(i.Path)
+	if i.HasQuery {
+		builder.WriteString("?" + i.Query)
+	}
+	if i.HasFragment {
+		builder.WriteString("#" + i.Fragment)
+	}
+	return builder.String()
+}
+
+// Parse splits an IRI reference, absolute or relative, into its components
+// and checks them against the grammar of RFC 3987.
+func Parse(reference string) (IRI, error) {
+	var iri IRI
+	rest := reference
+	if end := strings.IndexAny(rest, ":/?#"); end > 0 && rest[end] == ':' {
+		iri.Scheme, rest = rest[:end], rest[end+1:]
+		if !isScheme(iri.Scheme) {
+			return IRI{}, fmt.Errorf("%w: invalid scheme in %q", InvalidIRIError, reference)
+		}
+	}
+	rest, iri.Fragment, iri.HasFragment = strings.Cut(rest, "#")
+	rest, iri.Query, iri.HasQuery = strings.Cut(rest, "?")
+	if strings.HasPrefix(rest, "//") {
+		end := strings.IndexByte(rest[2:], '/')
+		if end == -1 {
+			end = len(rest) - 2
+		}
+		iri.Authority, iri.Path, iri.HasAuthority = rest[2:2+end], rest[2+end:], true
+	} else {
+		iri.Path = rest
+	}
+
+	switch {
+	case !utf8.ValidString(reference):
+		return IRI{}, fmt.Errorf("%w: %q is not valid UTF-8", InvalidIRIError, reference)
+	case iri.HasAuthority && !isAuthority(iri.Authority):
+		return IRI{}, fmt.Errorf("%w: invalid authority in %q", InvalidIRIError, reference)
+	case !isComponent(iri.Path, "/:@", false):
+		return IRI{}, fmt.Errorf("%w: invalid path in %q", InvalidIRIError, reference)
+	case iri.Scheme == "" && !iri.HasAuthority && strings.Contains(strings.SplitN(iri.Path, "/", 2)[0], ":"):
+		// A colon in the first segment of a relative path would make it a
+		// scheme.
+		return IRI{}, fmt.Errorf("%w: invalid scheme in %q", InvalidIRIError, reference)
+	case !isComponent(iri.Query, "/:@?", true):
+		return IRI{}, fmt.Errorf("%w: invalid query in %q", InvalidIRIError, reference)
+	case !isComponent(iri.Fragment, "/:@?", false):
+		return IRI{}, fmt.Errorf("%w: invalid fragment in %q", InvalidIRIError, reference)
+	}
+	return iri, nil
+}
+
+// Validate checks that an IRI is absolute and valid by RFC 3987.
+func Validate(iri string) error {
+	parsed, err := Parse(iri)
+	if err != nil {
+		return err
+	}
+	if !parsed.IsAbsolute() {
+		return fmt.Errorf("%w: %q", RelativeIRIError, iri)
+	}
+	return nil
+}
+
+func isScheme(scheme string) bool {
+	for i := 0; i < len(scheme); i++ {
+		c := scheme[i]
+		if !isAlpha(c) && (i == 0 || !isDigit(c) && c != '+' && c != '-' && c != '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// isAuthority checks [ iuserinfo "@" ] ihost [ ":" port ].
+func isAuthority(authority string) bool {
+	if at := strings.LastIndexByte(authority, '@'); at != -1 {
+		if !isComponent(authority[:at], ":", false) {
+			return false
+		}
+		authority = authority[at+1:]
+	}
+	host := authority
+	if colon := strings.LastIndexByte(authority, ':'); colon != -1 && !strings.Contains(authority[colon:], "]") {
+		host = authority[:colon]
+		for i := colon + 1; i < len(authority); i++ {
+			if !isDigit(authority[i]) {
+				return false
+			}
+		}
+	}
+	if strings.HasPrefix(host, "[") {
+		return strings.HasSuffix(host, "]") && isIPLiteral(host[1:len(host)-1])
+	}
+	return isComponent(host, "", false)
+}
+
+// isIPLiteral checks an IPv6 address or an IPvFuture between the brackets
+// of a host.
+func isIPLiteral(literal string) bool {
+	if len(literal) > 1 && (literal[0] == 'v' || literal[0] == 'V') {
+		version, rest, ok := strings.Cut(literal[1:], ".")
+		if !ok || version == "" || rest == "" || strings.Trim(version, "0123456789abcdefABCDEF") != "" {
+			return false
+		}
+		for i := 0; i < len(rest); i++ {
+			if !isUnreserved(rune(rest[i])) && !isSubDelim(rest[i]) && rest[i] != ':' {
+				return false
+			}
+		}
+		return true
+	}
+	address, err := netip.ParseAddr(literal)
+	return err == nil && address.Is6() && address.Zone() == ""
+}
+
+// isComponent checks that a component consists of iunreserved characters,
+// percent-encodings, sub-delims and the extra characters, and for a query
+// of iprivate characters.
+func isComponent(component string, extra string, private bool) bool {
+	for i := 0; i < len(component); {
+		r, size := utf8.DecodeRuneInString(component[i:])
+		switch {
+		case r == '%':
+			if i+2 >= len(component) || !isHex(component[i+1]) || !isHex(component[i+2]) {
+				return false
+			}
+			size = 3
+		case r < utf8.RuneSelf:
+			if !isUnreserved(r) && !isSubDelim(byte(r)) && !strings.ContainsRune(extra, r) {
+				return false
+			}
+		case !isUCSChar(r) && !(private && isPrivate(r)):
+			return false
+		}
+		i += size
+	}
+	return true
+}
+
+func isAlpha(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHex(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isSubDelim(c byte) bool {
+	return strings.IndexByte("!$&'()*+,;=", c) != -1
+}
+
+// isUnreserved reports whether r is in iunreserved.
+func isUnreserved(r rune) bool {
+	if r < utf8.RuneSelf {
+		c := byte(r)
+		return isAlpha(c) || isDigit(c) || c == '-' || c == '.' || c == '_' || c == '~'
+	}
+	return isUCSChar(r)
+}
+
+func isUCSChar(r rune) bool {
+	switch {
+	case 0xA0 <= r && r <= 0xD7FF, 0xF900 <= r && r <= 0xFDCF, 0xFDF0 <= r && r <= 0xFFEF:
+		return true
+	case 0x10000 <= r && r <= 0xEFFFD:
+		// Every plane but the last two code points of each, and plane 14
+		// from E1000.
+		return r&0xFFFE != 0xFFFE && (r < 0xE0000 || r >= 0xE1000)
+	}
+	return false
+}
+
+func isPrivate(r rune) bool {
+	return 0xE000 <= r && r <= 0xF8FF || 0xF0000 <= r && r <= 0xFFFFD || 0x100000 <= r && r <= 0x10FFFD
+}
@@ -0,0 +1,62 @@
+package rdfgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	iri, err := Parse("http://user@example.org:8080/a/b?c=d#e")
+	expected := IRI{
+		Scheme: "http", Authority: "user@example.org:8080", Path: "/a/b", Query: "c=d", Fragment: "e",
+		HasAuthority: true, HasQuery: true, HasFragment: true,
+	}
+	if err != nil || iri != expected {
+		t.Fatalf("expected %+v, got %+v, %v", expected, iri, err)
+	}
+	if iri.String() != "http://user@example.org:8080/a/b?c=d#e" {
+		t.Errorf("expected the IRI back, got %s", iri.String())
+	}
+
+	for _, reference := range []string{
+		"", "a", "../a/b", "#", "?", "//example.org", "//example.org?x", "urn:isbn:0451450523", "mailto:a@example.org",
+		"http://[::1]:80/", "http://[v7.fe:80]/", "http://例え.jp/パス?クエリ#断片", "http://a/%C3%A9", "tag:a,2024:b",
+		"http://a/?", "http://a/?\uE000", "http://a/\U00010000", "file:///etc/hosts", "x+y-z.w:a",
+	} {
+		iri, err := Parse(reference)
+		if err != nil {
+			t.Errorf("expected %q to be valid, got %v", reference, err)
+		} else if iri.String() != reference {
+			t.Errorf("expected %q back, got %q", reference, iri.String())
+		}
+	}
+
+	for _, reference := range []string{
+		"http://a/b c", "http://a/<b>", "http://a/%2", "http://a/%zz", "1http:a", "h_ttp:a", "a:b:c/d:e e",
+		"http://a:b/", "http://a b/", "http://[::1/", "http://[1.2.3.4]/", "http://[fe80::1%25eth0]/",
+		"http://[v.x]/", "http://[vz.x]/", "http://[v7.]/", "http://[v7.<]/", "http://a/\uE000", "http://a/\uFFFE",
+		"http://a/\U000E0001", "http://a/?a#b#c", "http://a/?{", "http://a/#{", "http://us er@a/", ":a", "\xff",
+	} {
+		if _, err := Parse(reference); !errors.Is(err, InvalidIRIError) {
+			t.Errorf("expected %q to be invalid, got %v", reference, err)
+		}
+	}
+	if _, err := Parse("a:b/c:d"); err != nil {
+		t.Errorf("expected a colon after the scheme to be valid, got %v", err)
+	}
+	if _, err := Parse("./a:b"); err != nil {
+		t.Errorf("expected a colon after the first segment to be valid, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate("http://example.org/"); err != nil {
+		t.Errorf("expected a valid IRI, got %v", err)
+	}
+	if err := Validate("/a"); !errors.Is(err, RelativeIRIError) {
+		t.Errorf("expected a RelativeIRIError, got %v", err)
+	}
+	if err := Validate("http://a/ b"); !errors.Is(err, InvalidIRIError) {
+		t.Errorf("expected an InvalidIRIError, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package rdfgo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Normalize applies the syntax-based normalization of RFC 3987, section
+// 5.3.2, to an IRI reference: the scheme and host are lowercased, the hex
+// digits of percent-encodings are uppercased, percent-encoded unreserved
+// characters are decoded and, for an absolute IRI, the dot segments are
+// removed from the path. Characters are not normalized to a Unicode form.
+func Normalize(reference string) (string, error) {
+	iri, err := Parse(reference)
+	if err != nil {
+		return "", err
+	}
+	iri.Scheme = strings.ToLower(iri.Scheme)
+	if iri.HasAuthority {
+		iri.Authority = normalizeAuthority(iri.Authority)
+	}
+	iri.Path = normalizePercentEncoding(iri.Path)
+	if iri.IsAbsolute() {
+		iri.Path = removeDotSegments(iri.Path)
+	}
+	iri.Query = normalizePercentEncoding(iri.Query)
+	iri.Fragment = normalizePercentEncoding(iri.Fragment)
+	return iri.String(), nil
+}
+
+func normalizeAuthority(authority string) string {
+	userinfo, host := "", authority
+	if at := strings.LastIndexByte(authority, '@'); at != -1 {
+		userinfo, host = normalizePercentEncoding(authority[:at+1]), authority[at+1:]
+	}
+	port := ""
+	if colon := strings.LastIndexByte(host, ':'); colon != -1 && !strings.Contains(host[colon:], "]") {
+		host, port = host[:colon], host[colon:]
+	}
+	// Lowercasing the host after decoding leaves the percent-encodings that
+	// are left with lowercase hex digits, so they are uppercased again.
+	host = normalizePercentEncoding(strings.ToLower(normalizePercentEncoding(host)))
+	return userinfo + host + port
+}
+
+// normalizePercentEncoding decodes the percent-encoded UTF-8 sequences of
+// unreserved characters and uppercases the hex digits of the others.
+func normalizePercentEncoding(component string) string {
+	if !strings.Contains(component, "%") {
+		return component
+	}
+	var builder strings.Builder
+	for i := 0; i < len(component); {
+		if component[i] != '%' {
+			builder.WriteByte(component[i])
+			i++
+			continue
+		}
+		var encoded []byte
+		for i+2 < len(component) && component[i] == '%' {
+			value, _ := strconv.ParseUint(component[i+1:i+3], 16, 8)
+			encoded = append(encoded, byte(value))
+			i += 3
+		}
+		for len(encoded) > 0 {
+			r, size := utf8.DecodeRune(encoded)
+			if r == utf8.RuneError || !isUnreserved(r) {
+				size = max(size, 1)
+				for _, b := range encoded[:size] {
+					fmt.Fprintf(&builder, "%%%02X", b)
+				}
+			} else {
+				builder.WriteRune(r)
+			}
+			encoded = encoded[size:]
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,28 @@
+package rdfgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	for iri, expected := range map[string]string{
+		"HTTP://User@Example.ORG:80/a/./b/../c": "http://User@example.org:80/a/c",
+		"http://a/%7euser/%61%2f%2F":            "http://a/~user/a%2F%2F",
+		"http://a/%C3%A9?%c3%a9#%C3%a9":         "http://a/é?é#é",
+		"http://a/%c3%28%E2%82":                 "http://a/%C3%28%E2%82",
+		"http://a/%EE%80%80":                    "http://a/%EE%80%80",
+		"http://%45x%2fAMPLE.org/":              "http://ex%2Fample.org/",
+		"http://[FE80::A]:8080/":                "http://[fe80::a]:8080/",
+		"../a/./b":                              "../a/./b",
+		"http://a/?%7e#%7E":                     "http://a/?~#~",
+	} {
+		normalized, err := Normalize(iri)
+		if err != nil || normalized != expected {
+			t.Errorf("expected %q to normalize to %q, got %q, %v", iri, expected, normalized, err)
+		}
+	}
+	if _, err := Normalize("http://a/%zz"); !errors.Is(err, InvalidIRIError) {
+		t.Errorf("expected an InvalidIRIError, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package rdfgo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Resolve resolves a reference against an absolute base IRI by the algorithm
+// of RFC 3986, section 5.2. The fragment of the base is ignored.
+func Resolve(base string, reference string) (string, error) {
+	b, err := Parse(base)
+	if err != nil {
+		return "", err
+	}
+	if !b.IsAbsolute() {
+		return "", fmt.Errorf("%w: base %q", RelativeIRIError, base)
+	}
+	r, err := Parse(reference)
+	if err != nil {
+		return "", err
+	}
+	return resolve(b, r).String(), nil
+}
+
+func resolve(base IRI, reference IRI) IRI {
+	target := reference
+	switch {
+	case reference.IsAbsolute():
+		target.Path = removeDotSegments(reference.Path)
+		return target
+	case reference.HasAuthority:
+		target.Path = removeDotSegments(reference.Path)
+	case reference.Path == "":
+		target.Authority, target.HasAuthority = base.Authority, base.HasAuthority
+		target.Path = base.Path
+		if !reference.HasQuery {
+			target.Query, target.HasQuery = base.Query, base.HasQuery
+		}
+	default:
+		target.Authority, target.HasAuthority = base.Authority, base.HasAuthority
+		if strings.HasPrefix(reference.Path, "/") {
+			target.Path = removeDotSegments(reference.Path)
+		} else {
+			target.Path = removeDotSegments(merge(base, reference.Path))
+		}
+	}
+	target.Scheme = base.Scheme
+	return target
+}
+
+// merge appends a relative path to the directory of the path of a base.
+func merge(base IRI, path string) string {
+	if base.HasAuthority && base.Path == "" {
+		return "/" + path
+	}
+	return base.Path[:strings.LastIndexByte(base.Path, '/')+1] + path
+}
+
+// removeDotSegments removes the "." and ".." segments of a path, by RFC 3986,
+// section 5.2.4.
+func removeDotSegments(path string) string {
+	var output []string
+	for path != "" {
+		switch {
+		case strings.HasPrefix(path, "../"):
+			path = path[3:]
+		case strings.HasPrefix(path, "./"):
+			path = path[2:]
+		case strings.HasPrefix(path, "/./"):
+			path = path[2:]
+		case path == "/.":
+			path = "/"
+		case strings.HasPrefix(path, "/../") || path == "/..":
+			path = "/" + path[min(len(path), 4):]
+			if len(output) > 0 {
+				output = output[:len(output)-1]
+			}
+		case path == "." || path == "..":
+			path = ""
+		default:
+			end := strings.IndexByte(path[1:], '/')
+			if end == -1 {
+				end = len(path)
+			} else {
+				end++
+			}
+			output, path = append(output, path[:end]), path[end:]
+		}
+	}
+	return strings.Join(output, "")
+}
+
+// Relativize returns the shortest reference it finds that resolves to iri
+// against base, for serializers that write IRIs relative to a base. It
+// returns iri itself when the IRIs do not share a scheme and an authority or
+// one of them is not a valid absolute IRI.
+func Relativize(base string, iri string) string {
+	b, err := Parse(base)
+	if err != nil || !b.IsAbsolute() {
+		return iri
+	}
+	target, err := Parse(iri)
+	if err != nil || !target.IsAbsolute() || target.Scheme != b.Scheme || target.HasAuthority != b.HasAuthority || target.Authority != b.Authority {
+		return iri
+	}
+
+	relative := IRI{Query: target.Query, HasQuery: target.HasQuery, Fragment: target.Fragment, HasFragment: target.HasFragment}
+	switch {
+	case target.Path == b.Path && target.HasQuery == b.HasQuery && target.Query == b.Query:
+		relative.Query, relative.HasQuery = "", false
+	case target.Path == b.Path && target.HasQuery:
+	default:
+		relative.Path = relativePath(b.Path, target.Path)
+	}
+	// The reference is parsed again, as a path like "a:b" would be read as an
+	// IRI with a scheme.
+	result := relative.String()
+	if resolved, err := Resolve(base, result); err == nil && resolved == iri {
+		return result
+	}
+	return iri
+}
+
+// relativePath returns a relative path from the directory of base to path,
+// or path itself when that is shorter.
+func relativePath(base string, path string) string {
+	if !strings.HasPrefix(base, "/") || !strings.HasPrefix(path, "/") {
+		return path
+	}
+	directory := base[:strings.LastIndexByte(base, '/')+1]
+	common := 0
+	for i := 0; i < len(directory) && i < len(path) && directory[i] == path[i]; i++ {
+		if directory[i] == '/' {
+			common = i + 1
+		}
+	}
+	relative := strings.Repeat("../", strings.Count(directory[common:], "/")) + path[common:]
+	if first, _, _ := strings.Cut(relative, "/"); relative == "" || strings.Contains(first, ":") {
+		relative = "./" + relative
+	}
+	if len(path) < len(relative) && !strings.HasPrefix(path, "//") {
+		return path
+	}
+	return relative
+}
@@ -0,0 +1,131 @@
+package rdfgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	// The examples of RFC 3986, section 5.4.
+	base := "http://a/b/c/d;p?q"
+	for reference, expected := range map[string]string{
+		"g:h":           "g:h",
+		"g":             "http://a/b/c/g",
+		"./g":           "http://a/b/c/g",
+		"g/":            "http://a/b/c/g/",
+		"/g":            "http://a/g",
+		"//g":           "http://g",
+		"?y":            "http://a/b/c/d;p?y",
+		"g?y":           "http://a/b/c/g?y",
+		"#s":            "http://a/b/c/d;p?q#s",
+		"g#s":           "http://a/b/c/g#s",
+		"g?y#s":         "http://a/b/c/g?y#s",
+		";x":            "http://a/b/c/;x",
+		"g;x":           "http://a/b/c/g;x",
+		"g;x?y#s":       "http://a/b/c/g;x?y#s",
+		"":              "http://a/b/c/d;p?q",
+		".":             "http://a/b/c/",
+		"./":            "http://a/b/c/",
+		"..":            "http://a/b/",
+		"../":           "http://a/b/",
+		"../g":          "http://a/b/g",
+		"../..":         "http://a/",
+		"../../":        "http://a/",
+		"../../g":       "http://a/g",
+		"../../../g":    "http://a/g",
+		"../../../../g": "http://a/g",
+		"/./g":          "http://a/g",
+		"/../g":         "http://a/g",
+		"g.":            "http://a/b/c/g.",
+		".g":            "http://a/b/c/.g",
+		"g..":           "http://a/b/c/g..",
+		"..g":           "http://a/b/c/..g",
+		"./../g":        "http://a/b/g",
+		"./g/.":         "http://a/b/c/g/",
+		"g/./h":         "http://a/b/c/g/h",
+		"g/../h":        "http://a/b/c/h",
+		"g;x=1/./y":     "http://a/b/c/g;x=1/y",
+		"g;x=1/../y":    "http://a/b/c/y",
+		"g?y/./x":       "http://a/b/c/g?y/./x",
+		"g?y/../x":      "http://a/b/c/g?y/../x",
+		"g#s/./x":       "http://a/b/c/g#s/./x",
+		"g#s/../x":      "http://a/b/c/g#s/../x",
+		"http:g":        "http:g",
+		"//g/./h/../i":  "http://g/i",
+		"http://x/./y":  "http://x/y",
+	} {
+		resolved, err := Resolve(base, reference)
+		if err != nil || resolved != expected {
+			t.Errorf("expected %q to resolve to %q, got %q, %v", reference, expected, resolved, err)
+		}
+	}
+
+	if resolved, _ := Resolve("http://a", "b"); resolved != "http://a/b" {
+		t.Errorf("expected http://a/b, got %s", resolved)
+	}
+	if resolved, _ := Resolve("http://a/b#f", ""); resolved != "http://a/b" {
+		t.Errorf("expected the fragment of the base to be dropped, got %s", resolved)
+	}
+	if resolved, _ := Resolve("urn:a:b", "#c"); resolved != "urn:a:b#c" {
+		t.Errorf("expected urn:a:b#c, got %s", resolved)
+	}
+	for _, reference := range []string{"./c", "../c"} {
+		if resolved, _ := Resolve("urn:a:b", reference); resolved != "urn:c" {
+			t.Errorf("expected %s to resolve to urn:c, got %s", reference, resolved)
+		}
+	}
+	if resolved, _ := Resolve("urn:a", "."); resolved != "urn:" {
+		t.Errorf("expected urn:, got %s", resolved)
+	}
+	if _, err := Resolve("/a", "b"); !errors.Is(err, RelativeIRIError) {
+		t.Errorf("expected a RelativeIRIError, got %v", err)
+	}
+	if _, err := Resolve("http://a b/", "b"); !errors.Is(err, InvalidIRIError) {
+		t.Errorf("expected an InvalidIRIError, got %v", err)
+	}
+	if _, err := Resolve("http://a/", "b c"); !errors.Is(err, InvalidIRIError) {
+		t.Errorf("expected an InvalidIRIError, got %v", err)
+	}
+}
+
+func TestRelativize(t *testing.T) {
+	base := "http://a/b/c/d;p?q"
+	for iri, expected := range map[string]string{
+		"http://a/b/c/d;p?q":   "",
+		"http://a/b/c/d;p?q#s": "#s",
+		"http://a/b/c/d;p?y":   "?y",
+		"http://a/b/c/d;p":     "d;p",
+		"http://a/b/c/g":       "g",
+		"http://a/b/c/g?y#s":   "g?y#s",
+		"http://a/b/c/":        "./",
+		"http://a/b/c/g/h":     "g/h",
+		"http://a/b/g":         "../g",
+		"http://a/g":           "/g",
+		"http://a/b/x:y":       "../x:y",
+		"http://a/b/c/x:y":     "./x:y",
+		"http://a/b/c//x":      "http://a/b/c//x",
+		"http://a":             "http://a",
+		"http://b/c":           "http://b/c",
+		"https://a/b/c/g":      "https://a/b/c/g",
+		"http://a/b/c/./g":     "http://a/b/c/./g",
+		"b":                    "b",
+		"http://a/ b":          "http://a/ b",
+	} {
+		relative := Relativize(base, iri)
+		if relative != expected {
+			t.Errorf("expected %q to relativize to %q, got %q", iri, expected, relative)
+		}
+	}
+	if relative := Relativize("http://a/b/c/d/e", "http://a/x/y"); relative != "/x/y" {
+		t.Errorf("expected the absolute path when it is shorter, got %s", relative)
+	}
+	if relative := Relativize("urn:a:b", "urn:a:c"); relative != "urn:a:c" {
+		t.Errorf("expected urn:a:c, got %s", relative)
+	}
+	if relative := Relativize("urn:a:b", "urn:a:b#c"); relative != "#c" {
+		t.Errorf("expected #c, got %s", relative)
+	}
+	if relative := Relativize("/a", "http://a/b"); relative != "http://a/b" {
+		t.Errorf("expected a relative base to be ignored, got %s", relative)
+	}
+}
@@ -0,0 +1,210 @@
+package rdfgo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var InvalidTagError = errors.New("invalid language tag")
+var InvalidDirectionError = errors.New("invalid base direction")
+
+// The base directions of RDF 1.2 directional language-tagged strings.
+const (
+	LeftToRight = "ltr"
+	RightToLeft = "rtl"
+)
+
+// grandfathered holds the tags of RFC 5646 that do not follow its syntax.
+var grandfathered = map[string]bool{
+	"en-gb-oed": true, "i-ami": true, "i-bnn": true, "i-default": true, "i-enochian": true, "i-hak": true,
+	"i-klingon": true, "i-lux": true, "i-mingo": true, "i-navajo": true, "i-pwn": true, "i-tao": true, "i-tay": true,
+	"i-tsu": true, "sgn-be-fr": true, "sgn-be-nl": true, "sgn-ch-de": true, "art-lojban": true, "cel-gaulish": true,
+	"no-bok": true, "no-nyn": true, "zh-guoyu": true, "zh-hakka": true, "zh-min": true, "zh-min-nan": true,
+	"zh-xiang": true,
+}
+
+// Validate checks that a tag is well-formed by the syntax of BCP 47 (RFC
+// 5646). Whether its subtags are registered is not checked.
+func Validate(tag string) error {
+	lower := strings.ToLower(tag)
+	if grandfathered[lower] {
+		return nil
+	}
+	subtags := strings.Split(lower, "-")
+	for _, subtag := range subtags {
+		if subtag == "" || len(subtag) > 8 || !isAlphanumeric(subtag) {
+			return fmt.Errorf("%w: %q", InvalidTagError, tag)
+		}
+	}
+	if subtags[0] == "x" {
+		if len(subtags) == 1 {
+			return fmt.Errorf("%w: %q has no private use subtags", InvalidTagError, tag)
+		}
+		return nil
+	}
+
+	i := 1
+	language := subtags[0]
+	switch {
+	case len(language) < 2 || !isAlpha(language):
+		return fmt.Errorf("%w: %q does not start with a language", InvalidTagError, tag)
+	case len(language) <= 3:
+		// Up to three extended language subtags.
+		for extlangs := 0; extlangs < 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]); extlangs++ {
+			i++
+		}
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		i++
+	}
+	if i < len(subtags) && (len(subtags[i]) == 2 && isAlpha(subtags[i]) || len(subtags[i]) == 3 && isDigit(subtags[i])) {
+		i++
+	}
+	variants := make(map[string]bool)
+	for ; i < len(subtags) && (len(subtags[i]) >= 5 || len(subtags[i]) == 4 && isDigit(subtags[i][:1])); i++ {
+		if variants[subtags[i]] {
+			return fmt.Errorf("%w: %q repeats the variant %s", InvalidTagError, tag, subtags[i])
+		}
+		variants[subtags[i]] = true
+	}
+	singletons := make(map[string]bool)
+	for i < len(subtags) {
+		singleton := subtags[i]
+		if len(singleton) != 1 {
+			return fmt.Errorf("%w: unexpected %s in %q", InvalidTagError, singleton, tag)
+		}
+		if singleton == "x" {
+			if i == len(subtags)-1 {
+				return fmt.Errorf("%w: %q has no private use subtags", InvalidTagError, tag)
+			}
+			return nil
+		}
+		if singletons[singleton] {
+			return fmt.Errorf("%w: %q repeats the extension %s", InvalidTagError, tag, singleton)
+		}
+		singletons[singleton] = true
+		i++
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 2 {
+			i++
+		}
+		if i == start {
+			return fmt.Errorf("%w: the extension %s of %q is empty", InvalidTagError, singleton, tag)
+		}
+	}
+	return nil
+}
+
+// Normalize returns a tag with the case of RFC 5646, section 2.1.1: scripts
+// in title case, regions in upper case and all other subtags in lower case,
+// so en-us becomes en-US and zh-hant-tw becomes zh-Hant-TW. Tags are
+// compared case-insensitively, and Normalize does not check that a tag is
+// well-formed.
+func Normalize(tag string) string {
+	subtags := strings.Split(strings.ToLower(tag), "-")
+	for i := 1; i < len(subtags); i++ {
+		subtag := subtags[i]
+		if len(subtags[i-1]) == 1 {
+			// The subtags of extensions and private use keep the lower case.
+			break
+		}
+		switch {
+		case len(subtag) == 2 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag)
+		case len(subtag) == 4 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag[:1]) + subtag[1:]
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// SplitDirection splits the tag of an RDF 1.2 directional language-tagged
+// string, like en--ltr, into its language tag and base direction. A tag
+// without a direction is returned with an empty direction.
+func SplitDirection(tag string) (string, string) {
+	language, direction, _ := strings.Cut(tag, "--")
+	return language, direction
+}
+
+// JoinDirection is the inverse of SplitDirection.
+func JoinDirection(language string, direction string) string {
+	if direction == "" {
+		return language
+	}
+	return language + "--" + direction
+}
+
+// ValidateDirection checks that a base direction is ltr or rtl.
+func ValidateDirection(direction string) error {
+	if direction != LeftToRight && direction != RightToLeft {
+		return fmt.Errorf("%w: %q", InvalidDirectionError, direction)
+	}
+	return nil
+}
+
+// Match reports whether a tag matches a basic language range by the basic
+// filtering of RFC 4647, section 3.3.1, as the SPARQL function LANGMATCHES
+// does: the range is the tag or a prefix of it that ends before a hyphen,
+// and "*" matches every tag that is not empty.
+func Match(tag string, languageRange string) bool {
+	if languageRange == "*" {
+		return tag != ""
+	}
+	tag, languageRange = strings.ToLower(tag), strings.ToLower(languageRange)
+	return languageRange != "" && (tag == languageRange || strings.HasPrefix(tag, languageRange+"-"))
+}
+
+// MatchExtended reports whether a tag matches an extended language range by
+// the extended filtering of RFC 4647, section 3.3.2, in which "*" matches any
+// sequence of subtags, so de-*-DE matches de-Latn-DE.
+func MatchExtended(tag string, languageRange string) bool {
+	subtags := strings.Split(strings.ToLower(tag), "-")
+	ranges := strings.Split(strings.ToLower(languageRange), "-")
+	if tag == "" || ranges[0] != "*" && ranges[0] != subtags[0] {
+		return false
+	}
+	i := 1
+	for _, subrange := range ranges[1:] {
+		if subrange == "*" {
+			continue
+		}
+		for ; i < len(subtags) && subtags[i] != subrange; i++ {
+			if len(subtags[i]) == 1 {
+				return false
+			}
+		}
+		if i == len(subtags) {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func isAlpha(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if (value[i] < 'a' || value[i] > 'z') && (value[i] < 'A' || value[i] > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if !isAlpha(value[i:i+1]) && !isDigit(value[i:i+1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+package rdfgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tag := range []string{
+		"en", "EN", "en-US", "zh-Hant-TW", "sr-Latn-RS", "es-419", "de-CH-1901", "sl-rozaj-biske", "hy-Latn-IT-arevela",
+		"zh-yue-HK", "ar-afb-acm-apc", "en-a-bbb-x-a-ccc", "en-US-u-islamcal", "x-whatever", "qaa-Qaaa-QM-x-southern",
+		"i-klingon", "en-GB-oed", "zh-min-nan", "abcde", "abcdefgh", "de-1996",
+	} {
+		if err := Validate(tag); err != nil {
+			t.Errorf("expected %q to be well-formed, got %v", tag, err)
+		}
+	}
+	for _, tag := range []string{
+		"", "e", "1a", "en-", "-en", "en--US", "abcdefghi", "en_US", "x", "en-x", "en-a", "en-a-b", "de-419-DE",
+		"a-DE", "ar-a-aaa-b-bbb-a-ccc", "de-1901-1901", "en-US-12", "é",
+	} {
+		if err := Validate(tag); !errors.Is(err, InvalidTagError) {
+			t.Errorf("expected %q to be ill-formed, got %v", tag, err)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for tag, expected := range map[string]string{
+		"EN":                      "en",
+		"en-us":                   "en-US",
+		"ZH-HANT-tw":              "zh-Hant-TW",
+		"sgn-be-fr":               "sgn-BE-FR",
+		"az-latn-x-latn":          "az-Latn-x-latn",
+		"en-ca-x-ca":              "en-CA-x-ca",
+		"X-Private":               "x-private",
+		"i-Klingon":               "i-klingon",
+		"de-CH-1901-U-co-phonebk": "de-CH-1901-u-co-phonebk",
+	} {
+		if normalized := Normalize(tag); normalized != expected {
+			t.Errorf("expected %q to normalize to %q, got %q", tag, expected, normalized)
+		}
+	}
+}
+
+func TestDirection(t *testing.T) {
+	if language, direction := SplitDirection("en-US--rtl"); language != "en-US" || direction != RightToLeft {
+		t.Errorf("expected en-US and rtl, got %q and %q", language, direction)
+	}
+	if language, direction := SplitDirection("en"); language != "en" || direction != "" {
+		t.Errorf("expected en without a direction, got %q and %q", language, direction)
+	}
+	if tag := JoinDirection("en", LeftToRight); tag != "en--ltr" {
+		t.Errorf("expected en--ltr, got %q", tag)
+	}
+	if tag := JoinDirection("en", ""); tag != "en" {
+		t.Errorf("expected en, got %q", tag)
+	}
+	if err := ValidateDirection(LeftToRight); err != nil {
+		t.Errorf("expected ltr to be valid, got %v", err)
+	}
+	if err := ValidateDirection("LTR"); !errors.Is(err, InvalidDirectionError) {
+		t.Errorf("expected an InvalidDirectionError, got %v", err)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		tag           string
+		languageRange string
+		expected      bool
+	}{
+		{"en", "en", true},
+		{"en-US", "en", true},
+		{"EN-us", "en-US", true},
+		{"en", "en-US", false},
+		{"eng", "en", false},
+		{"fr", "en", false},
+		{"en", "*", true},
+		{"", "*", false},
+		{"", "", false},
+		{"en", "", false},
+		{"de-Latn-DE", "de-DE", false},
+	}
+	for _, tt := range tests {
+		if Match(tt.tag, tt.languageRange) != tt.expected {
+			t.Errorf("expected Match(%q, %q) to be %v", tt.tag, tt.languageRange, tt.expected)
+		}
+	}
+}
+
+func TestMatchExtended(t *testing.T) {
+	// The examples of RFC 4647, section 3.3.2.
+	for _, tag := range []string{"de-DE", "de-de", "de-Latn-DE", "de-Latf-DE", "de-DE-x-goethe", "de-Latn-DE-1996", "de-Deva-DE"} {
+		if !MatchExtended(tag, "de-*-DE") {
+			t.Errorf("expected %q to match de-*-DE", tag)
+		}
+		if !MatchExtended(tag, "de-DE") {
+			t.Errorf("expected %q to match de-DE", tag)
+		}
+	}
+	for _, tag := range []string{"de", "de-x-DE", "de-Deva", "fr-DE", ""} {
+		if MatchExtended(tag, "de-*-DE") {
+			t.Errorf("expected %q not to match de-*-DE", tag)
+		}
+	}
+	if !MatchExtended("fr-CA", "*-CA") || !MatchExtended("en", "*") || MatchExtended("en", "en-US") {
+		t.Errorf("expected a wildcard first subtag to match any language")
+	}
+}
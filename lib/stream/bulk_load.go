@@ -0,0 +1,230 @@
+package rdfgo
+
+import (
+	"context"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+// BulkLoadOptions configures Store.BulkLoad. BatchSize is the number of
+// quads that are added as one commit, it defaults to 65536. Workers is the
+// number of goroutines that prepare a batch, it defaults to GOMAXPROCS.
+// Progress is called after every batch.
+type BulkLoadOptions struct {
+	BatchSize int
+	Workers   int
+	Progress  func(BulkLoadProgress)
+}
+
+// BulkLoadProgress counts the quads that a bulk load read so far, and how
+// many of them were added, already in the store or read twice, and invalid.
+type BulkLoadProgress struct {
+	Read       int
+	Added      int
+	Duplicates int
+	Invalid    int
+}
+
+// bulkRecord is a quad with the keys of its buckets.
+type bulkRecord struct {
+	quad   interfaces.IQuad
+	hashes []string
+}
+
+// BulkLoad adds the quads of a stream to the store in large batches. A batch
+// is validated, hashed and sorted by several workers and duplicates are
+// dropped. The rest of the batch is added as one commit that takes the store
+// lock once instead of twice per quad like Import, and the indexes of the
+// subject, predicate, object, graph and quad are built in parallel.
+//
+// It returns the error of the stream, or of ctx when it is cancelled. It
+// stops with a LimitError at the first quad that would exceed a limit of the
+// store. The quads that were added before an error stay in the store and the
+// progress it returns counts the whole batch: the quads after the one that
+// exceeded the limit are counted as read but not as added.
+func (s *Store) BulkLoad(ctx context.Context, quads interfaces.IQuadStream, options ...BulkLoadOptions) (BulkLoadProgress, error) {
+	var config BulkLoadOptions
+	if len(options) > 0 {
+		config = options[0]
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 1 << 16
+	}
+	if config.Workers <= 0 {
+		config.Workers = runtime.GOMAXPROCS(0)
+	}
+	defer quads.Close()
+
+	var progress BulkLoadProgress
+	batch := make([]interfaces.IQuad, 0, config.BatchSize)
+	flush := func() error {
+		records, invalid := s.prepareBatch(batch, config.Workers)
+		added, existing, err := s.addBatch(records)
+		progress.Read += len(batch)
+		progress.Invalid += invalid
+		progress.Added += added
+		progress.Duplicates += len(batch) - invalid - len(records) + existing
+		if err != nil {
+			return err
+		}
+		batch = batch[:0]
+		if config.Progress != nil {
+			config.Progress(progress)
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return progress, ctx.Err()
+		case quad, ok := <-quads.Quads():
+			if !ok {
+				if len(batch) > 0 {
+					if err := flush(); err != nil {
+						return progress, err
+					}
+				}
+				return progress, quads.Err()
+			}
+			if quad == nil {
+				continue
+			}
+			batch = append(batch, quad)
+			if len(batch) == config.BatchSize {
+				if err := flush(); err != nil {
+					return progress, err
+				}
+			}
+		}
+	}
+}
+
+// prepareBatch validates and hashes a batch in parallel and returns the valid
+// quads sorted by key without duplicates, and the number of invalid quads.
+func (s *Store) prepareBatch(batch []interfaces.IQuad, workers int) ([]bulkRecord, int) {
+	chunks := make([][]bulkRecord, workers)
+	invalid := make([]int, workers)
+	size := (len(batch) + workers - 1) / workers
+	var wait sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		start, end := min(worker*size, len(batch)), min((worker+1)*size, len(batch))
+		wait.Add(1)
+		go func(worker int, quads []interfaces.IQuad) {
+			defer wait.Done()
+			records := make([]bulkRecord, 0, len(quads))
+			for _, quad := range quads {
+				graph, ok := checkQuadTerms(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+				if !ok {
+					invalid[worker]++
+					continue
+				}
+				if quad.GetGraph() == nil {
+					quad, _ = newStoreQuad(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), graph)
+				}
+				hashes := s.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), graph)
+				records = append(records, bulkRecord{quad: quad, hashes: hashes})
+			}
+			slices.SortFunc(records, func(a bulkRecord, b bulkRecord) int {
+				return strings.Compare(a.hashes[4], b.hashes[4])
+			})
+			chunks[worker] = records
+		}(worker, batch[start:end])
+	}
+	wait.Wait()
+
+	// Merge the sorted chunks and skip keys that were already taken.
+	merged := make([]bulkRecord, 0, len(batch))
+	for {
+		next := -1
+		for i, chunk := range chunks {
+			if len(chunk) > 0 && (next == -1 || chunk[0].hashes[4] < chunks[next][0].hashes[4]) {
+				next = i
+			}
+		}
+		if next == -1 {
+			break
+		}
+		record := chunks[next][0]
+		chunks[next] = chunks[next][1:]
+		if len(merged) == 0 || merged[len(merged)-1].hashes[4] != record.hashes[4] {
+			merged = append(merged, record)
+		}
+	}
+	total := 0
+	for _, count := range invalid {
+		total += count
+	}
+	return merged, total
+}
+
+// addBatch adds the records that are not in the store yet as one commit and
+// returns how many were added and how many were in the store already. It
+// stops at the first record that would exceed a limit of the store. The
+// buckets of each record are looked up first, and then one goroutine per
+// position links the new entries into the buckets of that position. The
+// records are sorted, so consecutive records often share their subject
+// bucket, which is then looked up only once.
+func (s *Store) addBatch(records []bulkRecord) (int, int, error) {
+	var entries []*storeEntry
+	existing := 0
+	var err error
+	s.write(func() {
+		version := s.version + 1
+		entries = make([]*storeEntry, 0, len(records))
+		buckets := make([][5]*storeBucket, 0, len(records))
+		var last [5]*storeBucket
+		var lastHashes [5]string
+		for _, record := range records {
+			if s.find(record.hashes[4], s.version) != nil {
+				existing++
+				continue
+			}
+			if err = s.checkLimits(len(entries)+1, s.cost(record.quad, record.hashes)); err != nil {
+				break
+			}
+			entry := &storeEntry{quad: record.quad, added: version}
+			var linked [5]*storeBucket
+			for position, hash := range record.hashes {
+				if last[position] == nil || lastHashes[position] != hash {
+					last[position], lastHashes[position] = s.bucketFor(position, hash), hash
+				}
+				linked[position] = last[position]
+			}
+			entries = append(entries, entry)
+			buckets = append(buckets, linked)
+			s.countClass(entry.quad, 1)
+			s.indexLiteral(entry, record.hashes, true)
+			s.account(record.hashes[4], 1)
+		}
+		if len(entries) == 0 {
+			return
+		}
+
+		// The buckets of different positions never overlap and link only
+		// counts the distinct terms of its own position.
+		var wait sync.WaitGroup
+		for position := range last {
+			wait.Add(1)
+			go func(position int) {
+				defer wait.Done()
+				for i, entry := range entries {
+					s.link(position, buckets[i][position], entry)
+				}
+			}(position)
+		}
+		s.all.entries = append(s.all.entries, entries...)
+		wait.Wait()
+
+		for _, entry := range entries {
+			s.publish(QuadEvent{Type: QuadAdded, Quad: entry.quad, Version: version})
+		}
+		s.size += len(entries)
+		s.version = version
+	})
+	return len(entries), existing, err
+}
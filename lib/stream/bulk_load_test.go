@@ -0,0 +1,108 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func TestStore_BulkLoad(t *testing.T) {
+	store := NewStore().(*Store)
+	store.AddQuad(testQuads(1)[0])
+	subscription := store.Subscribe(context.Background(), SubscriptionOptions{Buffer: 1000})
+	defer subscription.Close()
+
+	quads := append(testQuads(500), testQuads(100)...)
+	quads = append(quads, nil)
+	invalid, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil)
+	quads = append(quads, &invalidQuad{IQuad: invalid})
+
+	var reports []BulkLoadProgress
+	progress, err := store.BulkLoad(context.Background(), ArrayToQuadStream(context.Background(), quads), BulkLoadOptions{
+		BatchSize: 128,
+		Workers:   3,
+		Progress: func(progress BulkLoadProgress) {
+			reports = append(reports, progress)
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected the load to succeed, got %v", err)
+	}
+	expected := BulkLoadProgress{Read: 601, Added: 499, Duplicates: 101, Invalid: 1}
+	if progress != expected || len(reports) != 5 || reports[4] != expected {
+		t.Errorf("Expected %+v in 5 reports, got %+v in %v", expected, progress, reports)
+	}
+
+	other := NewStore()
+	for _, quad := range testQuads(500) {
+		other.AddQuad(quad)
+	}
+	if !equalStrings(matchStrings(other.Match(nil, nil, nil, nil)), matchStrings(store.Match(nil, nil, nil, nil))) {
+		t.Errorf("Expected the same quads as adding them one by one")
+	}
+	if !equalStrings(matchStrings(other.Match(nil, nil, NewIntegerLiteral(7), nil)), matchStrings(store.Match(nil, nil, NewIntegerLiteral(7), nil))) {
+		t.Errorf("Expected the buckets to be filled")
+	}
+	for i := 0; i < 499; i++ {
+		if event := <-subscription.Events(); event.Type != QuadAdded {
+			t.Fatalf("Expected an added event, got %v", event)
+		}
+	}
+}
+
+// invalidQuad has a literal as subject, which a store does not accept.
+type invalidQuad struct {
+	interfaces.IQuad
+}
+
+func (q *invalidQuad) GetSubject() interfaces.ITerm {
+	return NewStringLiteral("s", "")
+}
+
+func TestStore_BulkLoadErrors(t *testing.T) {
+	store := NewStore().(*Store)
+	failure := errors.New("failure")
+	_, err := store.BulkLoad(context.Background(), FailedQuadStream(failure))
+	if !errors.Is(err, failure) {
+		t.Errorf("Expected the error of the stream, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	quadStream := NewQuadStream(context.Background(), func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		for _, quad := range testQuads(10) {
+			if !emit(quad) {
+				return nil
+			}
+		}
+		<-ctx.Done()
+		return nil
+	})
+	if _, err := store.BulkLoad(ctx, quadStream); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the load to be cancelled, got %v", err)
+	}
+}
+
+func benchmarkLoad(b *testing.B, load func(store *Store, quads []interfaces.IQuad)) {
+	quads := benchmarkQuads(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		load(NewStore().(*Store), quads)
+	}
+}
+
+func BenchmarkStore_Import(b *testing.B) {
+	benchmarkLoad(b, func(store *Store, quads []interfaces.IQuad) {
+		store.Import(ArrayToStream(quads).ToIStream())
+	})
+}
+
+func BenchmarkStore_BulkLoad(b *testing.B) {
+	benchmarkLoad(b, func(store *Store, quads []interfaces.IQuad) {
+		_, _ = store.BulkLoad(context.Background(), ArrayToQuadStream(context.Background(), quads))
+	})
+}
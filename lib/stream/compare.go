@@ -0,0 +1,112 @@
+package rdfgo
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	xsd "github.com/maartyman/rdfgo/lib/xsd"
+)
+
+// termRanks orders the kinds of terms: unbound (nil) terms first, then the
+// default graph, variables, blank nodes, IRIs, literals and quoted triples.
+var termRanks = map[interfaces.TermType]int{
+	interfaces.DefaultGraphType: 1,
+	interfaces.VariableType:     2,
+	interfaces.BlankNodeType:    3,
+	interfaces.NamedNodeType:    4,
+	interfaces.LiteralType:      5,
+	interfaces.QuadType:         6,
+}
+
+// Compare is a total order on terms that follows the ORDER BY of SPARQL:
+// unbound (nil) terms come before blank nodes, blank nodes before IRIs and
+// IRIs before literals. Blank nodes and IRIs are ordered by their label and
+// IRI. Literals with a value of a datatype xsd supports are ordered by
+// xsd.Order, so 2 comes before 10, and come before other literals, which are
+// ordered by their lexical form, language and datatype. Compare returns 0 for
+// equal terms only.
+func Compare(a interfaces.ITerm, b interfaces.ITerm) int {
+	if a == nil || b == nil {
+		return compareRanks(a, b)
+	}
+	if order := compareRanks(a, b); order != 0 {
+		return order
+	}
+	switch a.GetType() {
+	case interfaces.LiteralType:
+		x, ok := a.(interfaces.ILiteral)
+		y, other := b.(interfaces.ILiteral)
+		if ok && other {
+			return compareLiterals(x, y)
+		}
+	case interfaces.QuadType:
+		x, ok := a.(interfaces.IQuad)
+		y, other := b.(interfaces.IQuad)
+		if ok && other {
+			return CompareQuads(x, y)
+		}
+	}
+	return strings.Compare(a.GetValue(), b.GetValue())
+}
+
+// CompareQuads orders quads by their graph, subject, predicate and object,
+// with Compare, so the quads of a graph and of a subject in it are next to
+// each other.
+func CompareQuads(a interfaces.IQuad, b interfaces.IQuad) int {
+	if order := Compare(a.GetGraph(), b.GetGraph()); order != 0 {
+		return order
+	}
+	if order := Compare(a.GetSubject(), b.GetSubject()); order != 0 {
+		return order
+	}
+	if order := Compare(a.GetPredicate(), b.GetPredicate()); order != 0 {
+		return order
+	}
+	return Compare(a.GetObject(), b.GetObject())
+}
+
+func compareRanks(a interfaces.ITerm, b interfaces.ITerm) int {
+	rank := func(term interfaces.ITerm) int {
+		if term == nil {
+			return 0
+		}
+		return termRanks[term.GetType()]
+	}
+	return rank(a) - rank(b)
+}
+
+func compareLiterals(a interfaces.ILiteral, b interfaces.ILiteral) int {
+	x, err := xsd.ParseLiteral(a)
+	y, other := xsd.ParseLiteral(b)
+	switch {
+	case err == nil && other == nil:
+		if order := xsd.Order(x, y); order != 0 {
+			return order
+		}
+	case err == nil:
+		return -1
+	case other == nil:
+		return 1
+	}
+	if order := strings.Compare(a.GetValue(), b.GetValue()); order != 0 {
+		return order
+	}
+	if order := strings.Compare(strings.ToLower(a.GetLanguage()), strings.ToLower(b.GetLanguage())); order != 0 {
+		return order
+	}
+	if order := strings.Compare(a.GetDirection(), b.GetDirection()); order != 0 {
+		return order
+	}
+	return Compare(a.GetDatatype(), b.GetDatatype())
+}
+
+// SortedStream returns a stream of the quads of s, sorted by CompareQuads.
+// It reads all of s before it returns.
+func (s Stream) SortedStream() Stream {
+	quads := slices.DeleteFunc(s.ToArray(), func(quad interfaces.IQuad) bool {
+		return quad == nil
+	})
+	slices.SortStableFunc(quads, CompareQuads)
+	return ArrayToStream(quads)
+}
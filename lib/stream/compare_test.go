@@ -0,0 +1,82 @@
+package rdfgo
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+type otherTerm struct {
+	interfaces.ITerm
+	termType interfaces.TermType
+}
+
+func (t otherTerm) GetType() interfaces.TermType {
+	return t.termType
+}
+
+func TestCompare(t *testing.T) {
+	integer := NewNamedNode("http://www.w3.org/2001/XMLSchema#integer")
+	quoted, _ := NewQuad(NewNamedNode("http://example.com/s"), NewNamedNode("http://example.com/p"), NewIntegerLiteral(1), nil)
+	otherQuoted, _ := NewQuad(NewNamedNode("http://example.com/s"), NewNamedNode("http://example.com/p"), NewIntegerLiteral(2), nil)
+	lastQuoted, _ := NewQuad(NewNamedNode("http://example.com/s"), NewNamedNode("http://example.com/q"), NewIntegerLiteral(1), nil)
+	ordered := []interfaces.ITerm{
+		nil,
+		NewDefaultGraph(),
+		NewVariable("a"),
+		NewBlankNode("a"),
+		NewBlankNode("b"),
+		NewNamedNode("http://example.com/a"),
+		NewNamedNode("http://example.com/b"),
+		NewBooleanLiteral(false),
+		NewBooleanLiteral(true),
+		NewLiteral("01", "", integer),
+		NewLiteral("1", "", integer),
+		NewDecimalLiteral(1.5),
+		NewIntegerLiteral(2),
+		NewIntegerLiteral(10),
+		NewLiteral("a", "", nil),
+		NewStringLiteral("a", ""),
+		NewStringLiteral("b", ""),
+		NewLiteral("10", "", NewNamedNode("http://example.com/unknown")),
+		NewLiteral("2", "", NewNamedNode("http://example.com/unknown")),
+		NewLiteral("x", "", integer),
+		NewStringLiteral("x", "en"),
+		NewStringLiteral("x", "en--ltr"),
+		NewStringLiteral("x", "fr"),
+		quoted,
+		otherQuoted,
+		lastQuoted,
+	}
+	for i, a := range ordered {
+		for j, b := range ordered {
+			order := Compare(a, b)
+			if i < j && order >= 0 || i > j && order <= 0 || i == j && order != 0 {
+				t.Errorf("Expected Compare(%v, %v) to order them as %d and %d, got %d", a, b, i, j, order)
+			}
+		}
+	}
+	if Compare(NewStringLiteral("x", "EN"), NewStringLiteral("x", "en")) != 0 {
+		t.Errorf("Expected languages to be compared case-insensitively")
+	}
+	other := otherTerm{NewNamedNode("http://example.com/a"), interfaces.LiteralType}
+	if Compare(other, NewStringLiteral("a", "")) != 1 || Compare(otherTerm{quoted, interfaces.QuadType}, quoted) != 0 {
+		t.Errorf("Expected terms that do not implement their interface to be ordered by their value")
+	}
+}
+
+func TestStream_SortedStream(t *testing.T) {
+	p := NewNamedNode("http://example.com/p")
+	g := NewNamedNode("http://example.com/g")
+	quad := func(s string, o int, graph interfaces.ITerm) interfaces.IQuad {
+		quad, _ := NewQuad(NewNamedNode(s), p, NewIntegerLiteral(o), graph)
+		return quad
+	}
+	sorted := []interfaces.IQuad{quad("a", 2, nil), quad("a", 10, nil), quad("b", 1, nil), quad("a", 1, g)}
+	quads := ArrayToStream([]interfaces.IQuad{sorted[3], sorted[1], nil, sorted[2], sorted[0]}).SortedStream().ToArray()
+	if !slices.EqualFunc(quads, sorted, func(a interfaces.IQuad, b interfaces.IQuad) bool { return a.Equals(b) }) {
+		t.Errorf("Expected the quads to be sorted, got %v", quads)
+	}
+}
@@ -0,0 +1,332 @@
+package rdfgo
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+// materializeBatch is the number of quads that MatchSeq turns back into terms
+// per lock.
+const materializeBatch = 64
+
+// quadIDs holds the term IDs of a quad in subject, predicate, object, graph
+// order.
+type quadIDs [4]termID
+
+// quadEntry is a quad in the slab of a DictionaryStore together with its
+// position in each of the four posting lists, so it can be removed from them
+// in constant time.
+type quadEntry struct {
+	ids     quadIDs
+	offsets [4]uint32
+}
+
+// DictionaryStore interns every term into a dictionary and stores quads as
+// tuples of term IDs in a slab. Each term ID has a posting list with the slab
+// slots of the quads that use it in that position. Quads are materialized
+// when they are matched.
+type DictionaryStore struct {
+	dictionary *termDictionary
+	entries    []quadEntry
+	free       []uint32
+	slots      map[quadIDs]uint32
+	indexes    [4]map[termID][]uint32
+	mux        sync.RWMutex
+}
+
+func NewDictionaryStore() IStore {
+	store := &DictionaryStore{
+		dictionary: newTermDictionary(),
+		slots:      make(map[quadIDs]uint32),
+	}
+	for i := range store.indexes {
+		store.indexes[i] = make(map[termID][]uint32)
+	}
+	return store
+}
+
+func (s *DictionaryStore) Size() int {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return len(s.slots)
+}
+
+func (s *DictionaryStore) Has(quad interfaces.IQuad) bool {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	ids, ok := s.dictionary.lookupQuad(quad)
+	if !ok {
+		return false
+	}
+	_, exists := s.slots[ids]
+	return exists
+}
+
+// checkQuadTerms reports whether the terms form a quad that can be stored and
+// returns the graph, which defaults to the default graph.
+func checkQuadTerms(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) (interfaces.ITerm, bool) {
+	if subject == nil || predicate == nil || object == nil {
+		return nil, false
+	}
+	if graph == nil {
+		graph = NewDefaultGraph()
+	}
+	if subject.GetType() == interfaces.VariableType ||
+		predicate.GetType() == interfaces.VariableType ||
+		object.GetType() == interfaces.VariableType ||
+		graph.GetType() == interfaces.VariableType {
+		return nil, false
+	}
+	if _, err := NewQuad(subject, predicate, object, graph); err != nil {
+		return nil, false
+	}
+	return graph, true
+}
+
+func (s *DictionaryStore) AddQuadFromTerms(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) bool {
+	graph, ok := checkQuadTerms(subject, predicate, object, graph)
+	if !ok {
+		return false
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ids := quadIDs{
+		s.dictionary.intern(subject),
+		s.dictionary.intern(predicate),
+		s.dictionary.intern(object),
+		s.dictionary.intern(graph),
+	}
+	if _, exists := s.slots[ids]; exists {
+		return false
+	}
+	var slot uint32
+	if len(s.free) > 0 {
+		slot = s.free[len(s.free)-1]
+		s.free = s.free[:len(s.free)-1]
+	} else {
+		slot = uint32(len(s.entries))
+		s.entries = append(s.entries, quadEntry{})
+	}
+	entry := &s.entries[slot]
+	entry.ids = ids
+	for i, id := range ids {
+		postings := s.indexes[i][id]
+		entry.offsets[i] = uint32(len(postings))
+		s.indexes[i][id] = append(postings, slot)
+	}
+	s.slots[ids] = slot
+	return true
+}
+
+func (s *DictionaryStore) AddQuad(quad interfaces.IQuad) bool {
+	return s.AddQuadFromTerms(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+}
+
+func (s *DictionaryStore) RemoveQuad(quad interfaces.IQuad) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ids, ok := s.dictionary.lookupQuad(quad)
+	if !ok {
+		return
+	}
+	s.removeIDs(ids)
+}
+
+func (s *DictionaryStore) removeIDs(ids quadIDs) {
+	slot, exists := s.slots[ids]
+	if !exists {
+		return
+	}
+	delete(s.slots, ids)
+	entry := s.entries[slot]
+	for i, id := range ids {
+		postings := s.indexes[i][id]
+		last := postings[len(postings)-1]
+		postings[entry.offsets[i]] = last
+		s.entries[last].offsets[i] = entry.offsets[i]
+		if len(postings) == 1 {
+			delete(s.indexes[i], id)
+		} else {
+			s.indexes[i][id] = postings[:len(postings)-1]
+		}
+	}
+	s.entries[slot] = quadEntry{}
+	s.free = append(s.free, slot)
+}
+
+func (s *DictionaryStore) RemoveMatches(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for _, ids := range s.matchIDs(subject, predicate, object, graph) {
+		s.removeIDs(ids)
+	}
+}
+
+func (s *DictionaryStore) Remove(stream interfaces.IStream) {
+	for quad := range stream {
+		if quad != nil {
+			s.RemoveQuad(quad)
+		}
+	}
+}
+
+func (s *DictionaryStore) DeleteGraph(graph interfaces.ITerm) {
+	s.RemoveMatches(nil, nil, nil, graph)
+}
+
+// matchIDs returns a snapshot of the IDs of the matching quads. The caller
+// has to hold the lock.
+func (s *DictionaryStore) matchIDs(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) []quadIDs {
+	subject, predicate, object, graph = convertVariablesToNil(subject, predicate, object, graph)
+	var pattern quadIDs
+	smallest := -1
+	for i, term := range [4]interfaces.ITerm{subject, predicate, object, graph} {
+		if term == nil {
+			continue
+		}
+		id, ok := s.dictionary.lookup(term)
+		if !ok {
+			return nil
+		}
+		pattern[i] = id
+		if smallest == -1 || len(s.indexes[i][id]) < len(s.indexes[smallest][pattern[smallest]]) {
+			smallest = i
+		}
+	}
+
+	if smallest == -1 {
+		ids := make([]quadIDs, 0, len(s.slots))
+		for _, entry := range s.entries {
+			if entry.ids[0] != 0 {
+				ids = append(ids, entry.ids)
+			}
+		}
+		return ids
+	}
+	if pattern[0] != 0 && pattern[1] != 0 && pattern[2] != 0 && pattern[3] != 0 {
+		if _, exists := s.slots[pattern]; exists {
+			return []quadIDs{pattern}
+		}
+		return nil
+	}
+	var ids []quadIDs
+	for _, slot := range s.indexes[smallest][pattern[smallest]] {
+		quad := s.entries[slot].ids
+		if (pattern[0] == 0 || quad[0] == pattern[0]) &&
+			(pattern[1] == 0 || quad[1] == pattern[1]) &&
+			(pattern[2] == 0 || quad[2] == pattern[2]) &&
+			(pattern[3] == 0 || quad[3] == pattern[3]) {
+			ids = append(ids, quad)
+		}
+	}
+	return ids
+}
+
+// MatchSeq returns the matching quads as an iterator. The IDs of the matches
+// are collected when iteration starts, so the loop body may modify the store.
+func (s *DictionaryStore) MatchSeq(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) iter.Seq[interfaces.IQuad] {
+	return func(yield func(interfaces.IQuad) bool) {
+		s.mux.RLock()
+		ids := s.matchIDs(subject, predicate, object, graph)
+		s.mux.RUnlock()
+		s.materialize(ids)(yield)
+	}
+}
+
+// materialize turns the IDs of quads back into quads in batches. Terms are
+// never removed from the dictionary, so this works for quads that have been
+// removed since the IDs were collected.
+func (s *DictionaryStore) materialize(ids []quadIDs) iter.Seq[interfaces.IQuad] {
+	return func(yield func(interfaces.IQuad) bool) {
+		var batch [materializeBatch]interfaces.IQuad
+		for start := 0; start < len(ids); start += materializeBatch {
+			quads := batch[:min(materializeBatch, len(ids)-start)]
+			s.mux.RLock()
+			for i := range quads {
+				quads[i] = s.dictionary.quad(ids[start+i])
+			}
+			s.mux.RUnlock()
+			for _, quad := range quads {
+				if !yield(quad) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// MatchContext returns the quads that match at the time of the call, even if
+// the stream is read later.
+func (s *DictionaryStore) MatchContext(
+	ctx context.Context,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) *QuadStream {
+	s.mux.RLock()
+	ids := s.matchIDs(subject, predicate, object, graph)
+	s.mux.RUnlock()
+	quads := s.materialize(ids)
+	return NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		for quad := range quads {
+			if !emit(quad) {
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
+func (s *DictionaryStore) Match(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) interfaces.IStream {
+	return s.MatchContext(context.Background(), subject, predicate, object, graph).ToIStream()
+}
+
+func (s *DictionaryStore) Import(quadStream interfaces.IStream) {
+	for quad := range quadStream {
+		if quad != nil {
+			s.AddQuad(quad)
+		}
+	}
+}
+
+func (s *DictionaryStore) ForEach(callback func(interfaces.IQuad)) {
+	for quad := range s.MatchSeq(nil, nil, nil, nil) {
+		callback(quad)
+	}
+}
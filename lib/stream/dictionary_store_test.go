@@ -0,0 +1,316 @@
+package rdfgo
+
+import (
+	"context"
+	"math/rand"
+	"runtime"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func matchStrings(stream interfaces.IStream) []string {
+	var strings []string
+	for quad := range stream {
+		strings = append(strings, quad.ToString())
+	}
+	sort.Strings(strings)
+	return strings
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDictionaryStore_AddQuadFromTerms(t *testing.T) {
+	store := NewDictionaryStore()
+	if store.AddQuadFromTerms(nil, nil, nil, nil) {
+		t.Errorf("Expected nil terms to be rejected")
+	}
+	if store.AddQuadFromTerms(NewVariable("s"), NewNamedNode("p"), NewNamedNode("o"), nil) {
+		t.Errorf("Expected variables to be rejected")
+	}
+	if store.AddQuadFromTerms(NewLiteral("s", "", NewNamedNode("")), NewNamedNode("p"), NewNamedNode("o"), nil) {
+		t.Errorf("Expected an invalid quad to be rejected")
+	}
+	if !store.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil) {
+		t.Errorf("Expected the quad to be added")
+	}
+	if store.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), NewDefaultGraph()) {
+		t.Errorf("Expected a duplicate quad to be rejected")
+	}
+	if store.Size() != 1 {
+		t.Errorf("Expected 1 quad, got %d", store.Size())
+	}
+}
+
+func TestDictionaryStore_Has(t *testing.T) {
+	store := NewDictionaryStore()
+	quad, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewStringLiteral("o", "en"), nil)
+	store.AddQuad(quad)
+	if !store.Has(quad) {
+		t.Errorf("Expected the store to contain %s", quad.ToString())
+	}
+	others := []interfaces.IQuad{}
+	for _, object := range []interfaces.ITerm{NewStringLiteral("o", "nl"), NewStringLiteral("o", ""), NewNamedNode("o")} {
+		other, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), object, nil)
+		others = append(others, other)
+	}
+	other, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewStringLiteral("o", "en"), NewNamedNode(""))
+	others = append(others, other)
+	for _, other := range others {
+		if store.Has(other) {
+			t.Errorf("Expected the store not to contain %s", other.ToString())
+		}
+	}
+}
+
+func TestDictionaryStore_QuotedTriples(t *testing.T) {
+	store := NewDictionaryStore()
+	quoted, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil)
+	quad, _ := NewQuad(quoted, NewNamedNode("says"), NewStringLiteral("hi", ""), nil)
+	store.AddQuad(quad)
+	if Stream(store.Match(quoted, nil, nil, nil)).Count() != 1 {
+		t.Errorf("Expected a quoted triple to match")
+	}
+}
+
+func TestDictionaryStore_RemoveDuringIteration(t *testing.T) {
+	store := NewDictionaryStore()
+	for _, quad := range testQuads(50) {
+		store.AddQuad(quad)
+	}
+	store.ForEach(func(quad interfaces.IQuad) {
+		store.RemoveQuad(quad)
+	})
+	if store.Size() != 0 {
+		t.Errorf("Expected the store to be empty, got %d quads", store.Size())
+	}
+
+	for _, quad := range testQuads(50) {
+		store.AddQuad(quad)
+	}
+	store.RemoveMatches(nil, NewNamedNode("p"), nil, nil)
+	if store.Size() != 0 {
+		t.Errorf("Expected the store to be empty, got %d quads", store.Size())
+	}
+	store.RemoveMatches(NewNamedNode("missing"), nil, nil, nil)
+}
+
+func TestDictionaryStore_MatchContext(t *testing.T) {
+	store := NewDictionaryStore()
+	for _, quad := range testQuads(1000) {
+		store.AddQuad(quad)
+	}
+	before := runtime.NumGoroutine()
+	quadStream := store.MatchContext(context.Background(), nil, nil, nil, nil)
+	<-quadStream.Quads()
+	quadStream.Close()
+	<-quadStream.Done()
+	waitForGoroutines(t, before)
+
+	count := 0
+	for range store.MatchSeq(nil, NewNamedNode("p"), nil, nil) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Expected the loop to stop after 1 quad, got %d", count)
+	}
+}
+
+func TestDictionaryStore_MatchContextSnapshot(t *testing.T) {
+	store := NewDictionaryStore()
+	quads := testQuads(3)
+	store.AddQuad(quads[0])
+	store.AddQuad(quads[1])
+	quadStream := store.MatchContext(context.Background(), nil, nil, nil, nil)
+	store.RemoveQuad(quads[0])
+	store.AddQuad(quads[2])
+	expected := matchStrings(ArrayToStream(quads[:2]).ToIStream())
+	if got := matchStrings(quadStream.ToIStream()); !equalStrings(expected, got) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestDictionaryStore_SameAsStore(t *testing.T) {
+	testSameAsStore(t, NewDictionaryStore)
+}
+
+// testSameAsStore applies the same random operations to a Store and another
+// store and compares the results of every pattern.
+func testSameAsStore(t *testing.T, newStore func() IStore) {
+	random := rand.New(rand.NewSource(1))
+	term := func(position int) interfaces.ITerm {
+		i := strconv.Itoa(random.Intn(4))
+		switch {
+		case position == 3 && random.Intn(3) == 0:
+			return NewDefaultGraph()
+		case position == 2 && random.Intn(3) == 0:
+			return NewStringLiteral(i, "")
+		case position != 1 && random.Intn(4) == 0:
+			return NewBlankNode("b" + i)
+		default:
+			return NewNamedNode("http://example.com/" + i)
+		}
+	}
+	randomQuad := func() interfaces.IQuad {
+		quad, _ := NewQuad(term(0), term(1), term(2), term(3))
+		return quad
+	}
+
+	store := NewStore()
+	other := newStore()
+	for i := 0; i < 500; i++ {
+		quad := randomQuad()
+		if random.Intn(3) == 0 {
+			store.RemoveQuad(quad)
+			other.RemoveQuad(quad)
+		} else if store.AddQuad(quad) != other.AddQuad(quad) {
+			t.Fatalf("Expected both stores to agree on adding %s", quad.ToString())
+		}
+		if store.Size() != other.Size() {
+			t.Fatalf("Expected both stores to have %d quads, got %d", store.Size(), other.Size())
+		}
+	}
+
+	for i := 0; i < 200; i++ {
+		var pattern [4]interfaces.ITerm
+		for position := range pattern {
+			if random.Intn(2) == 0 {
+				pattern[position] = term(position)
+			}
+		}
+		expected := matchStrings(store.Match(pattern[0], pattern[1], pattern[2], pattern[3]))
+		got := matchStrings(other.Match(pattern[0], pattern[1], pattern[2], pattern[3]))
+		if !equalStrings(expected, got) {
+			t.Errorf("Expected %v for %v, got %v", expected, pattern, got)
+		}
+		quad := randomQuad()
+		if store.Has(quad) != other.Has(quad) {
+			t.Errorf("Expected both stores to agree on containing %s", quad.ToString())
+		}
+	}
+
+	store.DeleteGraph(NewDefaultGraph())
+	other.DeleteGraph(NewDefaultGraph())
+	store.Remove(store.Match(NewNamedNode("http://example.com/0"), nil, nil, nil))
+	other.Remove(other.Match(NewNamedNode("http://example.com/0"), nil, nil, nil))
+	if !equalStrings(matchStrings(store.Match(nil, nil, nil, nil)), matchStrings(other.Match(nil, nil, nil, nil))) {
+		t.Errorf("Expected both stores to contain the same quads")
+	}
+}
+
+func benchmarkQuads(size int) []interfaces.IQuad {
+	quads := make([]interfaces.IQuad, 0, size)
+	for i := 0; i < size; i++ {
+		quad, _ := NewQuad(
+			NewNamedNode("http://example.com/subject/"+strconv.Itoa(i/10)),
+			NewNamedNode("http://example.com/predicate/"+strconv.Itoa(i%10)),
+			NewStringLiteral("object "+strconv.Itoa(i), ""),
+			NewNamedNode("http://example.com/graph/"+strconv.Itoa(i%3)),
+		)
+		quads = append(quads, quad)
+	}
+	return quads
+}
+
+// benchmarkMemory reports the heap that a store holds per quad.
+func benchmarkMemory(b *testing.B, newStore func() IStore) {
+	quads := benchmarkQuads(100000)
+	var stores []IStore
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	for i := 0; i < b.N; i++ {
+		store := newStore()
+		for _, quad := range quads {
+			store.AddQuad(quad)
+		}
+		stores = append(stores, store)
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc)/float64(b.N*len(quads)), "bytes/quad")
+	runtime.KeepAlive(stores)
+}
+
+func BenchmarkStore_Memory(b *testing.B) {
+	benchmarkMemory(b, NewStore)
+}
+
+func BenchmarkDictionaryStore_Memory(b *testing.B) {
+	benchmarkMemory(b, NewDictionaryStore)
+}
+
+func benchmarkAdd(b *testing.B, newStore func() IStore) {
+	quads := benchmarkQuads(b.N)
+	store := newStore()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for _, quad := range quads {
+		store.AddQuad(quad)
+	}
+}
+
+func BenchmarkStore_Add(b *testing.B) {
+	benchmarkAdd(b, NewStore)
+}
+
+func BenchmarkDictionaryStore_Add(b *testing.B) {
+	benchmarkAdd(b, NewDictionaryStore)
+}
+
+func benchmarkHas(b *testing.B, newStore func() IStore) {
+	quads := benchmarkQuads(10000)
+	store := newStore()
+	for _, quad := range quads {
+		store.AddQuad(quad)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.Has(quads[i%len(quads)])
+	}
+}
+
+func BenchmarkStore_Has(b *testing.B) {
+	benchmarkHas(b, NewStore)
+}
+
+func BenchmarkDictionaryStore_Has(b *testing.B) {
+	benchmarkHas(b, NewDictionaryStore)
+}
+
+func benchmarkMatchSeq(b *testing.B, newStore func() IStore) {
+	quads := benchmarkQuads(10000)
+	store := newStore()
+	for _, quad := range quads {
+		store.AddQuad(quad)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range store.MatchSeq(quads[i%len(quads)].GetSubject(), nil, nil, nil) {
+		}
+	}
+}
+
+func BenchmarkStore_MatchSubject(b *testing.B) {
+	benchmarkMatchSeq(b, NewStore)
+}
+
+func BenchmarkDictionaryStore_MatchSubject(b *testing.B) {
+	benchmarkMatchSeq(b, NewDictionaryStore)
+}
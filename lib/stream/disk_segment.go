@@ -0,0 +1,204 @@
+package rdfgo
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+var CorruptSegmentError = errors.New("corrupt segment file")
+
+const segmentMagic = "RDFGOSEG"
+const segmentHeaderSize = 16
+const recordSize = 16
+
+// segment is an immutable file with the keys of one permutation index, sorted
+// and stored as fixed size records so they can be binary searched with
+// ReadAt.
+type segment struct {
+	file  *os.File
+	count int
+}
+
+func openSegment(path string) (*segment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, segmentHeaderSize)
+	if _, err := io.ReadFull(file, header); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("%w: %s", CorruptSegmentError, path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	count := binary.LittleEndian.Uint64(header[8:])
+	if string(header[:8]) != segmentMagic || uint64(info.Size()) != segmentHeaderSize+count*recordSize {
+		_ = file.Close()
+		return nil, fmt.Errorf("%w: %s", CorruptSegmentError, path)
+	}
+	return &segment{file: file, count: int(count)}, nil
+}
+
+// writeSegment writes the keys produced by keys to a new segment file. The
+// file is written under a temporary name and synced before it is renamed, so
+// a crash never leaves a partial segment behind under its final name.
+func writeSegment(path string, keys func(yield func(quadIDs) bool)) error {
+	temporary := path + ".tmp"
+	file, err := os.Create(temporary)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(temporary)
+	}()
+	writer := bufio.NewWriterSize(file, 1<<16)
+	header := make([]byte, segmentHeaderSize)
+	copy(header, segmentMagic)
+	if _, err := writer.Write(header); err != nil {
+		return err
+	}
+	count := uint64(0)
+	record := make([]byte, recordSize)
+	for key := range keys {
+		for i, id := range key {
+			binary.LittleEndian.PutUint32(record[i*4:], uint32(id))
+		}
+		if _, err := writer.Write(record); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint64(header[8:], count)
+	if _, err := file.WriteAt(header, 0); err != nil {
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temporary, path)
+}
+
+func decodeRecord(record []byte) quadIDs {
+	var key quadIDs
+	for i := range key {
+		key[i] = termID(binary.LittleEndian.Uint32(record[i*4:]))
+	}
+	return key
+}
+
+// read decodes up to limit records starting at position.
+func (g *segment) read(position int, limit int, keys []quadIDs) ([]quadIDs, error) {
+	if g == nil || position >= g.count {
+		return keys, nil
+	}
+	limit = min(limit, g.count-position)
+	data := make([]byte, limit*recordSize)
+	if _, err := g.file.ReadAt(data, segmentHeaderSize+int64(position)*recordSize); err != nil {
+		return keys, err
+	}
+	for i := 0; i < limit; i++ {
+		keys = append(keys, decodeRecord(data[i*recordSize:]))
+	}
+	return keys, nil
+}
+
+// lowerBound returns the position of the first key that is greater than or
+// equal to key, or greater than key when inclusive is false.
+func (g *segment) lowerBound(key quadIDs, inclusive bool) (int, error) {
+	if g == nil {
+		return 0, nil
+	}
+	record := make([]byte, recordSize)
+	var err error
+	position := sort.Search(g.count, func(i int) bool {
+		if err != nil {
+			return true
+		}
+		if _, err = g.file.ReadAt(record, segmentHeaderSize+int64(i)*recordSize); err != nil {
+			return true
+		}
+		comparison := compareIDs(decodeRecord(record), key)
+		return comparison > 0 || (inclusive && comparison == 0)
+	})
+	return position, err
+}
+
+func (g *segment) has(key quadIDs) (bool, error) {
+	position, err := g.lowerBound(key, true)
+	if err != nil || g == nil || position == g.count {
+		return false, err
+	}
+	keys, err := g.read(position, 1, nil)
+	return err == nil && keys[0] == key, err
+}
+
+// scan appends up to limit keys that follow from and share its first prefix
+// components to keys. It also reports whether the scan stopped at the limit,
+// in which case there may be more matching keys.
+func (g *segment) scan(from quadIDs, inclusive bool, prefix int, limit int, keys []quadIDs) ([]quadIDs, bool, error) {
+	position, err := g.lowerBound(from, inclusive)
+	if err != nil {
+		return keys, false, err
+	}
+	start := len(keys)
+	keys, err = g.read(position, limit, keys)
+	if err != nil {
+		return keys[:start], false, err
+	}
+	for i := start; i < len(keys); i++ {
+		for j := 0; j < prefix; j++ {
+			if keys[i][j] != from[j] {
+				return keys[:i], false, nil
+			}
+		}
+	}
+	return keys, len(keys)-start == limit, nil
+}
+
+// all returns every key in the segment, reading the file sequentially.
+func (g *segment) all(err *error) func(yield func(quadIDs) bool) {
+	return func(yield func(quadIDs) bool) {
+		const block = 4096
+		for position := 0; g != nil && position < g.count; position += block {
+			keys, readError := g.read(position, block, make([]quadIDs, 0, block))
+			if readError != nil {
+				*err = readError
+				return
+			}
+			for _, key := range keys {
+				if !yield(key) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (g *segment) size() int {
+	if g == nil {
+		return 0
+	}
+	return g.count
+}
+
+func (g *segment) close() error {
+	if g == nil {
+		return nil
+	}
+	return g.file.Close()
+}
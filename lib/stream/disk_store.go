@@ -0,0 +1,839 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+var DiskStoreClosedError = errors.New("disk store is closed")
+var CorruptManifestError = errors.New("corrupt manifest")
+
+// SyncPolicy decides when the write-ahead log of a DiskStore is synced to
+// disk. Every policy recovers to a consistent state after a crash, they
+// differ in how many of the most recent writes can be lost.
+type SyncPolicy int
+
+const (
+	// SyncAlways syncs after every write, a write that returned is never lost.
+	SyncAlways SyncPolicy = iota
+	// SyncPeriodically syncs every Interval, a crash loses at most the writes
+	// of the last interval.
+	SyncPeriodically
+	// SyncNever leaves syncing to the operating system. Writes are still
+	// synced by Sync, Checkpoint and Close.
+	SyncNever
+)
+
+// DiskStoreOptions configures a DiskStore. Interval defaults to one second
+// and CheckpointSize, the number of changes kept in memory and in the log
+// before they are merged into the segment files, to 65536.
+type DiskStoreOptions struct {
+	Sync           SyncPolicy
+	Interval       time.Duration
+	CheckpointSize int
+}
+
+const manifestName = "MANIFEST"
+const termsName = "terms.log"
+const manifestHeader = "rdfgo disk store 1"
+
+// importBatch is the number of quads that Import and Remove write to the log
+// as one batch.
+const importBatch = 1024
+
+// DiskStore is a store that is kept in a directory. The quads are stored in
+// six sorted segment files, one per permutation like in a PermutationStore,
+// and changes since the last checkpoint are kept in memory and appended to a
+// write-ahead log. Opening the store replays the committed batches of the
+// log, so it recovers from a crash at any point. A directory may only be
+// opened by one DiskStore at a time.
+//
+// Write errors are sticky: after a write failed, Err returns the error and
+// every further write is rejected.
+type DiskStore struct {
+	directory  string
+	options    DiskStoreOptions
+	dictionary *termDictionary
+	terms      *os.File
+	termsSize  int64
+	termsCount int
+	generation int
+	segments   [6]*segment
+	// added holds the quads that are not in the segments, removed the quads
+	// in the segments that were removed since the last checkpoint.
+	added    [6]sortedIndex
+	removed  map[quadIDs]struct{}
+	wal      *wal
+	unsynced bool
+	closed   bool
+	stop     chan struct{}
+	stopped  chan struct{}
+	stopOnce sync.Once
+	mux      sync.RWMutex
+
+	errMux sync.Mutex
+	err    error
+}
+
+func OpenDiskStore(directory string, options ...DiskStoreOptions) (*DiskStore, error) {
+	s := &DiskStore{
+		directory:  directory,
+		dictionary: newTermDictionary(),
+		removed:    make(map[quadIDs]struct{}),
+	}
+	if len(options) > 0 {
+		s.options = options[0]
+	}
+	if s.options.Interval <= 0 {
+		s.options.Interval = time.Second
+	}
+	if s.options.CheckpointSize <= 0 {
+		s.options.CheckpointSize = 1 << 16
+	}
+
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, err
+	}
+	if err := s.open(); err != nil {
+		_ = s.closeFiles()
+		return nil, err
+	}
+	if s.options.Sync == SyncPeriodically {
+		s.stop = make(chan struct{})
+		s.stopped = make(chan struct{})
+		go s.syncPeriodically()
+	}
+	return s, nil
+}
+
+func (s *DiskStore) open() error {
+	termsSize, err := s.readManifest()
+	if err != nil {
+		return err
+	}
+	if err := s.readTerms(termsSize); err != nil {
+		return err
+	}
+	if s.generation > 0 {
+		for i := range s.segments {
+			if s.segments[i], err = openSegment(s.segmentPath(i, s.generation)); err != nil {
+				return err
+			}
+		}
+	}
+	if s.wal, err = openWAL(s.walPath(s.generation), s.replay); err != nil {
+		return err
+	}
+	return s.removeStaleFiles()
+}
+
+func (s *DiskStore) readManifest() (int64, error) {
+	data, err := os.ReadFile(filepath.Join(s.directory, manifestName))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var termsSize int64
+	format := manifestHeader + "\ngeneration %d\nterms %d %d\n"
+	if _, err := fmt.Sscanf(string(data), format, &s.generation, &termsSize, &s.termsCount); err != nil {
+		return 0, fmt.Errorf("%w: %v", CorruptManifestError, err)
+	}
+	return termsSize, nil
+}
+
+// writeManifest replaces the manifest. The new manifest is synced before it
+// is renamed over the old one, so the switch to a new generation is atomic.
+func (s *DiskStore) writeManifest(generation int, termsSize int64, termsCount int) error {
+	path := filepath.Join(s.directory, manifestName)
+	data := fmt.Sprintf(manifestHeader+"\ngeneration %d\nterms %d %d\n", generation, termsSize, termsCount)
+	file, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = file.WriteString(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeError := file.Close(); err == nil {
+		err = closeError
+	}
+	if err == nil {
+		err = os.Rename(path+".tmp", path)
+	}
+	if err != nil {
+		return err
+	}
+	return syncDirectory(s.directory)
+}
+
+func syncDirectory(path string) error {
+	directory, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer directory.Close()
+	return directory.Sync()
+}
+
+// readTerms loads the terms that the manifest counts and drops anything a
+// failed checkpoint appended after them.
+func (s *DiskStore) readTerms(size int64) error {
+	var err error
+	s.terms, err = os.OpenFile(filepath.Join(s.directory, termsName), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(s.terms, data); err != nil {
+		return fmt.Errorf("%w: %s is too short", CorruptManifestError, termsName)
+	}
+	for offset := 0; offset < len(data); {
+		term, n, err := decodeTerm(data[offset:])
+		if err != nil {
+			return err
+		}
+		s.dictionary.intern(term)
+		offset += n
+	}
+	if len(s.dictionary.terms) != s.termsCount {
+		return fmt.Errorf("%w: expected %d terms, got %d", CorruptManifestError, s.termsCount, len(s.dictionary.terms))
+	}
+	s.termsSize = size
+	return s.terms.Truncate(size)
+}
+
+func (s *DiskStore) replay(batch walBatch) error {
+	for _, data := range batch.terms {
+		term, n, err := decodeTerm(data)
+		if err != nil || n != len(data) {
+			return CorruptLogError
+		}
+		count := len(s.dictionary.terms)
+		if s.dictionary.intern(term) != termID(count+1) {
+			return CorruptLogError
+		}
+	}
+	for _, change := range batch.changes {
+		for _, id := range change.ids {
+			if int(id) > len(s.dictionary.terms) {
+				return CorruptLogError
+			}
+		}
+		if _, err := s.apply(change.kind, change.ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeStaleFiles deletes the files of other generations, which a crash
+// during a checkpoint can leave behind.
+func (s *DiskStore) removeStaleFiles() error {
+	entries, err := os.ReadDir(s.directory)
+	if err != nil {
+		return err
+	}
+	current := map[string]bool{filepath.Base(s.walPath(s.generation)): true}
+	for i := range s.segments {
+		current[filepath.Base(s.segmentPath(i, s.generation))] = true
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		stale := strings.HasSuffix(name, ".tmp") ||
+			(strings.HasPrefix(name, "wal-") || strings.HasSuffix(name, ".seg")) && !current[name]
+		if stale {
+			if err := os.Remove(filepath.Join(s.directory, name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *DiskStore) walPath(generation int) string {
+	return filepath.Join(s.directory, fmt.Sprintf("wal-%06d.log", generation))
+}
+
+func (s *DiskStore) segmentPath(index int, generation int) string {
+	name := strings.ToLower(permutations[index].String())
+	return filepath.Join(s.directory, fmt.Sprintf("%s-%06d.seg", name, generation))
+}
+
+func (s *DiskStore) syncPeriodically() {
+	defer close(s.stopped)
+	ticker := time.NewTicker(s.options.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.mux.Lock()
+			if !s.closed && s.unsynced && s.Err() == nil {
+				if err := s.wal.sync(); err != nil {
+					s.fail(err)
+				}
+				s.unsynced = false
+			}
+			s.mux.Unlock()
+		}
+	}
+}
+
+// Err returns the first error the store ran into while reading or writing.
+func (s *DiskStore) Err() error {
+	s.errMux.Lock()
+	defer s.errMux.Unlock()
+	return s.err
+}
+
+func (s *DiskStore) fail(err error) {
+	s.errMux.Lock()
+	defer s.errMux.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// contains reports whether the store has a quad. The caller has to hold the
+// lock.
+func (s *DiskStore) contains(ids quadIDs) (bool, error) {
+	if s.added[0].has(ids) {
+		return true, nil
+	}
+	if _, ok := s.removed[ids]; ok {
+		return false, nil
+	}
+	return s.segments[0].has(ids)
+}
+
+// apply adds or removes a quad in memory and reports whether that changed
+// the store. A quad is either in added or in the segments, never in both.
+func (s *DiskStore) apply(kind byte, ids quadIDs) (bool, error) {
+	present, err := s.contains(ids)
+	if err != nil || present == (kind == walAdd) {
+		return false, err
+	}
+	if kind == walAdd {
+		if _, ok := s.removed[ids]; ok {
+			delete(s.removed, ids)
+			return true, nil
+		}
+		for i := range s.added {
+			s.added[i].insert(permutations[i].apply(ids))
+		}
+	} else if s.added[0].has(ids) {
+		for i := range s.added {
+			s.added[i].remove(permutations[i].apply(ids))
+		}
+	} else {
+		s.removed[ids] = struct{}{}
+	}
+	return true, nil
+}
+
+// intern returns the ID of a term and logs the term when it is new.
+func (s *DiskStore) intern(term interfaces.ITerm) termID {
+	count := len(s.dictionary.terms)
+	id := s.dictionary.intern(term)
+	if len(s.dictionary.terms) > count {
+		s.wal.term(appendTerm(nil, term))
+	}
+	return id
+}
+
+// write runs changes under the lock and commits the changes they logged as
+// one batch.
+func (s *DiskStore) write(changes func() error) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.closed {
+		return DiskStoreClosedError
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	err := changes()
+	if err == nil && s.wal.pending() {
+		err = s.wal.commit()
+		s.unsynced = true
+		if err == nil && s.options.Sync == SyncAlways {
+			err = s.wal.sync()
+			s.unsynced = false
+		}
+	}
+	if err == nil && len(s.removed)+s.added[0].size >= s.options.CheckpointSize {
+		err = s.checkpoint()
+	}
+	if err != nil {
+		s.fail(err)
+	}
+	return err
+}
+
+// change adds or removes a quad and logs it when that changed the store. The
+// caller has to hold the lock.
+func (s *DiskStore) change(kind byte, ids quadIDs) (bool, error) {
+	changed, err := s.apply(kind, ids)
+	if changed {
+		s.wal.change(kind, ids)
+	}
+	return changed, err
+}
+
+func (s *DiskStore) addTerms(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) (bool, error) {
+	graph, ok := checkQuadTerms(subject, predicate, object, graph)
+	if !ok {
+		return false, nil
+	}
+	ids := quadIDs{s.intern(subject), s.intern(predicate), s.intern(object), s.intern(graph)}
+	return s.change(walAdd, ids)
+}
+
+func (s *DiskStore) removeQuad(quad interfaces.IQuad) error {
+	ids, ok := s.dictionary.lookupQuad(quad)
+	if !ok {
+		return nil
+	}
+	_, err := s.change(walRemove, ids)
+	return err
+}
+
+// Checkpoint merges the changes in memory into new segment files and starts
+// a new, empty log.
+func (s *DiskStore) Checkpoint() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.closed {
+		return DiskStoreClosedError
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	if err := s.checkpoint(); err != nil {
+		s.fail(err)
+		return err
+	}
+	return nil
+}
+
+// checkpoint writes the segments and terms of the next generation and then
+// switches the manifest to it. A crash before the switch leaves the previous
+// generation and its log in place. The caller has to hold the lock.
+func (s *DiskStore) checkpoint() error {
+	if s.added[0].size == 0 && len(s.removed) == 0 && len(s.dictionary.terms) == s.termsCount {
+		return nil
+	}
+	generation := s.generation + 1
+	for i := range s.segments {
+		var readError error
+		err := writeSegment(s.segmentPath(i, generation), s.merged(i, &readError))
+		if err == nil {
+			err = readError
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var data []byte
+	for _, term := range s.dictionary.terms[s.termsCount:] {
+		data = appendTerm(data, term)
+	}
+	if _, err := s.terms.WriteAt(data, s.termsSize); err != nil {
+		return err
+	}
+	if err := s.terms.Sync(); err != nil {
+		return err
+	}
+	termsSize, termsCount := s.termsSize+int64(len(data)), len(s.dictionary.terms)
+	if err := os.Remove(s.walPath(generation)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := s.writeManifest(generation, termsSize, termsCount); err != nil {
+		return err
+	}
+
+	previous, previousWAL := s.segments, s.wal
+	for i := range s.segments {
+		var err error
+		if s.segments[i], err = openSegment(s.segmentPath(i, generation)); err != nil {
+			return err
+		}
+	}
+	var err error
+	if s.wal, err = openWAL(s.walPath(generation), func(walBatch) error { return nil }); err != nil {
+		return err
+	}
+	_ = previousWAL.close()
+	_ = os.Remove(s.walPath(s.generation))
+	for i, segment := range previous {
+		_ = segment.close()
+		if segment != nil {
+			_ = os.Remove(s.segmentPath(i, s.generation))
+		}
+	}
+	s.generation, s.termsSize, s.termsCount = generation, termsSize, termsCount
+	s.added = [6]sortedIndex{}
+	s.removed = make(map[quadIDs]struct{})
+	s.unsynced = false
+	return nil
+}
+
+// merged returns the keys of the next generation of a segment in order. A
+// read error stops the iteration and is stored in err.
+func (s *DiskStore) merged(index int, err *error) func(yield func(quadIDs) bool) {
+	return func(yield func(quadIDs) bool) {
+		added := s.added[index].scan(quadIDs{}, true, 0, s.added[index].size, nil)
+		for key := range s.segments[index].all(err) {
+			for len(added) > 0 && compareIDs(added[0], key) < 0 {
+				if !yield(added[0]) {
+					return
+				}
+				added = added[1:]
+			}
+			if _, ok := s.removed[permutations[index].revert(key)]; ok {
+				continue
+			}
+			if !yield(key) {
+				return
+			}
+		}
+		for _, key := range added {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Sync syncs the log, so every write that returned survives a crash.
+func (s *DiskStore) Sync() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.closed {
+		return DiskStoreClosedError
+	}
+	if err := s.wal.sync(); err != nil {
+		s.fail(err)
+		return err
+	}
+	s.unsynced = false
+	return nil
+}
+
+// Close checkpoints the store and closes its files. Closing a store that is
+// already closed does nothing.
+func (s *DiskStore) Close() error {
+	s.stopOnce.Do(func() {
+		if s.stop != nil {
+			close(s.stop)
+			<-s.stopped
+		}
+	})
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.closed {
+		return nil
+	}
+	var err error
+	if s.Err() == nil {
+		err = s.checkpoint()
+	}
+	s.closed = true
+	if closeError := s.closeFiles(); err == nil {
+		err = closeError
+	}
+	return err
+}
+
+func (s *DiskStore) closeFiles() error {
+	var err error
+	if s.wal != nil {
+		err = s.wal.close()
+	}
+	for _, segment := range s.segments {
+		if closeError := segment.close(); err == nil {
+			err = closeError
+		}
+	}
+	if s.terms != nil {
+		if closeError := s.terms.Close(); err == nil {
+			err = closeError
+		}
+	}
+	return err
+}
+
+func (s *DiskStore) Size() int {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.segments[0].size() - len(s.removed) + s.added[0].size
+}
+
+func (s *DiskStore) Has(quad interfaces.IQuad) bool {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	ids, ok := s.dictionary.lookupQuad(quad)
+	if !ok || s.closed {
+		return false
+	}
+	present, err := s.contains(ids)
+	if err != nil {
+		s.fail(err)
+	}
+	return present
+}
+
+func (s *DiskStore) AddQuadFromTerms(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) bool {
+	added := false
+	err := s.write(func() error {
+		var err error
+		added, err = s.addTerms(subject, predicate, object, graph)
+		return err
+	})
+	return added && err == nil
+}
+
+func (s *DiskStore) AddQuad(quad interfaces.IQuad) bool {
+	return s.AddQuadFromTerms(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+}
+
+func (s *DiskStore) RemoveQuad(quad interfaces.IQuad) {
+	_ = s.write(func() error {
+		return s.removeQuad(quad)
+	})
+}
+
+// RemoveMatches removes the matching quads in one batch.
+func (s *DiskStore) RemoveMatches(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) {
+	_ = s.write(func() error {
+		index, from, prefix, ok := patternRange(s.dictionary, subject, predicate, object, graph)
+		if !ok {
+			return nil
+		}
+		var keys []quadIDs
+		inclusive := true
+		for {
+			var next quadIDs
+			var more bool
+			var err error
+			keys, next, more, err = s.scan(index, from, inclusive, prefix, keys)
+			if err != nil {
+				return err
+			}
+			if !more {
+				break
+			}
+			from, inclusive = next, false
+		}
+		for _, key := range keys {
+			if _, err := s.change(walRemove, permutations[index].revert(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// batches writes the quads of a stream in batches of importBatch quads.
+func (s *DiskStore) batches(stream interfaces.IStream, write func(interfaces.IQuad) error) {
+	batch := make([]interfaces.IQuad, 0, importBatch)
+	flush := func() {
+		_ = s.write(func() error {
+			for _, quad := range batch {
+				if err := write(quad); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		batch = batch[:0]
+	}
+	for quad := range stream {
+		if quad == nil {
+			continue
+		}
+		batch = append(batch, quad)
+		if len(batch) == importBatch {
+			flush()
+		}
+	}
+	flush()
+}
+
+func (s *DiskStore) Import(stream interfaces.IStream) {
+	s.batches(stream, func(quad interfaces.IQuad) error {
+		_, err := s.addTerms(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+		return err
+	})
+}
+
+func (s *DiskStore) Remove(stream interfaces.IStream) {
+	s.batches(stream, s.removeQuad)
+}
+
+func (s *DiskStore) DeleteGraph(graph interfaces.ITerm) {
+	s.RemoveMatches(nil, nil, nil, graph)
+}
+
+// scan appends the next batch of matching keys of an index to keys, merging
+// the segment with the quads in memory. It returns the key to resume the
+// scan after, exclusively, and whether there may be more keys. The caller
+// has to hold the lock.
+func (s *DiskStore) scan(index int, from quadIDs, inclusive bool, prefix int, keys []quadIDs) ([]quadIDs, quadIDs, bool, error) {
+	added := s.added[index].scan(from, inclusive, prefix, materializeBatch, nil)
+	stored, moreStored, err := s.segments[index].scan(from, inclusive, prefix, materializeBatch, nil)
+	if err != nil {
+		return keys, quadIDs{}, false, err
+	}
+	moreAdded := len(added) == materializeBatch
+
+	// Keys after the last key of a source that stopped at the limit can not
+	// be emitted yet, that source may have smaller keys in its next batch.
+	var next quadIDs
+	if moreStored {
+		next = stored[len(stored)-1]
+	}
+	if moreAdded && (!moreStored || compareIDs(added[len(added)-1], next) < 0) {
+		next = added[len(added)-1]
+	}
+	more := moreAdded || moreStored
+
+	for len(added) > 0 || len(stored) > 0 {
+		var key quadIDs
+		fromSegment := len(added) == 0 || (len(stored) > 0 && compareIDs(stored[0], added[0]) < 0)
+		if fromSegment {
+			key, stored = stored[0], stored[1:]
+		} else {
+			key, added = added[0], added[1:]
+		}
+		if more && compareIDs(key, next) > 0 {
+			break
+		}
+		if fromSegment {
+			if _, ok := s.removed[permutations[index].revert(key)]; ok {
+				continue
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, next, more, nil
+}
+
+// MatchSeq returns the matching quads as an iterator, sorted like the
+// matches of a PermutationStore. The lock is released while the loop body
+// runs, so the body may modify the store. A read error ends the iteration
+// early and is returned by Err.
+func (s *DiskStore) MatchSeq(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) iter.Seq[interfaces.IQuad] {
+	return func(yield func(interfaces.IQuad) bool) {
+		s.mux.RLock()
+		index, from, prefix, ok := patternRange(s.dictionary, subject, predicate, object, graph)
+		s.mux.RUnlock()
+		if !ok {
+			return
+		}
+
+		keys := make([]quadIDs, 0, materializeBatch)
+		var quads []interfaces.IQuad
+		inclusive := true
+		for {
+			s.mux.RLock()
+			if s.closed {
+				s.mux.RUnlock()
+				return
+			}
+			var next quadIDs
+			var more bool
+			var err error
+			keys, next, more, err = s.scan(index, from, inclusive, prefix, keys[:0])
+			quads = quads[:0]
+			for _, key := range keys {
+				quads = append(quads, s.dictionary.quad(permutations[index].revert(key)))
+			}
+			s.mux.RUnlock()
+			if err != nil {
+				s.fail(err)
+				return
+			}
+
+			for _, quad := range quads {
+				if !yield(quad) {
+					return
+				}
+			}
+			if !more {
+				return
+			}
+			from, inclusive = next, false
+		}
+	}
+}
+
+// MatchContext streams the matching quads. The stream ends with the error of
+// the store when a read failed.
+func (s *DiskStore) MatchContext(
+	ctx context.Context,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) *QuadStream {
+	quads := s.MatchSeq(subject, predicate, object, graph)
+	return NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		for quad := range quads {
+			if !emit(quad) {
+				return ctx.Err()
+			}
+		}
+		return s.Err()
+	})
+}
+
+func (s *DiskStore) Match(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) interfaces.IStream {
+	return s.MatchContext(context.Background(), subject, predicate, object, graph).ToIStream()
+}
+
+func (s *DiskStore) ForEach(callback func(interfaces.IQuad)) {
+	for quad := range s.MatchSeq(nil, nil, nil, nil) {
+		callback(quad)
+	}
+}
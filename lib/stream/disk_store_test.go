@@ -0,0 +1,230 @@
+package rdfgo
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func openTestDiskStore(t *testing.T, directory string, options ...DiskStoreOptions) *DiskStore {
+	t.Helper()
+	store, err := OpenDiskStore(directory, options...)
+	if err != nil {
+		t.Fatalf("Expected the store to open, got %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+// crash closes the files of a store without a checkpoint, like a process
+// that is killed.
+func crash(store *DiskStore) {
+	store.mux.Lock()
+	defer store.mux.Unlock()
+	store.closed = true
+	_ = store.closeFiles()
+}
+
+func TestTermEncoding(t *testing.T) {
+	quoted, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewBlankNode("b"), NewNamedNode("g"))
+	terms := []interfaces.ITerm{
+		NewNamedNode("http://example.com/é"),
+		NewNamedNode(""),
+		NewBlankNode("b1"),
+		NewStringLiteral("hello", "en"),
+		NewStringLiteral("hello", "en--ltr"),
+		NewStringLiteral("", ""),
+		NewIntegerLiteral(42),
+		NewDefaultGraph(),
+		NewVariable("x"),
+		quoted,
+	}
+	var data []byte
+	for _, term := range terms {
+		data = appendTerm(data, term)
+	}
+	for _, term := range terms {
+		decoded, n, err := decodeTerm(data)
+		if err != nil {
+			t.Fatalf("Expected %s to decode, got %v", term.ToString(), err)
+		}
+		if termKey(decoded) != termKey(term) {
+			t.Errorf("Expected %s, got %s", term.ToString(), decoded.ToString())
+		}
+		data = data[n:]
+	}
+	if _, _, err := decodeTerm(appendTerm(nil, NewNamedNode("http://example.com/"))[:5]); !errors.Is(err, CorruptTermError) {
+		t.Errorf("Expected a truncated term to be corrupt, got %v", err)
+	}
+}
+
+func TestDiskStore_SameAsStore(t *testing.T) {
+	testSameAsStore(t, func() IStore {
+		return openTestDiskStore(t, t.TempDir(), DiskStoreOptions{Sync: SyncNever, CheckpointSize: 100})
+	})
+}
+
+func TestDiskStore_Reopen(t *testing.T) {
+	directory := t.TempDir()
+	store := openTestDiskStore(t, directory, DiskStoreOptions{CheckpointSize: 100})
+	store.Import(ArrayToStream(testQuads(250)).ToIStream())
+	store.RemoveMatches(nil, nil, NewIntegerLiteral(3), nil)
+	quoted, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil)
+	store.AddQuadFromTerms(quoted, NewNamedNode("says"), NewStringLiteral("hi", "en"), NewNamedNode("g"))
+	expected := matchStrings(store.Match(nil, nil, nil, nil))
+	if err := store.Close(); err != nil {
+		t.Fatalf("Expected the store to close, got %v", err)
+	}
+	if store.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil) {
+		t.Errorf("Expected a closed store to reject writes")
+	}
+
+	store = openTestDiskStore(t, directory)
+	if store.Size() != 250 {
+		t.Errorf("Expected 250 quads, got %d", store.Size())
+	}
+	if !equalStrings(expected, matchStrings(store.Match(nil, nil, nil, nil))) {
+		t.Errorf("Expected the reopened store to contain the same quads")
+	}
+	if Stream(store.Match(quoted, nil, nil, nil)).Count() != 1 {
+		t.Errorf("Expected the quoted triple to survive")
+	}
+	entries, _ := os.ReadDir(directory)
+	if len(entries) != 9 {
+		t.Errorf("Expected the manifest, terms, a log and six segments, got %d files", len(entries))
+	}
+}
+
+func TestDiskStore_Recovery(t *testing.T) {
+	directory := t.TempDir()
+	store := openTestDiskStore(t, directory, DiskStoreOptions{CheckpointSize: 50})
+	quads := testQuads(80)
+	for _, quad := range quads[:60] {
+		store.AddQuad(quad)
+	}
+	store.RemoveQuad(quads[0])
+	store.RemoveQuad(quads[55])
+	crash(store)
+
+	store = openTestDiskStore(t, directory)
+	if store.Size() != 58 || store.Has(quads[0]) || store.Has(quads[55]) || !store.Has(quads[59]) {
+		t.Errorf("Expected the logged changes to be replayed, got %d quads", store.Size())
+	}
+	store.AddQuad(quads[60])
+	crash(store)
+
+	// A torn write at the end of the log is dropped.
+	log := filepath.Join(directory, "wal-000001.log")
+	info, _ := os.Stat(log)
+	if err := os.Truncate(log, info.Size()-3); err != nil {
+		t.Fatal(err)
+	}
+	file, _ := os.OpenFile(log, os.O_WRONLY|os.O_APPEND, 0o644)
+	_, _ = file.Write([]byte("garbage"))
+	_ = file.Close()
+
+	store = openTestDiskStore(t, directory)
+	if store.Size() != 58 || store.Has(quads[60]) {
+		t.Errorf("Expected the torn batch to be dropped, got %d quads", store.Size())
+	}
+	store.AddQuad(quads[61])
+	if err := store.Close(); err != nil {
+		t.Fatalf("Expected the store to close, got %v", err)
+	}
+
+	store = openTestDiskStore(t, directory)
+	if store.Size() != 59 || !store.Has(quads[61]) {
+		t.Errorf("Expected writes after the recovery to be kept, got %d quads", store.Size())
+	}
+}
+
+func TestDiskStore_InterruptedCheckpoint(t *testing.T) {
+	directory := t.TempDir()
+	store := openTestDiskStore(t, directory)
+	store.Import(ArrayToStream(testQuads(20)).ToIStream())
+	crash(store)
+
+	// Files of a checkpoint that did not switch the manifest are ignored.
+	for _, name := range []string{"spog-000001.seg", "MANIFEST.tmp", "wal-000001.log"} {
+		_ = os.WriteFile(filepath.Join(directory, name), []byte("partial"), 0o644)
+	}
+	terms, _ := os.OpenFile(filepath.Join(directory, termsName), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	_, _ = terms.Write([]byte("partial"))
+	_ = terms.Close()
+
+	store = openTestDiskStore(t, directory)
+	if store.Size() != 20 {
+		t.Errorf("Expected 20 quads, got %d", store.Size())
+	}
+	if _, err := os.Stat(filepath.Join(directory, "spog-000001.seg")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected the stale segment to be removed")
+	}
+	if err := store.Checkpoint(); err != nil {
+		t.Fatalf("Expected a checkpoint, got %v", err)
+	}
+	crash(store)
+	store = openTestDiskStore(t, directory)
+	if store.Size() != 20 {
+		t.Errorf("Expected 20 quads after the checkpoint, got %d", store.Size())
+	}
+}
+
+func TestDiskStore_CorruptManifest(t *testing.T) {
+	directory := t.TempDir()
+	_ = os.WriteFile(filepath.Join(directory, manifestName), []byte("something else"), 0o644)
+	if _, err := OpenDiskStore(directory); !errors.Is(err, CorruptManifestError) {
+		t.Errorf("Expected a corrupt manifest, got %v", err)
+	}
+}
+
+func TestDiskStore_SyncPeriodically(t *testing.T) {
+	before := runtime.NumGoroutine()
+	store, err := OpenDiskStore(t.TempDir(), DiskStoreOptions{Sync: SyncPeriodically, Interval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Import(ArrayToStream(testQuads(10)).ToIStream())
+	time.Sleep(5 * time.Millisecond)
+	if err := store.Sync(); err != nil {
+		t.Errorf("Expected a sync, got %v", err)
+	}
+	if err := store.Close(); err != nil {
+		t.Errorf("Expected the store to close, got %v", err)
+	}
+	if err := store.Close(); err != nil {
+		t.Errorf("Expected a second close to do nothing, got %v", err)
+	}
+	waitForGoroutines(t, before)
+}
+
+func TestDiskStore_RemoveDuringIteration(t *testing.T) {
+	store := openTestDiskStore(t, t.TempDir(), DiskStoreOptions{Sync: SyncNever, CheckpointSize: 600})
+	for _, quad := range testQuads(1000) {
+		store.AddQuad(quad)
+	}
+	count := 0
+	store.ForEach(func(quad interfaces.IQuad) {
+		store.RemoveQuad(quad)
+		count++
+	})
+	if store.Size() != 0 || count != 1000 {
+		t.Errorf("Expected all 1000 quads to be visited and removed, got %d visited and %d left", count, store.Size())
+	}
+}
+
+func BenchmarkDiskStore_Add(b *testing.B) {
+	benchmarkAdd(b, func() IStore {
+		store, err := OpenDiskStore(b.TempDir(), DiskStoreOptions{Sync: SyncNever})
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Cleanup(func() { _ = store.Close() })
+		return store
+	})
+}
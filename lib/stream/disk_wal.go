@@ -0,0 +1,175 @@
+package rdfgo
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+var CorruptLogError = errors.New("corrupt write-ahead log")
+
+const (
+	walTerm   byte = 'T'
+	walAdd    byte = 'A'
+	walRemove byte = 'R'
+	walCommit byte = 'C'
+)
+
+const walHeaderSize = 8
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// wal is an append-only log of the changes since the last checkpoint. Each
+// record is framed as a little endian payload length and a CRC-32C of the
+// payload, followed by the payload. Changes are buffered in batches that end
+// with a commit record, and only committed batches are replayed, so a torn
+// write at the end of the log is dropped as a whole.
+type wal struct {
+	file   *os.File
+	buffer []byte
+	size   int64
+}
+
+func (w *wal) record(kind byte, data []byte) {
+	start := len(w.buffer)
+	w.buffer = append(w.buffer, make([]byte, walHeaderSize)...)
+	w.buffer = append(w.buffer, kind)
+	w.buffer = append(w.buffer, data...)
+	payload := w.buffer[start+walHeaderSize:]
+	binary.LittleEndian.PutUint32(w.buffer[start:], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(w.buffer[start+4:], crc32.Checksum(payload, castagnoli))
+}
+
+func (w *wal) term(encoded []byte) {
+	w.record(walTerm, encoded)
+}
+
+func (w *wal) change(kind byte, ids quadIDs) {
+	var data [4 * binary.MaxVarintLen32]byte
+	n := 0
+	for _, id := range ids {
+		n += binary.PutUvarint(data[n:], uint64(id))
+	}
+	w.record(kind, data[:n])
+}
+
+func (w *wal) pending() bool {
+	return len(w.buffer) > 0
+}
+
+// commit writes the buffered batch followed by a commit record.
+func (w *wal) commit() error {
+	if !w.pending() {
+		return nil
+	}
+	w.record(walCommit, nil)
+	n, err := w.file.WriteAt(w.buffer, w.size)
+	w.buffer = w.buffer[:0]
+	if err != nil {
+		return err
+	}
+	w.size += int64(n)
+	return nil
+}
+
+func (w *wal) sync() error {
+	return w.file.Sync()
+}
+
+// walBatch is a committed batch of changes read back from the log.
+type walBatch struct {
+	terms   [][]byte
+	changes []walChange
+}
+
+type walChange struct {
+	kind byte
+	ids  quadIDs
+}
+
+func decodeChange(kind byte, data []byte) (walChange, error) {
+	change := walChange{kind: kind}
+	for i := range change.ids {
+		id, n := binary.Uvarint(data)
+		if n <= 0 || id == 0 || id > 1<<32-1 {
+			return change, CorruptLogError
+		}
+		change.ids[i] = termID(id)
+		data = data[n:]
+	}
+	if len(data) != 0 {
+		return change, CorruptLogError
+	}
+	return change, nil
+}
+
+// openWAL opens or creates the log at path and calls apply for every
+// committed batch in it. Anything after the last valid commit record is
+// truncated. An error returned by apply stops the replay.
+func openWAL(path string, apply func(walBatch) error) (*wal, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	var batch walBatch
+	valid := 0
+	for offset := 0; len(data)-offset >= walHeaderSize; {
+		length := int(binary.LittleEndian.Uint32(data[offset:]))
+		checksum := binary.LittleEndian.Uint32(data[offset+4:])
+		if length == 0 || length > len(data)-offset-walHeaderSize {
+			break
+		}
+		payload := data[offset+walHeaderSize : offset+walHeaderSize+length]
+		if crc32.Checksum(payload, castagnoli) != checksum {
+			break
+		}
+		offset += walHeaderSize + length
+
+		kind := payload[0]
+		if kind == walCommit {
+			if err := apply(batch); err != nil {
+				_ = file.Close()
+				return nil, err
+			}
+			batch = walBatch{}
+			valid = offset
+			continue
+		}
+		if kind == walTerm {
+			batch.terms = append(batch.terms, payload[1:])
+			continue
+		}
+		if kind != walAdd && kind != walRemove {
+			break
+		}
+		change, err := decodeChange(kind, payload[1:])
+		if err != nil {
+			break
+		}
+		batch.changes = append(batch.changes, change)
+	}
+
+	if valid < len(data) {
+		if err := file.Truncate(int64(valid)); err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		if err := file.Sync(); err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+	}
+	return &wal{file: file, size: int64(valid)}, nil
+}
+
+func (w *wal) close() error {
+	return w.file.Close()
+}
@@ -0,0 +1,83 @@
+package rdfgo
+
+import (
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	xsd "github.com/maartyman/rdfgo/lib/xsd"
+)
+
+// Equality selects when a Store takes two objects to be the same.
+type Equality int
+
+const (
+	// TermEquality takes objects to be the same when they are the same term,
+	// like Equals.
+	TermEquality Equality = iota
+	// ValueEquality takes literals with an XSD datatype to be the same when
+	// they have the same value, so "1"^^xsd:integer, "01"^^xsd:integer and
+	// "1.0"^^xsd:decimal are one object. Other terms, and literals with a
+	// language or an invalid lexical form, are compared like TermEquality.
+	ValueEquality
+)
+
+// valueKey returns the key of the value of a literal when the store compares
+// literals by value. The keys start with "=", which no term does.
+func (s *Store) valueKey(term interfaces.ITerm) (string, bool) {
+	if s.options.Equality != ValueEquality || term == nil || term.GetType() != interfaces.LiteralType {
+		return "", false
+	}
+	literal, ok := term.(interfaces.ILiteral)
+	if !ok {
+		return "", false
+	}
+	value, err := xsd.ParseLiteral(literal)
+	if err != nil {
+		return "", false
+	}
+	return "=" + value.Key(), true
+}
+
+// objectKey returns the value key of an object, or the object itself.
+func (s *Store) objectKey(object interfaces.ITerm) string {
+	if key, ok := s.valueKey(object); ok {
+		return key
+	}
+	return object.ToString()
+}
+
+// hashes is getHashes with the object replaced by its value key.
+func (s *Store) hashes(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) []string {
+	hashes := getHashes(subject, predicate, object, graph)
+	if key, ok := s.valueKey(object); ok {
+		hashes[2] = ",," + key + ","
+		hashes[4] = strings.Join([]string{
+			strings.TrimSuffix(hashes[0], ",,,"),
+			hashes[1][1 : len(hashes[1])-2],
+			key,
+			strings.TrimPrefix(hashes[3], ",,,"),
+		}, ",")
+	}
+	return hashes
+}
+
+// quadMatches is like the quadMatches function, but compares the object by
+// its value key.
+func (s *Store) quadMatches(
+	quad interfaces.IQuad,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) bool {
+	key, ok := s.valueKey(object)
+	if !ok {
+		return quadMatches(quad, subject, predicate, object, graph)
+	}
+	return quadMatches(quad, subject, predicate, nil, graph) && s.objectKey(quad.GetObject()) == key
+}
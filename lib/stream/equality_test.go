@@ -0,0 +1,98 @@
+package rdfgo
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	xsd "github.com/maartyman/rdfgo/lib/xsd"
+)
+
+func TestStore_ValueEquality(t *testing.T) {
+	subject, predicate := NewNamedNode("s"), NewNamedNode("p")
+	one := NewLiteral("1", "", IRI.XSD.Integer)
+	padded := NewLiteral("01", "", IRI.XSD.Integer)
+	decimal := NewLiteral("1.0", "", IRI.XSD.Decimal)
+	double := NewLiteral("1E0", "", IRI.XSD.Double)
+
+	terms := NewStore().(*Store)
+	values := NewStoreWithOptions(StoreOptions{Equality: ValueEquality}).(*Store)
+	for _, store := range []*Store{terms, values} {
+		store.AddQuadFromTerms(subject, predicate, one, NewDefaultGraph())
+		store.AddQuadFromTerms(subject, predicate, NewStringLiteral("1", ""), NewDefaultGraph())
+		store.AddQuadFromTerms(subject, predicate, NewStringLiteral("1", "en"), NewDefaultGraph())
+	}
+
+	for _, object := range []interfaces.ITerm{one, padded, decimal, double} {
+		quad, _ := NewQuad(subject, predicate, object, NewDefaultGraph())
+		if !values.Has(quad) || values.AddQuad(quad) {
+			t.Errorf("Expected %s to be in the store by value", object.ToString())
+		}
+		if count := len(slices.Collect(values.MatchSeq(nil, nil, object, nil))); count != 1 {
+			t.Errorf("Expected %s to match one quad by value, got %d", object.ToString(), count)
+		}
+		if count := values.Cardinality(nil, nil, object, nil); count != 1 {
+			t.Errorf("Expected a cardinality of 1 for %s, got %d", object.ToString(), count)
+		}
+		if object != one && (terms.Has(quad) || len(slices.Collect(terms.MatchSeq(nil, nil, object, nil))) != 0) {
+			t.Errorf("Expected %s not to be in the store by term", object.ToString())
+		}
+	}
+	if count := len(slices.Collect(values.MatchSeq(nil, nil, NewLiteral("1", "", nil), nil))); count != 1 {
+		t.Errorf("Expected the string and the language string to stay apart, got %d matches", count)
+	}
+	if values.Size() != 3 {
+		t.Errorf("Expected 3 quads, got %d", values.Size())
+	}
+
+	values.RemoveMatches(subject, nil, decimal, nil)
+	quad, _ := NewQuad(subject, predicate, one, NewDefaultGraph())
+	if values.Has(quad) || values.Size() != 2 {
+		t.Errorf("Expected RemoveMatches to remove 1 by value, got %d quads", values.Size())
+	}
+	values.AddQuad(quad)
+	removed, _ := NewQuad(subject, predicate, padded, NewDefaultGraph())
+	values.RemoveQuad(removed)
+	if values.Has(quad) {
+		t.Error("Expected RemoveQuad to remove 1 by value")
+	}
+}
+
+func TestTransaction_ValueEquality(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{Equality: ValueEquality}).(*Store)
+	quads := testQuads(3)
+	store.Import(ArrayToQuadStream(context.Background(), quads).ToIStream())
+
+	transaction := store.Begin()
+	if transaction.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewLiteral("2.0", "", IRI.XSD.Decimal), NewDefaultGraph()) {
+		t.Error("Expected 2.0 to be in the transaction by value")
+	}
+	transaction.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewLiteral("3.0", "", IRI.XSD.Decimal), NewDefaultGraph())
+	transaction.RemoveMatches(nil, nil, NewLiteral("+0", "", IRI.XSD.Integer), nil)
+	if count := len(slices.Collect(transaction.MatchSeq(nil, nil, NewLiteral("3", "", NewNamedNode(xsd.Byte)), nil))); count != 1 {
+		t.Errorf("Expected the added quad to match by value, got %d", count)
+	}
+	if err := transaction.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if store.Has(quads[0]) || !store.Has(quads[1]) || store.Size() != 3 {
+		t.Errorf("Expected the transaction to remove 0 and add 3.0, got %d quads", store.Size())
+	}
+}
+
+func TestSubscription_ValueEquality(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{Equality: ValueEquality}).(*Store)
+	subscription := store.Subscribe(context.Background(), SubscriptionOptions{
+		Object: NewLiteral("1", "", IRI.XSD.Integer),
+	})
+	defer subscription.Close()
+	store.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewLiteral("2", "", IRI.XSD.Integer), NewDefaultGraph())
+	store.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewLiteral("01", "", IRI.XSD.Integer), NewDefaultGraph())
+
+	event := <-subscription.Events()
+	if event.Type != QuadAdded || event.Quad.GetObject().GetValue() != "01" {
+		t.Errorf("Expected the event of \"01\", got %v", event)
+	}
+}
@@ -0,0 +1,362 @@
+package rdfgo
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	geo "github.com/maartyman/rdfgo/lib/geo"
+	xsd "github.com/maartyman/rdfgo/lib/xsd"
+)
+
+var IndexDisabledError = errors.New("index is not enabled")
+
+// RangeBound is an end of a range of literal values for MatchRange.
+// Inclusive makes the range include Value itself.
+type RangeBound struct {
+	Value     interfaces.ILiteral
+	Inclusive bool
+}
+
+// rangeEntry is a quad in a rangeIndex with the value of its object. The key
+// of the quad orders quads with the same value.
+type rangeEntry struct {
+	value xsd.Value
+	key   string
+	entry *storeEntry
+}
+
+func compareRangeEntries(a rangeEntry, b rangeEntry) int {
+	if order := xsd.Order(a.value, b.value); order != 0 {
+		return order
+	}
+	return strings.Compare(a.key, b.key)
+}
+
+// rangeIndex holds the quads of a predicate with a literal object sorted by
+// the value of the object, in sorted chunks like a sortedIndex.
+type rangeIndex struct {
+	chunks [][]rangeEntry
+}
+
+// search returns the chunk and the position of the first entry for which
+// after is true, after being false for a prefix of the entries only. The chunk
+// is len(chunks) when there is no such entry.
+func (x *rangeIndex) search(after func(rangeEntry) bool) (int, int) {
+	chunk := sort.Search(len(x.chunks), func(i int) bool {
+		return after(x.chunks[i][len(x.chunks[i])-1])
+	})
+	if chunk == len(x.chunks) {
+		return chunk, 0
+	}
+	return chunk, sort.Search(len(x.chunks[chunk]), func(i int) bool {
+		return after(x.chunks[chunk][i])
+	})
+}
+
+func (x *rangeIndex) insert(entry rangeEntry) {
+	if len(x.chunks) == 0 {
+		x.chunks = append(x.chunks, []rangeEntry{entry})
+		return
+	}
+	chunk, position := x.search(func(candidate rangeEntry) bool {
+		return compareRangeEntries(candidate, entry) >= 0
+	})
+	if chunk == len(x.chunks) {
+		chunk = len(x.chunks) - 1
+		position = len(x.chunks[chunk])
+	}
+	entries := slices.Insert(x.chunks[chunk], position, entry)
+	x.chunks[chunk] = entries
+	if len(entries) > indexChunkSize {
+		half := len(entries) / 2
+		right := append([]rangeEntry(nil), entries[half:]...)
+		x.chunks[chunk] = entries[:half:half]
+		x.chunks = slices.Insert(x.chunks, chunk+1, right)
+	}
+}
+
+// remove removes an entry and reports whether it was in the index.
+func (x *rangeIndex) remove(entry rangeEntry) bool {
+	chunk, position := x.search(func(candidate rangeEntry) bool {
+		return compareRangeEntries(candidate, entry) >= 0
+	})
+	if chunk == len(x.chunks) || x.chunks[chunk][position].entry != entry.entry {
+		return false
+	}
+	if len(x.chunks[chunk]) == 1 {
+		x.chunks = slices.Delete(x.chunks, chunk, chunk+1)
+	} else {
+		x.chunks[chunk] = slices.Delete(x.chunks[chunk], position, position+1)
+	}
+	return true
+}
+
+// scan appends the entries with a value between the bounds to entries. Nil
+// bounds leave the range open, and the range holds the values of family.
+func (x *rangeIndex) scan(family string, lower *xsd.Value, upper *xsd.Value, bounds [2]*RangeBound, entries []*storeEntry) []*storeEntry {
+	chunk, position := x.search(func(candidate rangeEntry) bool {
+		if lower != nil {
+			return xsd.Order(candidate.value, *lower) >= 0
+		}
+		return family == "" || candidate.value.Family() >= family
+	})
+	for ; chunk < len(x.chunks); chunk, position = chunk+1, 0 {
+		for _, candidate := range x.chunks[chunk][position:] {
+			if family != "" && candidate.value.Family() != family || upper != nil && xsd.Order(candidate.value, *upper) > 0 {
+				return entries
+			}
+			if inRange(candidate.value, lower, bounds[0], 1) && inRange(candidate.value, upper, bounds[1], -1) {
+				entries = append(entries, candidate.entry)
+			}
+		}
+	}
+	return entries
+}
+
+// inRange reports whether value is on the side of a bound given by sign, or
+// the bound itself when it is inclusive.
+func inRange(value xsd.Value, bound *xsd.Value, rangeBound *RangeBound, sign int) bool {
+	if bound == nil {
+		return true
+	}
+	order, ok := xsd.Compare(value, *bound)
+	return ok && (order == sign || order == 0 && rangeBound.Inclusive)
+}
+
+// MatchRange returns the quads with a predicate whose object is a literal with
+// a value from lower to upper, in the order of the values. A nil predicate
+// matches every predicate and a nil bound leaves the range open at that end.
+// Values are compared like xsd.Compare, so a value that can not be compared
+// with a bound is not in the range. The quads are the ones in the store at
+// the time of the call. It fails with IndexDisabledError when the store was
+// not created with a range index.
+func (s *Store) MatchRange(predicate interfaces.ITerm, lower *RangeBound, upper *RangeBound) (iter.Seq[interfaces.IQuad], error) {
+	if !s.options.RangeIndex {
+		return nil, fmt.Errorf("%w: range", IndexDisabledError)
+	}
+	var values [2]*xsd.Value
+	family := ""
+	for i, bound := range []*RangeBound{lower, upper} {
+		if bound == nil {
+			continue
+		}
+		value, err := xsd.ParseLiteral(bound.Value)
+		if err != nil {
+			return nil, err
+		}
+		values[i], family = &value, value.Family()
+	}
+	if values[0] != nil && values[1] != nil && values[0].Family() != values[1].Family() {
+		return entrySeq(nil), nil
+	}
+
+	s.mux.RLock()
+	var entries []*storeEntry
+	if predicate != nil {
+		if index := s.ranges[s.bucketKey(1, predicate)]; index != nil {
+			entries = index.scan(family, values[0], values[1], [2]*RangeBound{lower, upper}, entries)
+		}
+	} else {
+		keys := make([]string, 0, len(s.ranges))
+		for key := range s.ranges {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			entries = s.ranges[key].scan(family, values[0], values[1], [2]*RangeBound{lower, upper}, entries)
+		}
+	}
+	s.mux.RUnlock()
+	return entrySeq(entries), nil
+}
+
+// MatchText returns the quads with a predicate whose object is a string
+// literal that contains every word of query. A nil predicate matches every
+// predicate. Words are runs of letters and digits, lowercased by the rules
+// of the language of their literal, and of language for the query. The quads
+// are the ones in the store at the time of the call. It fails with
+// IndexDisabledError when the store was not created with a text index.
+func (s *Store) MatchText(predicate interfaces.ITerm, query string, language string) (iter.Seq[interfaces.IQuad], error) {
+	if !s.options.TextIndex {
+		return nil, fmt.Errorf("%w: text", IndexDisabledError)
+	}
+	words := textWords(query, language)
+	s.mux.RLock()
+	var postings []map[*storeEntry]string
+	for _, word := range words {
+		postings = append(postings, s.words[word])
+	}
+	slices.SortFunc(postings, func(a map[*storeEntry]string, b map[*storeEntry]string) int {
+		return len(a) - len(b)
+	})
+	type match struct {
+		key   string
+		entry *storeEntry
+	}
+	var matches []match
+	if len(postings) > 0 {
+	candidates:
+		for entry, key := range postings[0] {
+			if predicate != nil && !entry.quad.GetPredicate().Equals(predicate) {
+				continue
+			}
+			for _, posting := range postings[1:] {
+				if _, ok := posting[entry]; !ok {
+					continue candidates
+				}
+			}
+			matches = append(matches, match{key: key, entry: entry})
+		}
+	}
+	s.mux.RUnlock()
+
+	slices.SortFunc(matches, func(a match, b match) int {
+		return strings.Compare(a.key, b.key)
+	})
+	entries := make([]*storeEntry, len(matches))
+	for i, match := range matches {
+		entries[i] = match.entry
+	}
+	return entrySeq(entries), nil
+}
+
+func entrySeq(entries []*storeEntry) iter.Seq[interfaces.IQuad] {
+	return func(yield func(interfaces.IQuad) bool) {
+		for _, entry := range entries {
+			if !yield(entry.quad) {
+				return
+			}
+		}
+	}
+}
+
+// textWords returns the distinct words of a text, lowercased by the rules of
+// a language.
+func textWords(text string, language string) []string {
+	primary, _, _ := strings.Cut(strings.ToLower(language), "-")
+	switch primary {
+	case "tr", "az":
+		text = strings.ToLowerSpecial(unicode.TurkishCase, text)
+	default:
+		text = strings.ToLower(text)
+	}
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r)
+	})
+	slices.Sort(words)
+	return slices.Compact(words)
+}
+
+// isText reports whether a literal is a string, with or without a language.
+func isText(literal interfaces.ILiteral) bool {
+	return literal.GetLanguage() != "" || literal.GetDatatype() == nil || literal.GetDatatype().GetValue() == xsd.String
+}
+
+// indexLiteral adds the object of an entry to the range, text and spatial
+// indexes of the store, or removes it when added is false. The caller has to
+// hold the lock.
+func (s *Store) indexLiteral(entry *storeEntry, hashes []string, added bool) {
+	if !s.options.RangeIndex && !s.options.TextIndex && !s.options.SpatialIndex || entry.quad.GetObject().GetType() != interfaces.LiteralType {
+		return
+	}
+	literal, ok := entry.quad.GetObject().(interfaces.ILiteral)
+	if !ok {
+		return
+	}
+	if s.options.SpatialIndex {
+		s.indexShape(entry, literal, hashes, added)
+	}
+	if s.options.RangeIndex {
+		if value, err := xsd.ParseLiteral(literal); err == nil {
+			s.indexRange(hashes[1], rangeEntry{value: value, key: hashes[4], entry: entry}, added)
+		}
+	}
+	if s.options.TextIndex && isText(literal) {
+		for _, word := range textWords(literal.GetValue(), literal.GetLanguage()) {
+			posting := s.words[word]
+			if added {
+				if posting == nil {
+					posting = make(map[*storeEntry]string)
+					s.words[word] = posting
+					s.memory.Indexes += bucketBytes + int64(len(word))
+				}
+				posting[entry] = hashes[4]
+				s.memory.Indexes += wordBytes
+				continue
+			}
+			if _, ok := posting[entry]; !ok {
+				continue
+			}
+			delete(posting, entry)
+			s.memory.Indexes -= wordBytes
+			if len(posting) == 0 {
+				delete(s.words, word)
+				s.memory.Indexes -= bucketBytes + int64(len(word))
+			}
+		}
+	}
+}
+
+// indexBytes returns the memory that indexing the object of a quad with the
+// given keys would take, like indexLiteral counts it. A wktLiteral is counted
+// even when it is not a valid geometry. The caller has to hold the lock.
+func (s *Store) indexBytes(object interfaces.ITerm, hashes []string) int64 {
+	if !s.options.RangeIndex && !s.options.TextIndex && !s.options.SpatialIndex || object.GetType() != interfaces.LiteralType {
+		return 0
+	}
+	literal, ok := object.(interfaces.ILiteral)
+	if !ok {
+		return 0
+	}
+	bytes := int64(0)
+	if s.options.SpatialIndex && literal.GetDatatype() != nil && literal.GetDatatype().GetValue() == geo.WKTLiteral {
+		bytes += shapeBytes + int64(len(literal.GetValue()))
+		if s.spatial[hashes[1]] == nil {
+			bytes += bucketBytes
+		}
+	}
+	if s.options.RangeIndex {
+		if _, err := xsd.ParseLiteral(literal); err == nil {
+			bytes += rangeBytes
+			if s.ranges[hashes[1]] == nil {
+				bytes += bucketBytes
+			}
+		}
+	}
+	if s.options.TextIndex && isText(literal) {
+		for _, word := range textWords(literal.GetValue(), literal.GetLanguage()) {
+			bytes += wordBytes
+			if s.words[word] == nil {
+				bytes += bucketBytes + int64(len(word))
+			}
+		}
+	}
+	return bytes
+}
+
+func (s *Store) indexRange(predicate string, entry rangeEntry, added bool) {
+	index := s.ranges[predicate]
+	if added {
+		if index == nil {
+			index = &rangeIndex{}
+			s.ranges[predicate] = index
+			s.memory.Indexes += bucketBytes
+		}
+		index.insert(entry)
+		s.memory.Indexes += rangeBytes
+		return
+	}
+	if index != nil && index.remove(entry) {
+		s.memory.Indexes -= rangeBytes
+		if len(index.chunks) == 0 {
+			delete(s.ranges, predicate)
+			s.memory.Indexes -= bucketBytes
+		}
+	}
+}
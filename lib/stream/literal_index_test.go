@@ -0,0 +1,128 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	xsd "github.com/maartyman/rdfgo/lib/xsd"
+)
+
+func objects(t *testing.T, quads iter.Seq[interfaces.IQuad], err error) []string {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result []string
+	for quad := range quads {
+		result = append(result, quad.GetObject().(interfaces.ILiteral).GetValue())
+	}
+	return result
+}
+
+func TestStore_MatchRange(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{RangeIndex: true}).(*Store)
+	age, born := NewNamedNode("age"), NewNamedNode("born")
+	for i := 0; i < 2000; i++ {
+		store.AddQuadFromTerms(NewNamedNode(fmt.Sprint("person", i)), age, NewIntegerLiteral(i%100), NewDefaultGraph())
+	}
+	store.AddQuadFromTerms(NewNamedNode("x"), age, NewLiteral("12.5", "", IRI.XSD.Decimal), NewDefaultGraph())
+	store.AddQuadFromTerms(NewNamedNode("x"), age, NewLiteral("NaN", "", IRI.XSD.Double), NewDefaultGraph())
+	store.AddQuadFromTerms(NewNamedNode("x"), age, NewStringLiteral("12", ""), NewDefaultGraph())
+	for _, date := range []string{"2024-01-01T00:00:00Z", "2024-01-02T00:00:00", "2024-01-01T12:00:00", "2023-12-31T23:00:00-02:00"} {
+		store.AddQuadFromTerms(NewNamedNode(date), born, NewLiteral(date, "", NewNamedNode(xsd.DateTime)), NewDefaultGraph())
+	}
+
+	between, err := store.MatchRange(age, &RangeBound{Value: NewIntegerLiteral(12), Inclusive: true}, &RangeBound{Value: NewLiteral("1.3E1", "", IRI.XSD.Double)})
+	if values := objects(t, between, err); fmt.Sprint(values) != fmt.Sprint(append(repeat("12", 20), "12.5")) {
+		t.Errorf("Expected twenty 12 and 12.5, got %v", values)
+	}
+	above, err := store.MatchRange(nil, &RangeBound{Value: NewIntegerLiteral(98)}, nil)
+	if values := objects(t, above, err); len(values) != 20 || values[0] != "99" {
+		t.Errorf("Expected twenty 99, got %v", values)
+	}
+	below, err := store.MatchRange(age, nil, &RangeBound{Value: NewIntegerLiteral(0), Inclusive: true})
+	if values := objects(t, below, err); len(values) != 20 || values[0] != "0" {
+		t.Errorf("Expected twenty 0 and no NaN, got %v", values)
+	}
+	// A dateTime without a timezone is only ordered against one with a
+	// timezone when they are more than 14 hours apart.
+	after, err := store.MatchRange(born, &RangeBound{Value: NewLiteral("2024-01-01T00:00:00Z", "", NewNamedNode(xsd.DateTime))}, nil)
+	if values := objects(t, after, err); fmt.Sprint(values) != "[2023-12-31T23:00:00-02:00 2024-01-02T00:00:00]" {
+		t.Errorf("Expected the later dateTimes, got %v", values)
+	}
+
+	store.RemoveMatches(nil, age, NewIntegerLiteral(12), nil)
+	between, err = store.MatchRange(age, &RangeBound{Value: NewIntegerLiteral(12), Inclusive: true}, &RangeBound{Value: NewIntegerLiteral(13)})
+	if values := objects(t, between, err); fmt.Sprint(values) != "[12.5]" {
+		t.Errorf("Expected the removed quads to leave the index, got %v", values)
+	}
+	if _, err := store.MatchRange(age, &RangeBound{Value: NewLiteral("x", "", IRI.XSD.Integer)}, nil); !errors.Is(err, xsd.InvalidLexicalFormError) {
+		t.Errorf("Expected an invalid bound, got %v", err)
+	}
+	if _, err := NewStore().(*Store).MatchRange(age, nil, nil); !errors.Is(err, IndexDisabledError) {
+		t.Errorf("Expected IndexDisabledError, got %v", err)
+	}
+}
+
+func repeat(value string, count int) []string {
+	result := make([]string, count)
+	for i := range result {
+		result[i] = value
+	}
+	return result
+}
+
+func TestStore_MatchText(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{TextIndex: true}).(*Store)
+	label, comment := NewNamedNode("label"), NewNamedNode("comment")
+	quads := []interfaces.IQuad{}
+	for _, object := range []interfaces.ITerm{
+		NewStringLiteral("The Quick Brown Fox", "en"),
+		NewStringLiteral("quick-thinking brown dogs", ""),
+		NewStringLiteral("İSTANBUL", "tr"),
+		NewLiteral("quick", "", IRI.XSD.Integer),
+	} {
+		quad, _ := NewQuad(NewNamedNode("s"), label, object, NewDefaultGraph())
+		quads = append(quads, quad)
+	}
+	quad, _ := NewQuad(NewNamedNode("s"), comment, NewStringLiteral("quick", ""), NewDefaultGraph())
+	quads = append(quads, quad)
+	if _, err := store.BulkLoad(context.Background(), ArrayToQuadStream(context.Background(), quads)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		predicate interfaces.ITerm
+		query     string
+		language  string
+		expected  string
+	}{
+		{label, "QUICK brown", "", "[The Quick Brown Fox quick-thinking brown dogs]"},
+		{label, "fox quick", "", "[The Quick Brown Fox]"},
+		{nil, "quick", "", "[quick The Quick Brown Fox quick-thinking brown dogs]"},
+		{label, "istanbul", "", "[İSTANBUL]"},
+		{label, "İstanbul", "tr", "[İSTANBUL]"},
+		{label, "cat", "", "[]"},
+		{label, "", "", "[]"},
+	}
+	for _, test := range tests {
+		matches, err := store.MatchText(test.predicate, test.query, test.language)
+		if values := objects(t, matches, err); fmt.Sprint(values) != test.expected {
+			t.Errorf("Expected %q to match %s, got %v", test.query, test.expected, values)
+		}
+	}
+
+	store.RemoveQuad(quads[0])
+	matches, err := store.MatchText(label, "brown", "")
+	if values := objects(t, matches, err); fmt.Sprint(values) != "[quick-thinking brown dogs]" {
+		t.Errorf("Expected the removed quad to leave the index, got %v", values)
+	}
+	if _, err := NewStore().(*Store).MatchText(nil, "quick", ""); !errors.Is(err, IndexDisabledError) {
+		t.Errorf("Expected IndexDisabledError, got %v", err)
+	}
+}
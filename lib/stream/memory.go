@@ -0,0 +1,127 @@
+package rdfgo
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+var QuadLimitError = errors.New("store quad limit exceeded")
+var ByteLimitError = errors.New("store byte limit exceeded")
+
+// StoreOptions configures NewStoreWithOptions. MaxQuads and MaxBytes limit
+// the number of quads in the store and its memory usage as reported by
+// Memory, zero means no limit. Adding quads that would exceed a limit fails
+// with a LimitError. Equality selects whether objects are matched by term or
+// by value. RangeIndex, TextIndex and SpatialIndex keep the indexes of
+// MatchRange, MatchText and MatchIntersects and MatchWithin.
+type StoreOptions struct {
+	MaxQuads     int
+	MaxBytes     int64
+	Equality     Equality
+	RangeIndex   bool
+	TextIndex    bool
+	SpatialIndex bool
+}
+
+// LimitError is returned for a write that was rejected because the store
+// would have held Quads quads and Bytes bytes afterwards. Err is
+// QuadLimitError or ByteLimitError.
+type LimitError struct {
+	Quads int
+	Bytes int64
+	Err   error
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v: %d quads, %d bytes", e.Err, e.Quads, e.Bytes)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
+
+// StoreMemory is an estimate of the memory a Store uses for the values of the
+// terms of its quads, for its entries and quads, for its index buckets and
+// their keys, for the slices that list the entries of the buckets, and for
+// its range, text and spatial indexes.
+type StoreMemory struct {
+	Terms   int64
+	Entries int64
+	Buckets int64
+	Slices  int64
+	Indexes int64
+}
+
+func (m StoreMemory) Total() int64 {
+	return m.Terms + m.Entries + m.Buckets + m.Slices + m.Indexes
+}
+
+const (
+	// termBytes is the size of a term besides its value, and entryBytes the
+	// size of an entry with its quad besides its terms.
+	termBytes  = 48
+	entryBytes = int64(unsafe.Sizeof(storeEntry{})) + 64
+	// bucketBytes is the size of a bucket with its map slot, besides its key.
+	bucketBytes = int64(unsafe.Sizeof(storeBucket{})) + 48
+	// slotBytes is the size of an entry in the list of a bucket. Every entry
+	// is in five buckets and in the list of all entries.
+	slotBytes = 6 * int64(unsafe.Sizeof(&storeEntry{}))
+	// rangeBytes is the size of an entry in a range index with its value,
+	// wordBytes of an entry in the posting of a word, and shapeBytes of a
+	// geometry in the spatial index besides its coordinates, which are
+	// counted as the length of its literal.
+	rangeBytes = int64(unsafe.Sizeof(rangeEntry{})) + 32
+	wordBytes  = 48
+	shapeBytes = int64(unsafe.Sizeof(spatialShape{})) + 96
+)
+
+// Memory returns an estimate of the memory the store uses. It is kept up to
+// date on every change and does not count the quads that are only kept for
+// open transactions.
+func (s *Store) Memory() StoreMemory {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.memory
+}
+
+// quadBytes returns the memory of the terms of a quad with the given key.
+func quadBytes(key string) int64 {
+	return int64(len(key)) + 4*termBytes
+}
+
+// cost returns the bytes that adding a quad with the given keys takes. The
+// caller has to hold the lock.
+func (s *Store) cost(quad interfaces.IQuad, hashes []string) int64 {
+	bytes := quadBytes(hashes[4]) + entryBytes + slotBytes + s.indexBytes(quad.GetObject(), hashes)
+	for _, hash := range hashes {
+		if s.entries[hash] == nil {
+			bytes += bucketBytes + int64(len(hash))
+		}
+	}
+	return bytes
+}
+
+// checkLimits returns a LimitError when the store would exceed a limit after
+// adding quads that take bytes. The caller has to hold the lock.
+func (s *Store) checkLimits(quads int, bytes int64) error {
+	quads += s.size
+	bytes += s.memory.Total()
+	if s.options.MaxQuads > 0 && quads > s.options.MaxQuads {
+		return &LimitError{Quads: quads, Bytes: bytes, Err: QuadLimitError}
+	}
+	if s.options.MaxBytes > 0 && bytes > s.options.MaxBytes {
+		return &LimitError{Quads: quads, Bytes: bytes, Err: ByteLimitError}
+	}
+	return nil
+}
+
+// account adds the memory of a quad with the given key, or subtracts it when
+// sign is -1. The caller has to hold the lock.
+func (s *Store) account(key string, sign int64) {
+	s.memory.Terms += sign * quadBytes(key)
+	s.memory.Entries += sign * entryBytes
+	s.memory.Slices += sign * slotBytes
+}
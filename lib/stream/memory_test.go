@@ -0,0 +1,167 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	geo "github.com/maartyman/rdfgo/lib/geo"
+	xsd "github.com/maartyman/rdfgo/lib/xsd"
+)
+
+func TestStore_Memory(t *testing.T) {
+	store := NewStore().(*Store)
+	if memory := store.Memory(); memory.Total() != 0 {
+		t.Errorf("Expected an empty store to use no memory, got %+v", memory)
+	}
+	quads := testQuads(100)
+	for _, quad := range quads[:50] {
+		store.AddQuad(quad)
+	}
+	half := store.Memory()
+	for _, quad := range quads[50:] {
+		store.AddQuad(quad)
+	}
+	full := store.Memory()
+	if half.Terms <= 0 || half.Entries <= 0 || half.Buckets <= 0 || half.Slices <= 0 || full.Total() <= half.Total() {
+		t.Errorf("Expected the memory to grow with the quads, got %+v and %+v", half, full)
+	}
+	if full.Entries != 2*half.Entries {
+		t.Errorf("Expected twice the entries for twice the quads, got %d and %d", half.Entries, full.Entries)
+	}
+
+	store.RemoveMatches(nil, nil, nil, nil)
+	if memory := store.Memory(); memory.Total() != 0 {
+		t.Errorf("Expected an emptied store to use no memory, got %+v", memory)
+	}
+}
+
+func TestStore_MemoryOfIndexes(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{RangeIndex: true, TextIndex: true, SpatialIndex: true}).(*Store)
+	wkt := NewNamedNode(geo.WKTLiteral)
+	objects := []interfaces.ITerm{
+		NewStringLiteral("the quick brown fox", "en"),
+		NewStringLiteral("the lazy dog", "en"),
+		NewLiteral("42", "", NewNamedNode(xsd.Integer)),
+		NewLiteral("POINT (1 2)", "", wkt),
+		NewLiteral("not a geometry", "", wkt),
+		NewNamedNode("http://example.com/o"),
+	}
+	for i, object := range objects {
+		quad, _ := NewQuad(NewNamedNode(fmt.Sprintf("http://example.com/s%d", i)), NewNamedNode("http://example.com/p"), object, NewDefaultGraph())
+		hashes := store.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+		before := store.Memory()
+		expected := store.cost(quad, hashes)
+		store.AddQuad(quad)
+		got := store.Memory().Total() - before.Total()
+		if object.GetValue() == "not a geometry" {
+			expected -= shapeBytes + int64(len(object.GetValue()))
+		}
+		if got != expected {
+			t.Errorf("Expected adding %s to take %d bytes, got %d", object.ToString(), expected, got)
+		}
+	}
+	if store.Memory().Indexes <= 0 {
+		t.Errorf("Expected the indexes to use memory, got %+v", store.Memory())
+	}
+
+	store.RemoveMatches(nil, nil, nil, nil)
+	if memory := store.Memory(); memory.Total() != 0 {
+		t.Errorf("Expected an emptied store to use no memory, got %+v", memory)
+	}
+}
+
+func TestStore_QuadLimit(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{MaxQuads: 10}).(*Store)
+	quads := testQuads(12)
+	err := store.ImportContext(context.Background(), ArrayToQuadStream(context.Background(), quads))
+	if store.Size() != 10 || store.Has(quads[10]) || store.Has(quads[11]) {
+		t.Errorf("Expected the store to stop at 10 quads, got %d", store.Size())
+	}
+	var limitError *LimitError
+	if !errors.Is(err, QuadLimitError) || !errors.As(err, &limitError) || limitError.Quads != 11 {
+		t.Errorf("Expected a quad limit error for 11 quads, got %v", err)
+	}
+
+	if added, err := store.TryAddQuad(quads[0]); added || err != nil {
+		t.Errorf("Expected a duplicate not to be rejected by the limit, got %v", err)
+	}
+	store.RemoveQuad(quads[0])
+	if added, err := store.TryAddQuad(quads[10]); !added || err != nil {
+		t.Errorf("Expected a quad to fit after a removal, got %v", err)
+	}
+	store.RemoveQuad(quads[10])
+	store.Import(ArrayToQuadStream(context.Background(), quads[10:]).ToIStream())
+	if store.Size() != 10 || !store.Has(quads[10]) || store.Has(quads[11]) {
+		t.Errorf("Expected Import to stop at the limit, got %d quads", store.Size())
+	}
+}
+
+func TestStore_LimitErrorPerWrite(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{MaxQuads: 50}).(*Store)
+	quads := testQuads(200)
+	var wait sync.WaitGroup
+	var rejected atomic.Int64
+	for worker := 0; worker < 4; worker++ {
+		wait.Add(1)
+		go func(quads []interfaces.IQuad) {
+			defer wait.Done()
+			for _, quad := range quads {
+				added, err := store.TryAddQuad(quad)
+				if added == (err != nil) {
+					t.Errorf("Expected a quad to be added or rejected with an error, got %t and %v", added, err)
+				}
+				if err != nil {
+					rejected.Add(1)
+				}
+			}
+		}(quads[worker*50 : (worker+1)*50])
+	}
+	wait.Wait()
+	if store.Size() != 50 || rejected.Load() != 150 {
+		t.Errorf("Expected 50 quads and 150 errors, got %d and %d", store.Size(), rejected.Load())
+	}
+}
+
+func TestStore_ByteLimit(t *testing.T) {
+	quads := testQuads(6)
+	measured := NewStore().(*Store)
+	for _, quad := range quads[:5] {
+		measured.AddQuad(quad)
+	}
+	store := NewStoreWithOptions(StoreOptions{MaxBytes: measured.Memory().Total()}).(*Store)
+	for _, quad := range quads[:5] {
+		if added, err := store.TryAddQuad(quad); !added {
+			t.Fatalf("Expected %s to fit, got %v", quad.ToString(), err)
+		}
+	}
+	if added, err := store.TryAddQuad(quads[5]); added || !errors.Is(err, ByteLimitError) {
+		t.Errorf("Expected a byte limit error, got %v", err)
+	}
+	if store.Memory() != measured.Memory() {
+		t.Errorf("Expected a rejected quad not to use memory, got %+v", store.Memory())
+	}
+}
+
+func TestStore_LimitOnCommitAndBulkLoad(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{MaxQuads: 100}).(*Store)
+	quads := testQuads(150)
+	transaction := store.Begin()
+	for _, quad := range quads {
+		transaction.AddQuad(quad)
+	}
+	if err := transaction.Commit(); !errors.Is(err, QuadLimitError) || store.Size() != 0 {
+		t.Errorf("Expected the commit to be rejected as a whole, got %v and %d quads", err, store.Size())
+	}
+
+	progress, err := store.BulkLoad(context.Background(), ArrayToQuadStream(context.Background(), quads), BulkLoadOptions{BatchSize: 64})
+	expected := BulkLoadProgress{Read: 128, Added: 100}
+	if !errors.Is(err, QuadLimitError) || store.Size() != 100 || progress != expected {
+		t.Errorf("Expected the load to stop at 100 quads, got %v, %d quads and %+v", err, store.Size(), progress)
+	}
+}
@@ -0,0 +1,325 @@
+package rdfgo
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+// permutation lists the quad positions (0 subject, 1 predicate, 2 object,
+// 3 graph) in the order they are sorted in an index.
+type permutation [4]int
+
+// permutations are chosen so that every set of bound positions is a prefix
+// of one of them.
+var permutations = []permutation{
+	{0, 1, 2, 3}, // SPOG
+	{1, 2, 3, 0}, // POGS
+	{2, 3, 0, 1}, // OGSP
+	{3, 0, 1, 2}, // GSPO
+	{3, 1, 0, 2}, // GPSO
+	{2, 0, 1, 3}, // OSPG
+}
+
+// permutationForMask maps a bit set of bound positions to the index of the
+// permutation that starts with exactly those positions.
+var permutationForMask = func() [16]int {
+	var table [16]int
+	for mask := range table {
+		bound := 0
+		for position := 0; position < 4; position++ {
+			if mask&(1<<position) != 0 {
+				bound++
+			}
+		}
+		for i, order := range permutations {
+			prefix := 0
+			for _, position := range order[:bound] {
+				prefix |= 1 << position
+			}
+			if prefix == mask {
+				table[mask] = i
+				break
+			}
+		}
+	}
+	return table
+}()
+
+func (p permutation) apply(ids quadIDs) quadIDs {
+	return quadIDs{ids[p[0]], ids[p[1]], ids[p[2]], ids[p[3]]}
+}
+
+func (p permutation) revert(key quadIDs) quadIDs {
+	var ids quadIDs
+	for i, position := range p {
+		ids[position] = key[i]
+	}
+	return ids
+}
+
+func (p permutation) String() string {
+	name := make([]byte, 4)
+	for i, position := range p {
+		name[i] = "SPOG"[position]
+	}
+	return string(name)
+}
+
+// PermutationStore keeps every quad in six sorted indexes over term IDs, one
+// per permutation of the quad positions. Any combination of bound positions
+// is answered with a range scan over the index that starts with them, and
+// the results come out sorted by that index.
+//
+// Unlike Store, a match is not a snapshot. MatchSeq, Match and ForEach read
+// the index in batches and release the lock between them, so writes made
+// during an iteration may or may not be seen: a quad added ahead of the
+// current position is returned and a quad removed ahead of it is not. Every
+// quad that is in the store for the whole iteration is returned exactly once.
+type PermutationStore struct {
+	dictionary *termDictionary
+	indexes    [6]sortedIndex
+	mux        sync.RWMutex
+}
+
+func NewPermutationStore() IStore {
+	return &PermutationStore{
+		dictionary: newTermDictionary(),
+	}
+}
+
+func (s *PermutationStore) Size() int {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.indexes[0].size
+}
+
+func (s *PermutationStore) Has(quad interfaces.IQuad) bool {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	ids, ok := s.dictionary.lookupQuad(quad)
+	return ok && s.indexes[0].has(ids)
+}
+
+func (s *PermutationStore) AddQuadFromTerms(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) bool {
+	graph, ok := checkQuadTerms(subject, predicate, object, graph)
+	if !ok {
+		return false
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ids := quadIDs{
+		s.dictionary.intern(subject),
+		s.dictionary.intern(predicate),
+		s.dictionary.intern(object),
+		s.dictionary.intern(graph),
+	}
+	if !s.indexes[0].insert(ids) {
+		return false
+	}
+	for i := 1; i < len(s.indexes); i++ {
+		s.indexes[i].insert(permutations[i].apply(ids))
+	}
+	return true
+}
+
+func (s *PermutationStore) AddQuad(quad interfaces.IQuad) bool {
+	return s.AddQuadFromTerms(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+}
+
+func (s *PermutationStore) RemoveQuad(quad interfaces.IQuad) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ids, ok := s.dictionary.lookupQuad(quad)
+	if ok {
+		s.removeIDs(ids)
+	}
+}
+
+func (s *PermutationStore) removeIDs(ids quadIDs) {
+	if !s.indexes[0].remove(ids) {
+		return
+	}
+	for i := 1; i < len(s.indexes); i++ {
+		s.indexes[i].remove(permutations[i].apply(ids))
+	}
+}
+
+func (s *PermutationStore) RemoveMatches(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	index, from, prefix, ok := s.rangeFor(subject, predicate, object, graph)
+	if !ok {
+		return
+	}
+	keys := s.indexes[index].scan(from, true, prefix, s.indexes[index].size, nil)
+	for _, key := range keys {
+		s.removeIDs(permutations[index].revert(key))
+	}
+}
+
+func (s *PermutationStore) Remove(stream interfaces.IStream) {
+	for quad := range stream {
+		if quad != nil {
+			s.RemoveQuad(quad)
+		}
+	}
+}
+
+func (s *PermutationStore) DeleteGraph(graph interfaces.ITerm) {
+	s.RemoveMatches(nil, nil, nil, graph)
+}
+
+// rangeFor returns the index to scan for a pattern, the key to start the scan
+// at and the number of bound positions at the start of that key. It returns
+// false when a bound term is not in the dictionary. The caller has to hold
+// the lock.
+func (s *PermutationStore) rangeFor(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) (int, quadIDs, int, bool) {
+	return patternRange(s.dictionary, subject, predicate, object, graph)
+}
+
+func patternRange(
+	dictionary *termDictionary,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) (int, quadIDs, int, bool) {
+	subject, predicate, object, graph = convertVariablesToNil(subject, predicate, object, graph)
+	var pattern quadIDs
+	mask, prefix := 0, 0
+	for i, term := range [4]interfaces.ITerm{subject, predicate, object, graph} {
+		if term == nil {
+			continue
+		}
+		id, ok := dictionary.lookup(term)
+		if !ok {
+			return 0, quadIDs{}, 0, false
+		}
+		pattern[i] = id
+		mask |= 1 << i
+		prefix++
+	}
+	index := permutationForMask[mask]
+	return index, permutations[index].apply(pattern), prefix, true
+}
+
+// Order returns the order, such as "POGS", in which the quads that match a
+// pattern are returned. Bound positions come first, so two matches that bind
+// the same positions can be merge joined on the next position.
+func (s *PermutationStore) Order(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) string {
+	subject, predicate, object, graph = convertVariablesToNil(subject, predicate, object, graph)
+	mask := 0
+	for i, term := range [4]interfaces.ITerm{subject, predicate, object, graph} {
+		if term != nil {
+			mask |= 1 << i
+		}
+	}
+	return permutations[permutationForMask[mask]].String()
+}
+
+// MatchSeq returns the matching quads as an iterator, sorted by term ID in
+// the order returned by Order. The index is scanned in batches and the lock
+// is released while the loop body runs, so the body may modify the store.
+func (s *PermutationStore) MatchSeq(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) iter.Seq[interfaces.IQuad] {
+	return func(yield func(interfaces.IQuad) bool) {
+		s.mux.RLock()
+		index, from, prefix, ok := s.rangeFor(subject, predicate, object, graph)
+		s.mux.RUnlock()
+		if !ok {
+			return
+		}
+
+		keys := make([]quadIDs, 0, materializeBatch)
+		var batch [materializeBatch]interfaces.IQuad
+		inclusive := true
+		for {
+			s.mux.RLock()
+			keys = s.indexes[index].scan(from, inclusive, prefix, materializeBatch, keys[:0])
+			quads := batch[:len(keys)]
+			for i, key := range keys {
+				quads[i] = s.dictionary.quad(permutations[index].revert(key))
+			}
+			s.mux.RUnlock()
+
+			for _, quad := range quads {
+				if !yield(quad) {
+					return
+				}
+			}
+			if len(keys) < materializeBatch {
+				return
+			}
+			from, inclusive = keys[len(keys)-1], false
+		}
+	}
+}
+
+func (s *PermutationStore) MatchContext(
+	ctx context.Context,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) *QuadStream {
+	quads := s.MatchSeq(subject, predicate, object, graph)
+	return NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		for quad := range quads {
+			if !emit(quad) {
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
+func (s *PermutationStore) Match(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) interfaces.IStream {
+	return s.MatchContext(context.Background(), subject, predicate, object, graph).ToIStream()
+}
+
+func (s *PermutationStore) Import(quadStream interfaces.IStream) {
+	for quad := range quadStream {
+		if quad != nil {
+			s.AddQuad(quad)
+		}
+	}
+}
+
+func (s *PermutationStore) ForEach(callback func(interfaces.IQuad)) {
+	for quad := range s.MatchSeq(nil, nil, nil, nil) {
+		callback(quad)
+	}
+}
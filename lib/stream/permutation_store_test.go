@@ -0,0 +1,187 @@
+package rdfgo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func TestPermutations(t *testing.T) {
+	for mask, index := range permutationForMask {
+		prefix := 0
+		bound := 0
+		for position := 0; position < 4; position++ {
+			if mask&(1<<position) != 0 {
+				bound++
+			}
+		}
+		for _, position := range permutations[index][:bound] {
+			prefix |= 1 << position
+		}
+		if prefix != mask {
+			t.Errorf("Expected a permutation starting with the positions in %04b, got %s", mask, permutations[index])
+		}
+	}
+	ids := quadIDs{1, 2, 3, 4}
+	for _, order := range permutations {
+		if order.revert(order.apply(ids)) != ids {
+			t.Errorf("Expected %s to revert to the original order", order)
+		}
+	}
+}
+
+func TestPermutationStore_SameAsStore(t *testing.T) {
+	testSameAsStore(t, NewPermutationStore)
+}
+
+func TestPermutationStore_AddQuadFromTerms(t *testing.T) {
+	store := NewPermutationStore()
+	if store.AddQuadFromTerms(nil, nil, nil, nil) {
+		t.Errorf("Expected nil terms to be rejected")
+	}
+	if !store.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil) {
+		t.Errorf("Expected the quad to be added")
+	}
+	if store.AddQuadFromTerms(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), NewDefaultGraph()) {
+		t.Errorf("Expected a duplicate quad to be rejected")
+	}
+	quad, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewNamedNode("o"), nil)
+	if store.Size() != 1 || !store.Has(quad) {
+		t.Errorf("Expected the store to contain only %s", quad.ToString())
+	}
+	store.RemoveQuad(quad)
+	store.RemoveQuad(quad)
+	if store.Size() != 0 || store.Has(quad) {
+		t.Errorf("Expected the store to be empty")
+	}
+}
+
+func TestPermutationStore_Order(t *testing.T) {
+	store := NewPermutationStore().(*PermutationStore)
+	rdfType := NewNamedNode("http://www.w3.org/1999/02/22-rdf-syntax-ns#type")
+	person := NewNamedNode("http://example.com/Person")
+	for i := 20; i > 0; i-- {
+		store.AddQuadFromTerms(NewNamedNode("http://example.com/person/"+strconv.Itoa(i)), rdfType, person, nil)
+		store.AddQuadFromTerms(NewNamedNode("http://example.com/person/"+strconv.Itoa(i)), NewNamedNode("http://example.com/age"), NewIntegerLiteral(i), nil)
+	}
+
+	if order := store.Order(nil, rdfType, person, nil); order != "POGS" {
+		t.Errorf("Expected POGS, got %s", order)
+	}
+	if order := store.Order(NewVariable("s"), nil, nil, NewNamedNode("g")); order != "GSPO" {
+		t.Errorf("Expected GSPO, got %s", order)
+	}
+
+	var subjects []string
+	for quad := range store.MatchSeq(nil, rdfType, person, nil) {
+		subjects = append(subjects, quad.GetSubject().GetValue())
+	}
+	if len(subjects) != 20 {
+		t.Fatalf("Expected 20 matches, got %d", len(subjects))
+	}
+	// Term IDs follow insertion order, so the subjects come out in that order.
+	for i, subject := range subjects {
+		if subject != "http://example.com/person/"+strconv.Itoa(20-i) {
+			t.Errorf("Expected the matches to be sorted by term ID, got %v", subjects)
+			break
+		}
+	}
+}
+
+func TestPermutationStore_RemoveDuringIteration(t *testing.T) {
+	store := NewPermutationStore()
+	for _, quad := range testQuads(1000) {
+		store.AddQuad(quad)
+	}
+	count := 0
+	store.ForEach(func(quad interfaces.IQuad) {
+		store.RemoveQuad(quad)
+		count++
+	})
+	if store.Size() != 0 || count != 1000 {
+		t.Errorf("Expected all 1000 quads to be visited and removed, got %d visited and %d left", count, store.Size())
+	}
+
+	for _, quad := range testQuads(1000) {
+		store.AddQuad(quad)
+	}
+	store.RemoveMatches(nil, NewNamedNode("p"), nil, nil)
+	store.RemoveMatches(NewNamedNode("missing"), nil, nil, nil)
+	if store.Size() != 0 {
+		t.Errorf("Expected the store to be empty, got %d quads", store.Size())
+	}
+	if Stream(store.Match(nil, nil, nil, nil)).Count() != 0 {
+		t.Errorf("Expected no matches in an empty store")
+	}
+}
+
+func TestPermutationStore_Import(t *testing.T) {
+	store := NewPermutationStore()
+	store.Import(ArrayToStream(append(testQuads(10), nil)).ToIStream())
+	if store.Size() != 10 {
+		t.Errorf("Expected 10 quads, got %d", store.Size())
+	}
+	store.Remove(ArrayToStream(append(testQuads(5), nil)).ToIStream())
+	store.DeleteGraph(NewNamedNode("g"))
+	if store.Size() != 5 {
+		t.Errorf("Expected 5 quads, got %d", store.Size())
+	}
+	count := 0
+	for range store.MatchSeq(nil, nil, nil, nil) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Expected the loop to stop after 1 quad, got %d", count)
+	}
+}
+
+// benchmarkClassExtent matches (?s rdf:type :Person) for a small class in a
+// store where rdf:type and :Person are both used by many other quads, so
+// every single position bucket is large.
+func benchmarkClassExtent(b *testing.B, newStore func() IStore) {
+	store := newStore()
+	rdfType := NewNamedNode("http://www.w3.org/1999/02/22-rdf-syntax-ns#type")
+	person := NewNamedNode("http://example.com/Person")
+	document := NewNamedNode("http://example.com/Document")
+	for i := 0; i < 20000; i++ {
+		subject := NewNamedNode("http://example.com/" + strconv.Itoa(i))
+		if i%1000 == 0 {
+			store.AddQuadFromTerms(subject, rdfType, person, nil)
+		} else {
+			store.AddQuadFromTerms(subject, rdfType, document, nil)
+		}
+		store.AddQuadFromTerms(subject, NewNamedNode("http://example.com/mentions"), person, nil)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range store.MatchSeq(nil, rdfType, person, nil) {
+		}
+	}
+}
+
+func BenchmarkStore_ClassExtent(b *testing.B) {
+	benchmarkClassExtent(b, NewStore)
+}
+
+func BenchmarkDictionaryStore_ClassExtent(b *testing.B) {
+	benchmarkClassExtent(b, NewDictionaryStore)
+}
+
+func BenchmarkPermutationStore_ClassExtent(b *testing.B) {
+	benchmarkClassExtent(b, NewPermutationStore)
+}
+
+func BenchmarkPermutationStore_Memory(b *testing.B) {
+	benchmarkMemory(b, NewPermutationStore)
+}
+
+func BenchmarkPermutationStore_Add(b *testing.B) {
+	benchmarkAdd(b, NewPermutationStore)
+}
+
+func BenchmarkPermutationStore_Has(b *testing.B) {
+	benchmarkHas(b, NewPermutationStore)
+}
@@ -0,0 +1,160 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+const quadStreamBuffer = 10
+
+// Producer writes quads to a QuadStream. Emit returns false once the stream
+// has been cancelled, after which the producer should stop and return.
+type Producer func(ctx context.Context, emit func(interfaces.IQuad) bool) error
+
+// QuadStream runs a producer in its own goroutine. Cancelling the context or
+// calling Close stops the producer the next time it emits a quad, so a
+// consumer that stops reading early does not leak the goroutine.
+type QuadStream struct {
+	quads  interfaces.IStream
+	done   chan struct{}
+	err    error
+	cancel context.CancelFunc
+}
+
+func NewQuadStream(ctx context.Context, producer Producer) *QuadStream {
+	streamContext, cancel := context.WithCancel(ctx)
+	s := &QuadStream{
+		quads:  make(interfaces.IStream, quadStreamBuffer),
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+	emit := func(quad interfaces.IQuad) bool {
+		if streamContext.Err() != nil {
+			return false
+		}
+		select {
+		case s.quads <- quad:
+			return true
+		case <-streamContext.Done():
+			return false
+		}
+	}
+	go func() {
+		err := producer(streamContext, emit)
+		if err == nil || errors.Is(err, context.Canceled) {
+			// Cancellation is only an error when it was not asked for by Close.
+			err = ctx.Err()
+		}
+		s.err = err
+		close(s.done)
+		close(s.quads)
+		cancel()
+	}()
+	return s
+}
+
+// FailedQuadStream returns a stream without quads that ends with err.
+func FailedQuadStream(err error) *QuadStream {
+	return NewQuadStream(context.Background(), func(context.Context, func(interfaces.IQuad) bool) error {
+		return err
+	})
+}
+
+// FromIStream adapts a channel based stream. When the QuadStream is cancelled,
+// the rest of the channel is drained so its producer can finish.
+func FromIStream(ctx context.Context, stream interfaces.IStream) *QuadStream {
+	return NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		for quad := range stream {
+			if !emit(quad) {
+				go func() {
+					for range stream {
+					}
+				}()
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
+func ArrayToQuadStream(ctx context.Context, quads []interfaces.IQuad) *QuadStream {
+	return NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		for _, quad := range quads {
+			if !emit(quad) {
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
+func (s *QuadStream) Quads() <-chan interfaces.IQuad {
+	return s.quads
+}
+
+// Err returns the error that ended the producer. It is nil until Done is
+// closed, which happens before Quads is closed, and nil when the stream was
+// stopped with Close.
+func (s *QuadStream) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+func (s *QuadStream) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *QuadStream) Close() {
+	s.cancel()
+}
+
+// ToIStream returns the underlying channel for APIs that expect an IStream.
+// A consumer of the channel can not cancel the producer, so it has to read
+// the channel until it is closed.
+func (s *QuadStream) ToIStream() interfaces.IStream {
+	return s.quads
+}
+
+func (s *QuadStream) ToArray() ([]interfaces.IQuad, error) {
+	var quadArray []interfaces.IQuad
+	for quad := range s.quads {
+		quadArray = append(quadArray, quad)
+	}
+	return quadArray, s.Err()
+}
+
+func (s *QuadStream) Count() (int, error) {
+	count := 0
+	for range s.quads {
+		count++
+	}
+	return count, s.Err()
+}
+
+func (s *QuadStream) ToStore() (interfaces.IStore, error) {
+	store := NewStore()
+	for quad := range s.quads {
+		store.AddQuad(quad)
+	}
+	return store, s.Err()
+}
+
+// ForEach calls callback for every quad until it returns false, in which case
+// the stream is closed.
+func (s *QuadStream) ForEach(callback func(interfaces.IQuad) bool) error {
+	for quad := range s.quads {
+		if !callback(quad) {
+			s.Close()
+			return nil
+		}
+	}
+	return s.Err()
+}
+
+var _ interfaces.IQuadStream = (*QuadStream)(nil)
@@ -0,0 +1,191 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func testQuads(count int) []interfaces.IQuad {
+	quads := make([]interfaces.IQuad, 0, count)
+	for i := 0; i < count; i++ {
+		quad, _ := NewQuad(NewNamedNode("s"), NewNamedNode("p"), NewIntegerLiteral(i), NewDefaultGraph())
+		quads = append(quads, quad)
+	}
+	return quads
+}
+
+// waitForGoroutines fails when the number of goroutines does not drop back to
+// at most expected.
+func waitForGoroutines(t *testing.T, expected int) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= expected {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Errorf("Expected at most %d goroutines, got %d", expected, runtime.NumGoroutine())
+}
+
+func TestQuadStream(t *testing.T) {
+	quadArray, err := ArrayToQuadStream(context.Background(), testQuads(100)).ToArray()
+	if err != nil || len(quadArray) != 100 {
+		t.Errorf("Expected 100 quads and no error, got %d and %v", len(quadArray), err)
+	}
+
+	count, err := ArrayToQuadStream(context.Background(), testQuads(5)).Count()
+	if err != nil || count != 5 {
+		t.Errorf("Expected 5 quads and no error, got %d and %v", count, err)
+	}
+
+	store, err := ArrayToQuadStream(context.Background(), testQuads(5)).ToStore()
+	if err != nil || store.(IStore).Size() != 5 {
+		t.Errorf("Expected a store with 5 quads and no error, got %v", err)
+	}
+
+	count = Stream(ArrayToQuadStream(context.Background(), testQuads(3)).ToIStream()).Count()
+	if count != 3 {
+		t.Errorf("Expected 3 quads, got %d", count)
+	}
+}
+
+func TestQuadStream_Err(t *testing.T) {
+	producerError := errors.New("producer failed")
+	quadStream := NewQuadStream(context.Background(), func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		emit(testQuads(1)[0])
+		return producerError
+	})
+	if quadStream.Err() != nil {
+		select {
+		case <-quadStream.Done():
+		default:
+			t.Errorf("Expected no error before the stream is done")
+		}
+	}
+	quadArray, err := quadStream.ToArray()
+	if len(quadArray) != 1 || !errors.Is(err, producerError) {
+		t.Errorf("Expected 1 quad and the producer error, got %d and %v", len(quadArray), err)
+	}
+
+	_, err = FailedQuadStream(producerError).Count()
+	if !errors.Is(err, producerError) {
+		t.Errorf("Expected the producer error, got %v", err)
+	}
+}
+
+func TestQuadStream_Close(t *testing.T) {
+	before := runtime.NumGoroutine()
+	quadStream := ArrayToQuadStream(context.Background(), testQuads(1000))
+	<-quadStream.Quads()
+	quadStream.Close()
+	<-quadStream.Done()
+	if err := quadStream.Err(); err != nil {
+		t.Errorf("Expected no error after Close, got %v", err)
+	}
+	waitForGoroutines(t, before)
+}
+
+func TestQuadStream_Cancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	quadStream := ArrayToQuadStream(ctx, testQuads(1000))
+	<-quadStream.Quads()
+	cancel()
+	count, err := quadStream.Count()
+	if count >= 999 || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the stream to stop with context.Canceled, got %d and %v", count, err)
+	}
+	waitForGoroutines(t, before)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if _, err := ArrayToQuadStream(ctx, testQuads(10)).Count(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestQuadStream_ForEach(t *testing.T) {
+	before := runtime.NumGoroutine()
+	count := 0
+	err := ArrayToQuadStream(context.Background(), testQuads(1000)).ForEach(func(interfaces.IQuad) bool {
+		count++
+		return count < 3
+	})
+	if err != nil || count != 3 {
+		t.Errorf("Expected 3 quads and no error, got %d and %v", count, err)
+	}
+	waitForGoroutines(t, before)
+
+	count = 0
+	err = ArrayToQuadStream(context.Background(), testQuads(10)).ForEach(func(interfaces.IQuad) bool {
+		count++
+		return true
+	})
+	if err != nil || count != 10 {
+		t.Errorf("Expected 10 quads and no error, got %d and %v", count, err)
+	}
+}
+
+func TestFromIStream(t *testing.T) {
+	before := runtime.NumGoroutine()
+	legacy := ArrayToStream(testQuads(1000))
+	quadStream := legacy.ToQuadStream(context.Background())
+	<-quadStream.Quads()
+	quadStream.Close()
+	for range quadStream.Quads() {
+	}
+	waitForGoroutines(t, before)
+
+	quadArray, err := FromIStream(context.Background(), ArrayToStream(testQuads(10)).ToIStream()).ToArray()
+	if err != nil || len(quadArray) != 10 {
+		t.Errorf("Expected 10 quads and no error, got %d and %v", len(quadArray), err)
+	}
+}
+
+func TestStream_ImportContext(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := NewStream()
+	stream.ImportContext(ctx, ArrayToQuadStream(context.Background(), testQuads(1000)))
+	<-stream
+	cancel()
+	count := 0
+	for range stream {
+		count++
+	}
+	if count >= 999 {
+		t.Errorf("Expected the import to stop, got %d quads", count)
+	}
+	waitForGoroutines(t, before)
+}
+
+func TestStore_MatchContext(t *testing.T) {
+	store := ArrayToStream(testQuads(100)).ToStore().(IStore)
+	patterns := [][4]interfaces.ITerm{
+		{nil, nil, nil, nil},
+		{NewNamedNode("s"), nil, nil, nil},
+		{nil, NewNamedNode("p"), nil, nil},
+	}
+	for _, pattern := range patterns {
+		before := runtime.NumGoroutine()
+		quadStream := store.MatchContext(context.Background(), pattern[0], pattern[1], pattern[2], pattern[3])
+		<-quadStream.Quads()
+		quadStream.Close()
+		<-quadStream.Done()
+		waitForGoroutines(t, before)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	quad := testQuads(1)[0]
+	_, err := store.MatchContext(ctx, quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph()).Count()
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package rdfgo
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+// Seq returns an iterator over the quads of the stream. When the loop exits
+// early, the rest of the stream is drained in the background so its producer
+// can finish.
+func (s Stream) Seq() iter.Seq[interfaces.IQuad] {
+	return func(yield func(interfaces.IQuad) bool) {
+		for quad := range s {
+			if !yield(quad) {
+				go func() {
+					for range s {
+					}
+				}()
+				return
+			}
+		}
+	}
+}
+
+// ForEach calls callback for every quad in the stream until it returns false.
+func (s Stream) ForEach(callback func(interfaces.IQuad) bool) {
+	for quad := range s.Seq() {
+		if !callback(quad) {
+			return
+		}
+	}
+}
+
+// Seq returns an iterator over the quads of the stream that closes the
+// stream when the loop exits early.
+func (s *QuadStream) Seq() iter.Seq[interfaces.IQuad] {
+	return func(yield func(interfaces.IQuad) bool) {
+		for quad := range s.quads {
+			if !yield(quad) {
+				s.Close()
+				return
+			}
+		}
+	}
+}
+
+func ArrayToSeq(quads []interfaces.IQuad) iter.Seq[interfaces.IQuad] {
+	return slices.Values(quads)
+}
+
+// SeqToStream runs seq in a goroutine for APIs that expect a channel.
+func SeqToStream(seq iter.Seq[interfaces.IQuad]) Stream {
+	channel := make(Stream, quadStreamBuffer)
+	go func() {
+		for quad := range seq {
+			channel <- quad
+		}
+		close(channel)
+	}()
+	return channel
+}
+
+func SeqToArray(seq iter.Seq[interfaces.IQuad]) []interfaces.IQuad {
+	return slices.Collect(seq)
+}
+
+func SeqToStore(seq iter.Seq[interfaces.IQuad]) interfaces.IStore {
+	store := NewStore()
+	for quad := range seq {
+		store.AddQuad(quad)
+	}
+	return store
+}
+
+func SeqCount(seq iter.Seq[interfaces.IQuad]) int {
+	count := 0
+	for range seq {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,163 @@
+package rdfgo
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func TestStream_Seq(t *testing.T) {
+	count := 0
+	for range ArrayToStream(testQuads(10)).Seq() {
+		count++
+	}
+	if count != 10 {
+		t.Errorf("Expected 10 quads, got %d", count)
+	}
+
+	before := runtime.NumGoroutine()
+	for range ArrayToStream(testQuads(1000)).Seq() {
+		break
+	}
+	waitForGoroutines(t, before)
+}
+
+func TestStream_ForEach(t *testing.T) {
+	count := 0
+	ArrayToStream(testQuads(10)).ForEach(func(interfaces.IQuad) bool {
+		count++
+		return count < 4
+	})
+	if count != 4 {
+		t.Errorf("Expected 4 quads, got %d", count)
+	}
+
+	count = 0
+	ArrayToStream(testQuads(10)).ForEach(func(interfaces.IQuad) bool {
+		count++
+		return true
+	})
+	if count != 10 {
+		t.Errorf("Expected 10 quads, got %d", count)
+	}
+}
+
+func TestQuadStream_Seq(t *testing.T) {
+	before := runtime.NumGoroutine()
+	quadStream := ArrayToQuadStream(context.Background(), testQuads(1000))
+	count := 0
+	for range quadStream.Seq() {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+	<-quadStream.Done()
+	if quadStream.Err() != nil {
+		t.Errorf("Expected no error after an early exit, got %v", quadStream.Err())
+	}
+	waitForGoroutines(t, before)
+
+	if SeqCount(ArrayToQuadStream(context.Background(), testQuads(7)).Seq()) != 7 {
+		t.Errorf("Expected 7 quads")
+	}
+}
+
+func TestSeqHelpers(t *testing.T) {
+	quads := testQuads(5)
+	if len(SeqToArray(ArrayToSeq(quads))) != 5 {
+		t.Errorf("Expected 5 quads in the array")
+	}
+	if SeqCount(ArrayToSeq(quads)) != 5 {
+		t.Errorf("Expected a count of 5")
+	}
+	if SeqToStore(ArrayToSeq(quads)).(IStore).Size() != 5 {
+		t.Errorf("Expected a store with 5 quads")
+	}
+	if SeqToStream(ArrayToSeq(quads)).Count() != 5 {
+		t.Errorf("Expected a stream with 5 quads")
+	}
+}
+
+func TestStore_MatchSeq(t *testing.T) {
+	store := NewStore()
+	store.AddQuadFromTerms(NewNamedNode("s1"), NewNamedNode("p1"), NewNamedNode("o1"), NewNamedNode("g1"))
+	store.AddQuadFromTerms(NewNamedNode("s1"), NewNamedNode("p2"), NewNamedNode("o2"), NewNamedNode("g1"))
+	store.AddQuadFromTerms(NewNamedNode("s2"), NewNamedNode("p1"), NewNamedNode("o1"), NewNamedNode("g2"))
+
+	tests := []struct {
+		name     string
+		pattern  [4]interfaces.ITerm
+		expected int
+	}{
+		{"all", [4]interfaces.ITerm{nil, nil, nil, nil}, 3},
+		{"variables", [4]interfaces.ITerm{NewVariable("s"), nil, nil, NewVariable("g")}, 3},
+		{"subject", [4]interfaces.ITerm{NewNamedNode("s1"), nil, nil, nil}, 2},
+		{"predicate and object", [4]interfaces.ITerm{nil, NewNamedNode("p1"), NewNamedNode("o1"), nil}, 2},
+		{"graph", [4]interfaces.ITerm{nil, nil, nil, NewNamedNode("g2")}, 1},
+		{"fully bound", [4]interfaces.ITerm{NewNamedNode("s1"), NewNamedNode("p2"), NewNamedNode("o2"), NewNamedNode("g1")}, 1},
+		{"fully bound missing", [4]interfaces.ITerm{NewNamedNode("s1"), NewNamedNode("p2"), NewNamedNode("o2"), NewNamedNode("g2")}, 0},
+		{"missing", [4]interfaces.ITerm{NewNamedNode("s3"), nil, nil, nil}, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			count := SeqCount(store.MatchSeq(test.pattern[0], test.pattern[1], test.pattern[2], test.pattern[3]))
+			if count != test.expected {
+				t.Errorf("Expected %d quads, got %d", test.expected, count)
+			}
+			count = Stream(store.Match(test.pattern[0], test.pattern[1], test.pattern[2], test.pattern[3])).Count()
+			if count != test.expected {
+				t.Errorf("Expected Match to return %d quads, got %d", test.expected, count)
+			}
+		})
+	}
+
+	for _, pattern := range [][4]interfaces.ITerm{{nil, nil, nil, nil}, {NewNamedNode("s1"), nil, nil, nil}} {
+		count := 0
+		for range store.MatchSeq(pattern[0], pattern[1], pattern[2], pattern[3]) {
+			count++
+			break
+		}
+		if count != 1 {
+			t.Errorf("Expected the loop to stop after 1 quad, got %d", count)
+		}
+	}
+
+	for quad := range store.MatchSeq(nil, nil, nil, nil) {
+		store.RemoveQuad(quad)
+	}
+	if store.Size() != 0 {
+		t.Errorf("Expected the store to be empty, got %d quads", store.Size())
+	}
+}
+
+func benchmarkStore(size int) IStore {
+	store := NewStore()
+	for _, quad := range testQuads(size) {
+		store.AddQuad(quad)
+	}
+	return store
+}
+
+func BenchmarkStore_Match(b *testing.B) {
+	store := benchmarkStore(100)
+	object := NewIntegerLiteral(42)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range store.Match(nil, nil, object, nil) {
+		}
+	}
+}
+
+func BenchmarkStore_MatchSeq(b *testing.B) {
+	store := benchmarkStore(100)
+	object := NewIntegerLiteral(42)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range store.MatchSeq(nil, nil, object, nil) {
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package rdfgo
+
+import "sort"
+
+// indexChunkSize is the maximum number of keys in a chunk of a sortedIndex.
+// Chunks keep inserts and removals cheap without the pointer overhead of a
+// tree.
+const indexChunkSize = 512
+
+func compareIDs(a quadIDs, b quadIDs) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// sortedIndex is a sorted set of keys, stored as a list of sorted chunks.
+type sortedIndex struct {
+	chunks [][]quadIDs
+	size   int
+}
+
+// lowerBound returns the chunk and the position of the first key that is
+// greater than or equal to key, or greater than key when inclusive is false.
+// The chunk is len(chunks) when there is no such key.
+func (x *sortedIndex) lowerBound(key quadIDs, inclusive bool) (int, int) {
+	after := func(candidate quadIDs) bool {
+		comparison := compareIDs(candidate, key)
+		return comparison > 0 || (inclusive && comparison == 0)
+	}
+	chunk := sort.Search(len(x.chunks), func(i int) bool {
+		return after(x.chunks[i][len(x.chunks[i])-1])
+	})
+	if chunk == len(x.chunks) {
+		return chunk, 0
+	}
+	position := sort.Search(len(x.chunks[chunk]), func(i int) bool {
+		return after(x.chunks[chunk][i])
+	})
+	return chunk, position
+}
+
+func (x *sortedIndex) has(key quadIDs) bool {
+	chunk, position := x.lowerBound(key, true)
+	return chunk < len(x.chunks) && x.chunks[chunk][position] == key
+}
+
+// insert adds key and reports whether it was not in the index yet.
+func (x *sortedIndex) insert(key quadIDs) bool {
+	if len(x.chunks) == 0 {
+		x.chunks = append(x.chunks, []quadIDs{key})
+		x.size++
+		return true
+	}
+	chunk, position := x.lowerBound(key, true)
+	if chunk == len(x.chunks) {
+		chunk = len(x.chunks) - 1
+		position = len(x.chunks[chunk])
+	} else if x.chunks[chunk][position] == key {
+		return false
+	}
+
+	keys := x.chunks[chunk]
+	keys = append(keys, quadIDs{})
+	copy(keys[position+1:], keys[position:])
+	keys[position] = key
+	x.chunks[chunk] = keys
+	if len(keys) > indexChunkSize {
+		half := len(keys) / 2
+		right := append([]quadIDs(nil), keys[half:]...)
+		x.chunks[chunk] = keys[:half:half]
+		x.chunks = append(x.chunks, nil)
+		copy(x.chunks[chunk+2:], x.chunks[chunk+1:])
+		x.chunks[chunk+1] = right
+	}
+	x.size++
+	return true
+}
+
+// remove deletes key and reports whether it was in the index.
+func (x *sortedIndex) remove(key quadIDs) bool {
+	chunk, position := x.lowerBound(key, true)
+	if chunk == len(x.chunks) || x.chunks[chunk][position] != key {
+		return false
+	}
+	keys := x.chunks[chunk]
+	if len(keys) == 1 {
+		x.chunks = append(x.chunks[:chunk], x.chunks[chunk+1:]...)
+	} else {
+		x.chunks[chunk] = append(keys[:position], keys[position+1:]...)
+	}
+	x.size--
+	return true
+}
+
+// scan appends up to limit keys that follow from and share its first prefix
+// components to keys.
+func (x *sortedIndex) scan(from quadIDs, inclusive bool, prefix int, limit int, keys []quadIDs) []quadIDs {
+	chunk, position := x.lowerBound(from, inclusive)
+	for ; chunk < len(x.chunks); chunk, position = chunk+1, 0 {
+		for _, key := range x.chunks[chunk][position:] {
+			for i := 0; i < prefix; i++ {
+				if key[i] != from[i] {
+					return keys
+				}
+			}
+			if len(keys) == limit {
+				return keys
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
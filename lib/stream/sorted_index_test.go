@@ -0,0 +1,72 @@
+package rdfgo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSortedIndex(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	index := sortedIndex{}
+	expected := make(map[quadIDs]bool)
+	for i := 0; i < 20000; i++ {
+		key := quadIDs{termID(random.Intn(20) + 1), termID(random.Intn(20) + 1), termID(random.Intn(20) + 1), 1}
+		if random.Intn(3) == 0 {
+			if index.remove(key) != expected[key] {
+				t.Fatalf("Expected remove of %v to return %v", key, expected[key])
+			}
+			delete(expected, key)
+		} else {
+			if index.insert(key) == expected[key] {
+				t.Fatalf("Expected insert of %v to return %v", key, !expected[key])
+			}
+			expected[key] = true
+		}
+	}
+	if index.size != len(expected) {
+		t.Fatalf("Expected %d keys, got %d", len(expected), index.size)
+	}
+	if len(index.chunks) < 2 {
+		t.Errorf("Expected the index to be split into chunks, got %d", len(index.chunks))
+	}
+
+	keys := index.scan(quadIDs{}, true, 0, index.size, nil)
+	if len(keys) != len(expected) {
+		t.Fatalf("Expected to scan %d keys, got %d", len(expected), len(keys))
+	}
+	for i, key := range keys {
+		if !expected[key] || !index.has(key) {
+			t.Errorf("Unexpected key %v", key)
+		}
+		if i > 0 && compareIDs(keys[i-1], key) >= 0 {
+			t.Errorf("Expected %v to be sorted before %v", keys[i-1], key)
+		}
+	}
+	if index.has(quadIDs{30, 1, 1, 1}) {
+		t.Errorf("Expected a missing key not to be found")
+	}
+
+	prefix := quadIDs{5, 7, 0, 0}
+	count := 0
+	for key := range expected {
+		if key[0] == 5 && key[1] == 7 {
+			count++
+		}
+	}
+	if scanned := index.scan(prefix, true, 2, index.size, nil); len(scanned) != count {
+		t.Errorf("Expected %d keys with prefix %v, got %d", count, prefix, len(scanned))
+	}
+	if scanned := index.scan(prefix, true, 2, 1, nil); count > 0 && len(scanned) != 1 {
+		t.Errorf("Expected the scan to stop at the limit, got %d", len(scanned))
+	}
+	if scanned := index.scan(keys[len(keys)-1], false, 0, index.size, nil); len(scanned) != 0 {
+		t.Errorf("Expected no keys after the last key, got %d", len(scanned))
+	}
+
+	for _, key := range keys {
+		index.remove(key)
+	}
+	if index.size != 0 || len(index.chunks) != 0 {
+		t.Errorf("Expected an empty index, got %d keys in %d chunks", index.size, len(index.chunks))
+	}
+}
@@ -0,0 +1,100 @@
+package rdfgo
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	geo "github.com/maartyman/rdfgo/lib/geo"
+)
+
+// spatialShape is the geometry of the object of an entry in a spatial index.
+type spatialShape struct {
+	key      string
+	geometry geo.Geometry
+}
+
+// MatchIntersects returns the quads with a predicate whose object is a
+// geo:wktLiteral that intersects geometry, like geof:sfIntersects. A nil
+// predicate matches every predicate. Geometries in another coordinate
+// reference system than geometry do not match. It fails with
+// IndexDisabledError when the store was not created with a spatial index.
+func (s *Store) MatchIntersects(predicate interfaces.ITerm, geometry geo.Geometry) (iter.Seq[interfaces.IQuad], error) {
+	return s.matchSpatial(predicate, geometry, geo.Geometry.Intersects)
+}
+
+// MatchWithin is like MatchIntersects for the geometries within geometry,
+// like geof:sfWithin. It finds the features in a bounding box with
+// geo.NewBox.
+func (s *Store) MatchWithin(predicate interfaces.ITerm, geometry geo.Geometry) (iter.Seq[interfaces.IQuad], error) {
+	return s.matchSpatial(predicate, geometry, geo.Geometry.Within)
+}
+
+func (s *Store) matchSpatial(predicate interfaces.ITerm, geometry geo.Geometry, test func(geo.Geometry, geo.Geometry) bool) (iter.Seq[interfaces.IQuad], error) {
+	if !s.options.SpatialIndex {
+		return nil, fmt.Errorf("%w: spatial", IndexDisabledError)
+	}
+	if geometry.IsEmpty() {
+		return entrySeq(nil), nil
+	}
+	s.mux.RLock()
+	var trees []*geo.RTree[*storeEntry]
+	if predicate != nil {
+		if tree := s.spatial[s.bucketKey(1, predicate)]; tree != nil {
+			trees = append(trees, tree)
+		}
+	} else {
+		for _, tree := range s.spatial {
+			trees = append(trees, tree)
+		}
+	}
+	var matches []*storeEntry
+	for _, tree := range trees {
+		tree.Search(geometry.Bounds(), func(_ geo.Box, entry *storeEntry) bool {
+			if shape := s.shapes[entry]; shape.geometry.CRS == geometry.CRS && test(shape.geometry, geometry) {
+				matches = append(matches, entry)
+			}
+			return true
+		})
+	}
+	slices.SortFunc(matches, func(a *storeEntry, b *storeEntry) int {
+		return strings.Compare(s.shapes[a].key, s.shapes[b].key)
+	})
+	s.mux.RUnlock()
+	return entrySeq(matches), nil
+}
+
+// indexShape adds a geometry object of an entry to the spatial index, or
+// removes it when added is false. The caller has to hold the lock.
+func (s *Store) indexShape(entry *storeEntry, literal interfaces.ILiteral, hashes []string, added bool) {
+	if !added {
+		shape, ok := s.shapes[entry]
+		if !ok {
+			return
+		}
+		tree := s.spatial[hashes[1]]
+		tree.Remove(shape.geometry.Bounds(), entry)
+		if tree.Len() == 0 {
+			delete(s.spatial, hashes[1])
+			s.memory.Indexes -= bucketBytes
+		}
+		delete(s.shapes, entry)
+		s.memory.Indexes -= shapeBytes + int64(len(literal.GetValue()))
+		return
+	}
+	geometry, err := geo.ParseLiteral(literal)
+	if err != nil || geometry.IsEmpty() {
+		return
+	}
+	tree := s.spatial[hashes[1]]
+	if tree == nil {
+		tree = &geo.RTree[*storeEntry]{}
+		s.spatial[hashes[1]] = tree
+		s.memory.Indexes += bucketBytes
+	}
+	tree.Insert(geometry.Bounds(), entry)
+	s.shapes[entry] = spatialShape{key: hashes[4], geometry: geometry}
+	s.memory.Indexes += shapeBytes + int64(len(literal.GetValue()))
+}
@@ -0,0 +1,64 @@
+package rdfgo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	geo "github.com/maartyman/rdfgo/lib/geo"
+)
+
+func TestStore_MatchSpatial(t *testing.T) {
+	store := NewStoreWithOptions(StoreOptions{SpatialIndex: true}).(*Store)
+	location := NewNamedNode("location")
+	wkt := NewNamedNode(geo.WKTLiteral)
+	for x := 0; x < 20; x++ {
+		for y := 0; y < 20; y++ {
+			point := NewLiteral(fmt.Sprintf("POINT (%d %d)", x, y), "", wkt)
+			store.AddQuadFromTerms(NewNamedNode(fmt.Sprintf("p%02d%02d", x, y)), location, point, NewDefaultGraph())
+		}
+	}
+	store.AddQuadFromTerms(NewNamedNode("road"), location, NewLiteral("LINESTRING (-5 2.5, 2.5 2.5)", "", wkt), NewDefaultGraph())
+	store.AddQuadFromTerms(NewNamedNode("other"), location, NewLiteral("<http://www.opengis.net/def/crs/EPSG/0/4326> POINT (2 2)", "", wkt), NewDefaultGraph())
+	store.AddQuadFromTerms(NewNamedNode("name"), location, NewStringLiteral("POINT (2 2)", ""), NewDefaultGraph())
+
+	box := geo.NewBox(geo.Box{MinX: 1.5, MinY: 1.5, MaxX: 3.5, MaxY: 3.5})
+	within, err := store.MatchWithin(location, box)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found []string
+	for quad := range within {
+		found = append(found, quad.GetSubject().GetValue())
+	}
+	if fmt.Sprint(found) != "[p0202 p0203 p0302 p0303]" {
+		t.Errorf("Expected the four points in the box, got %v", found)
+	}
+
+	intersects, err := store.MatchIntersects(nil, box)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found = nil
+	for quad := range intersects {
+		found = append(found, quad.GetSubject().GetValue())
+	}
+	if fmt.Sprint(found) != "[p0202 p0203 p0302 p0303 road]" {
+		t.Errorf("Expected the points and the road, got %v", found)
+	}
+
+	store.RemoveMatches(NewNamedNode("road"), nil, nil, nil)
+	store.RemoveMatches(NewNamedNode("p0202"), nil, nil, nil)
+	intersects, _ = store.MatchIntersects(location, box)
+	found = nil
+	for quad := range intersects {
+		found = append(found, quad.GetSubject().GetValue())
+	}
+	if fmt.Sprint(found) != "[p0203 p0302 p0303]" {
+		t.Errorf("Expected the removed quads to leave the index, got %v", found)
+	}
+	if _, err := NewStore().(*Store).MatchWithin(location, box); !errors.Is(err, IndexDisabledError) {
+		t.Errorf("Expected IndexDisabledError, got %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+package rdfgo
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const rdfType = "http://www.w3.org/1999/02/22-rdf-syntax-ns#type"
+
+// TermCount is the number of quads in a store that contain a term.
+type TermCount struct {
+	Term  interfaces.ITerm
+	Count int
+}
+
+// StoreStatistics describes the quads in a Store. Subjects, Predicates,
+// Objects and Graphs count the distinct terms in those positions. The
+// counts per predicate, per graph and per class, the objects of rdf:type,
+// are sorted from the largest to the smallest count.
+type StoreStatistics struct {
+	Quads           int
+	Subjects        int
+	Predicates      int
+	Objects         int
+	Graphs          int
+	PredicateCounts []TermCount
+	GraphCounts     []TermCount
+	ClassCounts     []TermCount
+}
+
+// Statistics returns the statistics of the latest version of the store. They
+// are kept up to date on every change, so this does not read the quads.
+func (s *Store) Statistics() StoreStatistics {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	statistics := StoreStatistics{
+		Quads:           s.size,
+		Subjects:        s.distinct[0],
+		Predicates:      s.distinct[1],
+		Objects:         s.distinct[2],
+		Graphs:          s.distinct[3],
+		PredicateCounts: bucketCounts(s.predicates, interfaces.IQuad.GetPredicate),
+		GraphCounts:     bucketCounts(s.graphs, interfaces.IQuad.GetGraph),
+		ClassCounts:     make([]TermCount, 0, len(s.classes)),
+	}
+	for _, count := range s.classes {
+		statistics.ClassCounts = append(statistics.ClassCounts, *count)
+	}
+	sortTermCounts(statistics.ClassCounts)
+	return statistics
+}
+
+// Cardinality estimates how many quads Match returns for a pattern, without
+// matching it. A pattern with a single term, or none, is counted exactly.
+// For more terms it assumes that the terms occur independently of each
+// other, and never estimates more than the quads of the rarest term.
+func (s *Store) Cardinality(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) int {
+	subject, predicate, object, graph = convertVariablesToNil(subject, predicate, object, graph)
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	if subject != nil && predicate != nil && object != nil && graph != nil {
+		if s.find(s.hashes(subject, predicate, object, graph)[4], s.version) != nil {
+			return 1
+		}
+		return 0
+	}
+
+	estimate := float64(s.size)
+	smallest := s.size
+	bound := 0
+	for position, term := range []interfaces.ITerm{subject, predicate, object, graph} {
+		if term == nil {
+			continue
+		}
+		bucket := s.entries[s.bucketKey(position, term)]
+		if bucket == nil || bucket.live() == 0 {
+			return 0
+		}
+		estimate *= float64(bucket.live()) / float64(s.size)
+		smallest = min(smallest, bucket.live())
+		bound++
+	}
+	if bound < 2 {
+		return smallest
+	}
+	// Every term occurs, so the estimate is not rounded down to nothing.
+	return max(1, min(smallest, int(estimate+0.5)))
+}
+
+// bucketKey returns the key of the bucket of a term at a position of a quad,
+// like hashes.
+func (s *Store) bucketKey(position int, term interfaces.ITerm) string {
+	value := term.ToString()
+	if position == 2 {
+		value = s.objectKey(term)
+	} else if term.GetType() == interfaces.DefaultGraphType {
+		value = DefaultGraphValue
+	}
+	return strings.Repeat(",", position) + value + strings.Repeat(",", 3-position)
+}
+
+// bucketFor returns the bucket of a key at a position of a quad and creates
+// it when it does not exist yet. The caller has to hold the lock.
+func (s *Store) bucketFor(position int, key string) *storeBucket {
+	bucket := s.entries[key]
+	if bucket == nil {
+		bucket = &storeBucket{}
+		s.entries[key] = bucket
+		s.memory.Buckets += bucketBytes + int64(len(key))
+		switch position {
+		case 1:
+			s.predicates[key] = bucket
+		case 3:
+			s.graphs[key] = bucket
+		}
+	}
+	return bucket
+}
+
+// link appends an entry to the bucket at a position of its quad and counts
+// the term when it was not in any quad yet. The caller has to hold the lock.
+func (s *Store) link(position int, bucket *storeBucket, entry *storeEntry) {
+	bucket.entries = append(bucket.entries, entry)
+	if position < 4 && bucket.live() == 1 {
+		s.distinct[position]++
+	}
+}
+
+// countClass changes the count of the class of an rdf:type quad by delta.
+// The caller has to hold the lock.
+func (s *Store) countClass(quad interfaces.IQuad, delta int) {
+	predicate := quad.GetPredicate()
+	if predicate.GetType() != interfaces.NamedNodeType || predicate.GetValue() != rdfType {
+		return
+	}
+	key := quad.GetObject().ToString()
+	count := s.classes[key]
+	if count == nil {
+		count = &TermCount{Term: quad.GetObject()}
+		s.classes[key] = count
+	}
+	count.Count += delta
+	if count.Count == 0 {
+		delete(s.classes, key)
+	}
+}
+
+// bucketCounts returns the number of quads in the buckets that are not
+// empty, with the term that term takes from their quads.
+func bucketCounts(buckets map[string]*storeBucket, term func(interfaces.IQuad) interfaces.ITerm) []TermCount {
+	counts := make([]TermCount, 0, len(buckets))
+	for _, bucket := range buckets {
+		if bucket.live() > 0 {
+			counts = append(counts, TermCount{Term: term(bucket.entries[0].quad), Count: bucket.live()})
+		}
+	}
+	sortTermCounts(counts)
+	return counts
+}
+
+func sortTermCounts(counts []TermCount) {
+	slices.SortFunc(counts, func(a TermCount, b TermCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Term.ToString(), b.Term.ToString()))
+	})
+}
@@ -0,0 +1,167 @@
+package rdfgo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+// statisticsQuads types 20 people and 10 places, gives every person a name
+// in the default graph and puts the places in the graph g.
+func statisticsQuads() []interfaces.IQuad {
+	var quads []interfaces.IQuad
+	newQuad := func(subject, predicate, object, graph interfaces.ITerm) interfaces.IQuad {
+		quad, _ := NewQuad(subject, predicate, object, graph)
+		return quad
+	}
+	typeNode := NewNamedNode(rdfType)
+	for i := 0; i < 20; i++ {
+		person := NewNamedNode(fmt.Sprintf("person%d", i))
+		quads = append(quads,
+			newQuad(person, typeNode, NewNamedNode("Person"), NewDefaultGraph()),
+			newQuad(person, NewNamedNode("name"), NewStringLiteral(fmt.Sprint(i), ""), NewDefaultGraph()),
+		)
+	}
+	for i := 0; i < 10; i++ {
+		quads = append(quads, newQuad(NewNamedNode(fmt.Sprintf("place%d", i)), typeNode, NewNamedNode("Place"), NewNamedNode("g")))
+	}
+	return quads
+}
+
+func termCountStrings(counts []TermCount) []string {
+	result := make([]string, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, fmt.Sprintf("%s=%d", count.Term.ToString(), count.Count))
+	}
+	return result
+}
+
+func statisticsString(statistics StoreStatistics) string {
+	return fmt.Sprint(
+		statistics.Quads, statistics.Subjects, statistics.Predicates, statistics.Objects, statistics.Graphs,
+		termCountStrings(statistics.PredicateCounts), termCountStrings(statistics.GraphCounts), termCountStrings(statistics.ClassCounts),
+	)
+}
+
+func TestStore_Statistics(t *testing.T) {
+	store := NewStore().(*Store)
+	for _, quad := range statisticsQuads() {
+		store.AddQuad(quad)
+	}
+	statistics := store.Statistics()
+	if statistics.Quads != 50 || statistics.Subjects != 30 || statistics.Predicates != 2 || statistics.Objects != 22 || statistics.Graphs != 2 {
+		t.Errorf("Expected 50 quads with 30, 2, 22 and 2 distinct terms, got %+v", statistics)
+	}
+	expected := []string{"<" + rdfType + ">=30", "<name>=20"}
+	if got := termCountStrings(statistics.PredicateCounts); !equalStrings(expected, got) {
+		t.Errorf("Expected predicate counts %v, got %v", expected, got)
+	}
+	expected = []string{NewDefaultGraph().ToString() + "=40", "<g>=10"}
+	if got := termCountStrings(statistics.GraphCounts); !equalStrings(expected, got) {
+		t.Errorf("Expected graph counts %v, got %v", expected, got)
+	}
+	expected = []string{"<Person>=20", "<Place>=10"}
+	if got := termCountStrings(statistics.ClassCounts); !equalStrings(expected, got) {
+		t.Errorf("Expected class counts %v, got %v", expected, got)
+	}
+
+	store.RemoveMatches(nil, nil, nil, NewNamedNode("g"))
+	store.RemoveQuad(statisticsQuads()[1])
+	statistics = store.Statistics()
+	if statistics.Quads != 39 || statistics.Subjects != 20 || statistics.Objects != 20 || statistics.Graphs != 1 {
+		t.Errorf("Expected the removed quads not to be counted, got %+v", statistics)
+	}
+	expected = []string{"<Person>=20"}
+	if got := termCountStrings(statistics.ClassCounts); !equalStrings(expected, got) {
+		t.Errorf("Expected class counts %v, got %v", expected, got)
+	}
+	if len(store.graphs) != 1 || len(store.predicates) != 2 {
+		t.Errorf("Expected the empty buckets to be dropped, got %d graphs and %d predicates", len(store.graphs), len(store.predicates))
+	}
+}
+
+func TestStore_StatisticsSameForAllWrites(t *testing.T) {
+	quads := statisticsQuads()
+	added := NewStore().(*Store)
+	for _, quad := range quads {
+		added.AddQuad(quad)
+	}
+
+	committed := NewStore().(*Store)
+	transaction := committed.Begin()
+	for _, quad := range quads {
+		transaction.AddQuad(quad)
+	}
+	if err := transaction.Commit(); err != nil {
+		t.Fatalf("Expected the commit to succeed, got %v", err)
+	}
+
+	loaded := NewStore().(*Store)
+	if _, err := loaded.BulkLoad(context.Background(), ArrayToQuadStream(context.Background(), quads), BulkLoadOptions{BatchSize: 7}); err != nil {
+		t.Fatalf("Expected the load to succeed, got %v", err)
+	}
+
+	expected := statisticsString(added.Statistics())
+	for name, store := range map[string]*Store{"committed": committed, "loaded": loaded} {
+		if got := statisticsString(store.Statistics()); got != expected {
+			t.Errorf("Expected the %s store to have %s, got %s", name, expected, got)
+		}
+	}
+}
+
+func TestStore_StatisticsKeptForTransaction(t *testing.T) {
+	store := NewStore().(*Store)
+	quad := testQuads(1)[0]
+	store.AddQuad(quad)
+	transaction := store.Begin()
+	store.RemoveQuad(quad)
+	if statistics := store.Statistics(); statistics.Subjects != 0 || len(statistics.PredicateCounts) != 0 {
+		t.Errorf("Expected the removed quad not to be counted while a transaction reads it, got %+v", statistics)
+	}
+	store.AddQuad(quad)
+	transaction.Rollback()
+	if statistics := store.Statistics(); statistics.Quads != 1 || statistics.Subjects != 1 || statistics.Objects != 1 {
+		t.Errorf("Expected the added quad to be counted once, got %+v", statistics)
+	}
+}
+
+func TestStore_Cardinality(t *testing.T) {
+	store := NewStore().(*Store)
+	for _, quad := range statisticsQuads() {
+		store.AddQuad(quad)
+	}
+	typeNode := NewNamedNode(rdfType)
+	tests := []struct {
+		name                              string
+		subject, predicate, object, graph interfaces.ITerm
+		expected                          int
+	}{
+		{"all", nil, nil, nil, nil, 50},
+		{"variables", NewVariable("s"), nil, NewVariable("o"), nil, 50},
+		{"predicate", nil, typeNode, nil, nil, 30},
+		{"graph", nil, nil, nil, NewDefaultGraph(), 40},
+		{"class", nil, typeNode, NewNamedNode("Place"), nil, 6},
+		{"rarest term", NewNamedNode("person3"), NewNamedNode("name"), nil, nil, 1},
+		{"missing term", nil, NewNamedNode("missing"), nil, nil, 0},
+		{"quad", NewNamedNode("place1"), typeNode, NewNamedNode("Place"), NewNamedNode("g"), 1},
+		{"missing quad", NewNamedNode("place1"), typeNode, NewNamedNode("Place"), NewDefaultGraph(), 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := store.Cardinality(test.subject, test.predicate, test.object, test.graph); got != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+
+	empty := NewStore().(*Store)
+	if got := empty.Cardinality(nil, nil, nil, nil); got != 0 {
+		t.Errorf("Expected 0 for an empty store, got %d", got)
+	}
+	if got := empty.Cardinality(nil, typeNode, nil, nil); got != 0 {
+		t.Errorf("Expected 0 for a term of an empty store, got %d", got)
+	}
+}
@@ -1,17 +1,81 @@
 package rdfgo
 
 import (
+	"context"
+	"iter"
+
 	"github.com/maartyman/rdfgo/interfaces"
 	. "github.com/maartyman/rdfgo/lib/data_model"
+	geo "github.com/maartyman/rdfgo/lib/geo"
 	"sync"
+	"sync/atomic"
 )
 
+// Store indexes every quad in five buckets: one per term of the quad and one
+// for the quad itself. Every change commits a new version of the store. The
+// entries in the buckets are stamped with the versions that added and
+// removed them, so a reader sees the version it started at while writers
+// commit newer ones.
 type Store struct {
 	size    int
-	entries map[string][]interfaces.IQuad
+	version uint64
+	entries map[string]*storeBucket
+	all     storeBucket
+	// snapshots counts the open transactions per version. Removed entries
+	// stay in the buckets while a transaction may still read them, retained
+	// holds those buckets.
+	snapshots     map[uint64]int
+	retained      map[string]*storeBucket
+	subscriptions map[*Subscription]struct{}
+	// distinct counts the terms per position that are in at least one quad.
+	// predicates and graphs hold the buckets of those positions, and classes
+	// counts the quads per object of rdf:type.
+	distinct   [4]int
+	predicates map[string]*storeBucket
+	graphs     map[string]*storeBucket
+	classes    map[string]*TermCount
+	// ranges holds the literal objects per predicate sorted by value, words
+	// the entries per word of their string object, and spatial the
+	// geometry objects per predicate, with their geometries in shapes.
+	ranges  map[string]*rangeIndex
+	words   map[string]map[*storeEntry]string
+	spatial map[string]*geo.RTree[*storeEntry]
+	shapes  map[*storeEntry]spatialShape
+	options StoreOptions
+	memory  StoreMemory
 	mux     sync.RWMutex
 }
 
+// storeEntry is a quad in a Store. Removed is 0 while the quad is in the
+// store and the version that removed it afterwards.
+type storeEntry struct {
+	quad    interfaces.IQuad
+	added   uint64
+	removed atomic.Uint64
+}
+
+func (e *storeEntry) visibleAt(version uint64) bool {
+	removed := e.removed.Load()
+	return e.added <= version && (removed == 0 || removed > version)
+}
+
+// storeBucket is a list of entries that is only appended to. Removed entries
+// are dropped by replacing the list, so readers can keep iterating over a
+// list they took under the lock after releasing it.
+type storeBucket struct {
+	entries []*storeEntry
+	removed int
+}
+
+// live returns the number of entries in the bucket that are not removed.
+func (b *storeBucket) live() int {
+	return len(b.entries) - b.removed
+}
+
+// latestVersion makes a match read the version of the store at the time it
+// starts.
+const latestVersion = ^uint64(0)
+
 type IStore interface {
 	interfaces.IStore
 	Size() int
@@ -20,16 +84,35 @@ type IStore interface {
 	AddQuad(interfaces.IQuad) bool
 	RemoveQuad(interfaces.IQuad)
 	ForEach(func(interfaces.IQuad))
+	MatchContext(context.Context, interfaces.ITerm, interfaces.ITerm, interfaces.ITerm, interfaces.ITerm) *QuadStream
+	MatchSeq(interfaces.ITerm, interfaces.ITerm, interfaces.ITerm, interfaces.ITerm) iter.Seq[interfaces.IQuad]
 }
 
 func NewStore() IStore {
+	return NewStoreWithOptions(StoreOptions{})
+}
+
+// NewStoreWithOptions returns an empty store with limits on its size.
+func NewStoreWithOptions(options StoreOptions) IStore {
 	return &Store{
-		size:    0,
-		entries: make(map[string][]interfaces.IQuad),
+		size:       0,
+		entries:    make(map[string]*storeBucket),
+		snapshots:  make(map[uint64]int),
+		retained:   make(map[string]*storeBucket),
+		predicates: make(map[string]*storeBucket),
+		graphs:     make(map[string]*storeBucket),
+		classes:    make(map[string]*TermCount),
+		ranges:     make(map[string]*rangeIndex),
+		words:      make(map[string]map[*storeEntry]string),
+		spatial:    make(map[string]*geo.RTree[*storeEntry]),
+		shapes:     make(map[*storeEntry]spatialShape),
+		options:    options,
 	}
 }
 
 func (s *Store) Size() int {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
 	return s.size
 }
 
@@ -80,50 +163,98 @@ func convertVariablesToNil(
 }
 
 func (s *Store) Has(quad interfaces.IQuad) bool {
-	s.mux.Lock()
-	_, exists := s.entries[quad.GetSubject().ToString()+","+quad.GetPredicate().ToString()+","+quad.GetObject().ToString()+","+quad.GetGraph().ToString()]
-	s.mux.Unlock()
-	return exists
+	key := s.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())[4]
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.find(key, s.version) != nil
+}
+
+// find returns the entry of the quad with the given key that is visible at
+// version. The caller has to hold the lock.
+func (s *Store) find(key string, version uint64) *storeEntry {
+	bucket := s.entries[key]
+	if bucket == nil {
+		return nil
+	}
+	for _, entry := range bucket.entries {
+		if entry.visibleAt(version) {
+			return entry
+		}
+	}
+	return nil
 }
 
+// newStoreQuad validates the terms of a quad that is added to a store.
+func newStoreQuad(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) (interfaces.IQuad, bool) {
+	graph, ok := checkQuadTerms(subject, predicate, object, graph)
+	if !ok {
+		return nil, false
+	}
+	quad, err := NewQuad(subject, predicate, object, graph)
+	return quad, err == nil
+}
+
+// AddQuadFromTerms adds a quad to the store. It returns false when the quad
+// is invalid, already in the store or would exceed a limit of the store.
 func (s *Store) AddQuadFromTerms(
 	subject interfaces.ITerm,
 	predicate interfaces.ITerm,
 	object interfaces.ITerm,
 	graph interfaces.ITerm,
 ) bool {
-	if subject == nil || predicate == nil || object == nil {
-		return false
-	}
-	if graph == nil {
-		graph = NewDefaultGraph()
-	}
-	if subject.GetType() == interfaces.VariableType ||
-		predicate.GetType() == interfaces.VariableType ||
-		object.GetType() == interfaces.VariableType ||
-		graph.GetType() == interfaces.VariableType {
-		return false
-	}
+	added, _ := s.TryAddQuadFromTerms(subject, predicate, object, graph)
+	return added
+}
 
-	quad, err := NewQuad(subject, predicate, object, graph)
-	if err != nil {
-		return false
+// TryAddQuadFromTerms adds a quad to the store like AddQuadFromTerms, and
+// returns a LimitError when the quad would exceed a limit of the store.
+func (s *Store) TryAddQuadFromTerms(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) (bool, error) {
+	quad, ok := newStoreQuad(subject, predicate, object, graph)
+	if !ok {
+		return false, nil
 	}
-	if s.Has(quad) {
+	hashes := s.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+	added := false
+	var err error
+	s.write(func() {
+		if s.find(hashes[4], s.version) == nil {
+			err = s.checkLimits(1, s.cost(quad, hashes))
+		}
+		if err == nil {
+			added = s.add(quad, hashes, s.version+1)
+		}
+	})
+	return added, err
+}
+
+// add inserts a quad with the given keys at version and makes that the
+// version of the store when the quad is new. It does not check the limits of
+// the store. The caller has to hold the lock.
+func (s *Store) add(quad interfaces.IQuad, hashes []string, version uint64) bool {
+	if s.find(hashes[4], s.version) != nil {
 		return false
 	}
-	s.mux.Lock()
-	for _, hash := range getHashes(subject, predicate, object, graph) {
-		quadArray, exists := s.entries[hash]
-		if !exists {
-			s.entries[hash] = []interfaces.IQuad{quad}
-		} else {
-			s.entries[hash] = append(quadArray, quad)
-		}
+	entry := &storeEntry{quad: quad, added: version}
+	for position, hash := range hashes {
+		s.link(position, s.bucketFor(position, hash), entry)
 	}
-
+	s.all.entries = append(s.all.entries, entry)
+	s.countClass(quad, 1)
+	s.indexLiteral(entry, hashes, true)
+	s.account(hashes[4], 1)
 	s.size++
-	s.mux.Unlock()
+	s.version = version
+	s.publish(QuadEvent{Type: QuadAdded, Quad: quad, Version: version})
 	return true
 }
 
@@ -131,34 +262,93 @@ func (s *Store) AddQuad(quad interfaces.IQuad) bool {
 	return s.AddQuadFromTerms(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
 }
 
+// TryAddQuad adds a quad like AddQuad, and returns a LimitError when the quad
+// would exceed a limit of the store.
+func (s *Store) TryAddQuad(quad interfaces.IQuad) (bool, error) {
+	return s.TryAddQuadFromTerms(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+}
+
 func (s *Store) RemoveQuad(quad interfaces.IQuad) {
-	if !s.Has(quad) {
-		return
+	s.write(func() {
+		s.remove(quad, s.version+1)
+	})
+}
+
+// remove marks the entry of a quad as removed at version and makes that the
+// version of the store when the quad was in it. All buckets share the entry,
+// so the quad disappears from all of them at once. The caller has to hold the
+// lock.
+func (s *Store) remove(quad interfaces.IQuad, version uint64) bool {
+	hashes := s.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+	entry := s.find(hashes[4], s.version)
+	if entry == nil {
+		return false
 	}
+	entry.removed.Store(version)
+	s.size--
+	s.version = version
+	s.publish(QuadEvent{Type: QuadRemoved, Quad: entry.quad, Version: version})
+	s.countClass(entry.quad, -1)
+	s.indexLiteral(entry, hashes, false)
+	s.account(hashes[4], -1)
+	for position, hash := range hashes {
+		bucket := s.entries[hash]
+		bucket.removed++
+		if position < 4 && bucket.live() == 0 {
+			s.distinct[position]--
+		}
+		s.compact(hash, bucket)
+	}
+	s.all.removed++
+	s.compact("", &s.all)
+	return true
+}
 
-	for _, hash := range getHashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph()) {
-		s.mux.Lock()
-		quadArray := s.entries[hash]
-		for i := 0; i < len(quadArray); i++ {
-			q := quadArray[i]
-			if q.GetSubject().Equals(quad.GetSubject()) &&
-				q.GetPredicate().Equals(quad.GetPredicate()) &&
-				q.GetObject().Equals(quad.GetObject()) &&
-				q.GetGraph().Equals(quad.GetGraph()) {
-				if len(quadArray) == 1 {
-					delete(s.entries, hash)
-					break
-				}
-				s.entries[hash] = append(quadArray[:i], quadArray[i+1:]...)
-				break
-			}
+// compact drops the removed entries of a bucket that no open transaction can
+// read, once they make up more than half of it. Buckets that keep removed
+// entries for a transaction are compacted again when it ends. The all
+// bucket has the empty key. The caller has to hold the lock.
+func (s *Store) compact(key string, bucket *storeBucket) {
+	if bucket.removed*2 <= len(bucket.entries) {
+		return
+	}
+	oldest := s.version
+	for version := range s.snapshots {
+		oldest = min(oldest, version)
+	}
+	kept := make([]*storeEntry, 0, len(bucket.entries)-bucket.removed)
+	bucket.removed = 0
+	for _, entry := range bucket.entries {
+		removed := entry.removed.Load()
+		if removed != 0 && removed <= oldest {
+			continue
 		}
-		s.mux.Unlock()
+		if removed != 0 {
+			bucket.removed++
+		}
+		kept = append(kept, entry)
+	}
+	bucket.entries = kept
+	if bucket.removed > 0 {
+		s.retained[key] = bucket
+	} else {
+		delete(s.retained, key)
 	}
+	if len(kept) == 0 && key != "" {
+		delete(s.entries, key)
+		s.memory.Buckets -= bucketBytes + int64(len(key))
+		delete(s.predicates, key)
+		delete(s.graphs, key)
+	}
+}
 
-	s.mux.Lock()
-	s.size--
-	s.mux.Unlock()
+// vacuum compacts the buckets that kept removed entries for a transaction.
+// The caller has to hold the lock.
+func (s *Store) vacuum() {
+	for key, bucket := range s.retained {
+		delete(s.retained, key)
+		s.compact(key, bucket)
+	}
 }
 
 func (s *Store) RemoveMatches(
@@ -167,9 +357,15 @@ func (s *Store) RemoveMatches(
 	object interfaces.ITerm,
 	graph interfaces.ITerm,
 ) {
-	for quad := range s.Match(subject, predicate, object, graph) {
-		s.RemoveQuad(quad)
-	}
+	subject, predicate, object, graph = convertVariablesToNil(subject, predicate, object, graph)
+	s.write(func() {
+		version := s.version + 1
+		for _, entry := range s.candidatesAt(s.version, subject, predicate, object, graph) {
+			if entry.removed.Load() == 0 && s.quadMatches(entry.quad, subject, predicate, object, graph) {
+				s.remove(entry.quad, version)
+			}
+		}
+	})
 }
 
 func (s *Store) Remove(stream interfaces.IStream) {
@@ -184,23 +380,30 @@ func (s *Store) DeleteGraph(graph interfaces.ITerm) {
 	s.RemoveMatches(nil, nil, nil, graph)
 }
 
-func (s *Store) matchSubject(subject interfaces.ITerm) []interfaces.IQuad {
-	return s.entries[subject.ToString()+",,,"]
+func (s *Store) bucket(key string) []*storeEntry {
+	if bucket := s.entries[key]; bucket != nil {
+		return bucket.entries
+	}
+	return nil
 }
 
-func (s *Store) matchPredicate(predicate interfaces.ITerm) []interfaces.IQuad {
-	return s.entries[","+predicate.ToString()+",,"]
+func (s *Store) matchSubject(subject interfaces.ITerm) []*storeEntry {
+	return s.bucket(subject.ToString() + ",,,")
 }
 
-func (s *Store) matchObject(object interfaces.ITerm) []interfaces.IQuad {
-	return s.entries[",,"+object.ToString()+","]
+func (s *Store) matchPredicate(predicate interfaces.ITerm) []*storeEntry {
+	return s.bucket("," + predicate.ToString() + ",,")
 }
 
-func (s *Store) matchGraph(graph interfaces.ITerm) []interfaces.IQuad {
+func (s *Store) matchObject(object interfaces.ITerm) []*storeEntry {
+	return s.bucket(",," + s.objectKey(object) + ",")
+}
+
+func (s *Store) matchGraph(graph interfaces.ITerm) []*storeEntry {
 	if graph.GetType() == interfaces.DefaultGraphType {
-		return s.entries[",,,"+DefaultGraphValue]
+		return s.bucket(",,," + DefaultGraphValue)
 	}
-	return s.entries[",,,"+graph.ToString()]
+	return s.bucket(",,," + graph.ToString())
 }
 
 func (s *Store) Match(
@@ -209,132 +412,229 @@ func (s *Store) Match(
 	object interfaces.ITerm,
 	graph interfaces.ITerm,
 ) interfaces.IStream {
-	subject, predicate, object, graph = convertVariablesToNil(subject, predicate, object, graph)
-	quadStream := make(interfaces.IStream, 10)
+	return s.MatchContext(context.Background(), subject, predicate, object, graph).ToIStream()
+}
 
-	if subject == nil && predicate == nil && object == nil && graph == nil {
-		go func() {
-			s.mux.Lock()
-			for key, value := range s.entries {
-				s.mux.Unlock()
-				if key[0] != ',' && key[len(key)-1] != ',' {
-					for _, quad := range value {
-						quadStream <- quad
-					}
-				}
-				s.mux.Lock()
+// MatchContext is like Match, but stops producing quads when ctx is cancelled
+// or the returned stream is closed. The stream holds the quads that matched
+// at the time of the call.
+func (s *Store) MatchContext(
+	ctx context.Context,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) *QuadStream {
+	quads := s.snapshot(latestVersion, subject, predicate, object, graph)
+	return NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		for quad := range quads {
+			if !emit(quad) {
+				return ctx.Err()
 			}
-			s.mux.Unlock()
-			close(quadStream)
-		}()
-	} else if subject != nil && predicate != nil && object != nil && graph != nil {
-		quad, err := NewQuad(subject, predicate, object, graph)
-		if err != nil {
-			close(quadStream)
-			return quadStream
 		}
-		if s.Has(quad) {
-			quadStream <- quad
-		}
-		close(quadStream)
-	} else {
-		smallest := [2]int{0, 0} // [index, size]
-		var subjectMatches []interfaces.IQuad = nil
-		if subject != nil {
-			subjectMatches = s.matchSubject(subject)
-			if subjectMatches != nil {
-				smallest[1] = len(subjectMatches)
+		return nil
+	})
+}
+
+// MatchSeq returns the matching quads as an iterator. It runs in the calling
+// goroutine, so it avoids the channel handoff of Match. The store is not
+// locked while the loop body runs, so the body may modify the store, but
+// the loop only sees the version of the store at the time it started.
+func (s *Store) MatchSeq(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) iter.Seq[interfaces.IQuad] {
+	return s.matchAt(latestVersion, subject, predicate, object, graph)
+}
+
+// matchAt returns the quads that match at version. The entries are taken
+// when the loop starts.
+func (s *Store) matchAt(
+	version uint64,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) iter.Seq[interfaces.IQuad] {
+	return func(yield func(interfaces.IQuad) bool) {
+		for quad := range s.snapshot(version, subject, predicate, object, graph) {
+			if !yield(quad) {
+				return
 			}
 		}
-		var predicateMatches []interfaces.IQuad = nil
-		if predicate != nil {
-			predicateMatches = s.matchPredicate(predicate)
-			if predicateMatches != nil && (smallest[1] == 0 || len(predicateMatches) < smallest[1]) {
-				smallest[0] = 1
-				smallest[1] = len(predicateMatches)
+	}
+}
+
+// snapshot takes the entries that can match a pattern at version right away
+// and returns the matching quads among them. Entries are never changed
+// after they are added, except for the version that removed them, so the
+// loop sees the same quads however the store changes in the meantime.
+func (s *Store) snapshot(
+	version uint64,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) iter.Seq[interfaces.IQuad] {
+	subject, predicate, object, graph = convertVariablesToNil(subject, predicate, object, graph)
+	s.mux.RLock()
+	if version == latestVersion {
+		version = s.version
+	}
+	candidates := s.candidatesAt(version, subject, predicate, object, graph)
+	s.mux.RUnlock()
+
+	return func(yield func(interfaces.IQuad) bool) {
+		for _, entry := range candidates {
+			if entry.visibleAt(version) && s.quadMatches(entry.quad, subject, predicate, object, graph) {
+				if !yield(entry.quad) {
+					return
+				}
 			}
 		}
-		var objectMatches []interfaces.IQuad = nil
-		if object != nil {
-			objectMatches = s.matchObject(object)
-			if objectMatches != nil && (smallest[1] == 0 || len(objectMatches) < smallest[1]) {
-				smallest[0] = 2
-				smallest[1] = len(objectMatches)
-			}
+	}
+}
+
+// candidatesAt returns the entries that have to be checked for a pattern
+// without variables. The caller has to hold the lock.
+func (s *Store) candidatesAt(
+	version uint64,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) []*storeEntry {
+	if subject == nil && predicate == nil && object == nil && graph == nil {
+		return s.all.entries
+	}
+	if subject != nil && predicate != nil && object != nil && graph != nil {
+		if _, err := NewQuad(subject, predicate, object, graph); err != nil {
+			return nil
 		}
-		var graphMatches []interfaces.IQuad = nil
-		if graph != nil {
-			graphMatches = s.matchGraph(graph)
-			if graphMatches != nil && (smallest[1] == 0 || len(graphMatches) < smallest[1]) {
-				smallest[0] = 3
-				smallest[1] = len(graphMatches)
-			}
+		if entry := s.find(s.hashes(subject, predicate, object, graph)[4], version); entry != nil {
+			return []*storeEntry{entry}
 		}
+		return nil
+	}
+	return s.candidates(subject, predicate, object, graph)
+}
 
-		switch smallest[0] {
-		case 0:
-			go func() {
-				for _, quad := range subjectMatches {
-					if (predicate == nil || quad.GetPredicate().Equals(predicate)) &&
-						(object == nil || quad.GetObject().Equals(object)) &&
-						(graph == nil || quad.GetGraph().Equals(graph)) {
-						quadStream <- quad
-					}
-				}
-				close(quadStream)
-			}()
-		case 1:
-			go func() {
-				for _, quad := range predicateMatches {
-					if (subject == nil || quad.GetSubject().Equals(subject)) &&
-						(object == nil || quad.GetObject().Equals(object)) &&
-						(graph == nil || quad.GetGraph().Equals(graph)) {
-						quadStream <- quad
-					}
-				}
-				close(quadStream)
-			}()
-		case 2:
-			go func() {
-				for _, quad := range objectMatches {
-					if (subject == nil || quad.GetSubject().Equals(subject)) &&
-						(predicate == nil || quad.GetPredicate().Equals(predicate)) &&
-						(graph == nil || quad.GetGraph().Equals(graph)) {
-						quadStream <- quad
-					}
-				}
-				close(quadStream)
-			}()
-		case 3:
-			go func() {
-				for _, quad := range graphMatches {
-					if (subject == nil || quad.GetSubject().Equals(subject)) &&
-						(predicate == nil || quad.GetPredicate().Equals(predicate)) &&
-						(object == nil || quad.GetObject().Equals(object)) {
-						quadStream <- quad
-					}
-				}
-				close(quadStream)
-			}()
+func quadMatches(
+	quad interfaces.IQuad,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) bool {
+	return (subject == nil || quad.GetSubject().Equals(subject)) &&
+		(predicate == nil || quad.GetPredicate().Equals(predicate)) &&
+		(object == nil || quad.GetObject().Equals(object)) &&
+		(graph == nil || quad.GetGraph().Equals(graph))
+}
+
+// candidates returns the smallest list of quads that share one of the given
+// terms.
+func (s *Store) candidates(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) []*storeEntry {
+	smallest := [2]int{0, 0} // [index, size]
+	var subjectMatches []*storeEntry = nil
+	if subject != nil {
+		subjectMatches = s.matchSubject(subject)
+		if subjectMatches != nil {
+			smallest[1] = len(subjectMatches)
+		}
+	}
+	var predicateMatches []*storeEntry = nil
+	if predicate != nil {
+		predicateMatches = s.matchPredicate(predicate)
+		if predicateMatches != nil && (smallest[1] == 0 || len(predicateMatches) < smallest[1]) {
+			smallest[0] = 1
+			smallest[1] = len(predicateMatches)
 		}
 	}
-	return quadStream
+	var objectMatches []*storeEntry = nil
+	if object != nil {
+		objectMatches = s.matchObject(object)
+		if objectMatches != nil && (smallest[1] == 0 || len(objectMatches) < smallest[1]) {
+			smallest[0] = 2
+			smallest[1] = len(objectMatches)
+		}
+	}
+	var graphMatches []*storeEntry = nil
+	if graph != nil {
+		graphMatches = s.matchGraph(graph)
+		if graphMatches != nil && (smallest[1] == 0 || len(graphMatches) < smallest[1]) {
+			smallest[0] = 3
+			smallest[1] = len(graphMatches)
+		}
+	}
+	return [4][]*storeEntry{subjectMatches, predicateMatches, objectMatches, graphMatches}[smallest[0]]
 }
 
+// Import adds the quads of a stream. It stops adding at the first quad that
+// would exceed a limit of the store, ImportContext returns that error.
 func (s *Store) Import(quadStream interfaces.IStream) {
+	s.importStream(quadStream, nil)
+}
+
+// ImportContext adds the quads of a stream and closes it afterwards. It
+// returns the error of the stream, of ctx when it is cancelled, or the
+// LimitError of the first quad that would exceed a limit of the store. The
+// quads that were added before an error stay in the store.
+func (s *Store) ImportContext(ctx context.Context, quads interfaces.IQuadStream) error {
+	defer quads.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case quad, ok := <-quads.Quads():
+			if !ok {
+				return quads.Err()
+			}
+			if quad == nil {
+				continue
+			}
+			if _, err := s.TryAddQuad(quad); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Merge adds the quads of a stream like Import, but first gives their blank
+// nodes fresh labels, so that they are not the same as the blank nodes that
+// are in the store already. The same label in the stream gets the same new
+// label.
+func (s *Store) Merge(quadStream interfaces.IStream) {
+	s.importStream(quadStream, NewBlankNodeScope().RelabelQuad)
+}
+
+// importStream adds the quads of a stream, passed through relabel when it is
+// not nil, until a quad exceeds a limit. The rest of the stream is drained so
+// that its producer does not block.
+func (s *Store) importStream(quadStream interfaces.IStream, relabel func(interfaces.IQuad) interfaces.IQuad) {
+	var err error
 	for quad := range quadStream {
-		if quad != nil {
-			s.AddQuad(quad)
+		if quad == nil || err != nil {
+			continue
+		}
+		if relabel != nil {
+			quad = relabel(quad)
 		}
+		_, err = s.TryAddQuad(quad)
 	}
 }
 
+// ForEach calls callback for every quad in the store at the time of the call.
+// Use MatchSeq(nil, nil, nil, nil) to stop before the last quad.
 func (s *Store) ForEach(callback func(interfaces.IQuad)) {
-	for key, value := range s.entries {
-		if key[0] != ',' && key[len(key)-1] != ',' {
-			for _, quad := range value {
-				callback(quad)
-			}
-		}
+	for quad := range s.snapshot(latestVersion, nil, nil, nil, nil) {
+		callback(quad)
 	}
 }
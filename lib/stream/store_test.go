@@ -32,6 +32,59 @@ func TestStoreImport(t *testing.T) {
 	}
 }
 
+func TestStore_Merge(t *testing.T) {
+	store := NewStore().(*Store)
+	p := NewNamedNode("http://example.com/p")
+	store.AddQuadFromTerms(NewBlankNode("a"), p, NewBlankNode("b"), nil)
+	first, _ := NewQuad(NewBlankNode("a"), p, NewBlankNode("b"), nil)
+	second, _ := NewQuad(NewBlankNode("b"), p, NewNamedNode("http://example.com/o"), nil)
+	store.Merge(interfaces.IStream(ArrayToStream([]interfaces.IQuad{first, nil, second})))
+	if store.Size() != 3 {
+		t.Fatalf("Expected the merged quads to be added next to the same quad, got %d quads", store.Size())
+	}
+	merged := Stream(store.Match(nil, p, NewNamedNode("http://example.com/o"), nil)).ToArray()
+	if len(merged) != 1 || merged[0].GetSubject().Equals(NewBlankNode("b")) {
+		t.Fatalf("Expected the blank nodes of the stream to be relabeled")
+	}
+	if len(Stream(store.Match(nil, p, merged[0].GetSubject(), nil)).ToArray()) != 1 {
+		t.Errorf("Expected the same label in the stream to get the same new label")
+	}
+}
+
+func TestStore_MergeFreshLabels(t *testing.T) {
+	store := NewStore().(*Store)
+	p := NewNamedNode("http://example.com/p")
+	for i := 0; i < 100; i++ {
+		store.AddQuadFromTerms(NewBlankNode("n3-"+strconv.Itoa(i)), p, NewNamedNode("http://example.com/o"), nil)
+	}
+	merged, _ := NewQuad(NewBlankNode("n3-0"), p, NewNamedNode("http://example.com/merged"), nil)
+	store.Merge(interfaces.IStream(ArrayToStream([]interfaces.IQuad{merged})))
+	quads := Stream(store.Match(nil, p, NewNamedNode("http://example.com/merged"), nil)).ToArray()
+	if store.Size() != 101 || len(quads) != 1 {
+		t.Fatalf("Expected the merged quad to be added, got %d quads", store.Size())
+	}
+	if len(Stream(store.Match(quads[0].GetSubject(), nil, nil, nil)).ToArray()) != 1 {
+		t.Errorf("Expected the merged blank node %s not to be a blank node of the store", quads[0].GetSubject().ToString())
+	}
+}
+
+func TestStore_LanguageCase(t *testing.T) {
+	store := NewStore().(*Store)
+	subject, predicate := NewNamedNode("http://example.com/s"), NewNamedNode("http://example.com/p")
+	store.AddQuadFromTerms(subject, predicate, NewStringLiteral("colour", "en-gb"), nil)
+	quad, _ := NewQuad(subject, predicate, NewStringLiteral("colour", "EN-GB"), nil)
+	if !store.Has(quad) {
+		t.Errorf("Expected the store to find a literal with a language in another case")
+	}
+	if store.AddQuadFromTerms(subject, predicate, NewStringLiteral("colour", "En-Gb"), nil) || store.Size() != 1 {
+		t.Errorf("Expected a literal with a language in another case not to be added again")
+	}
+	quad, _ = NewQuad(subject, predicate, NewStringLiteral("colour", "en-gb--ltr"), nil)
+	if store.Has(quad) {
+		t.Errorf("Expected a literal with a direction not to equal one without")
+	}
+}
+
 func TestAddQuadFromTerms_NilTerms(t *testing.T) {
 	store := NewStore()
 
@@ -854,3 +907,115 @@ func TestConcurrentModifications(t *testing.T) {
 		)
 	}
 }
+
+// TestStore_SnapshotStress checks that readers never see half of a change.
+// Writers only add and remove pairs of quads at once, so every read has to
+// see an even number of quads. Run it with -race.
+func TestStore_SnapshotStress(t *testing.T) {
+	store := NewStore().(*Store)
+	left := NewNamedNode("left")
+	right := NewNamedNode("right")
+	stop := make(chan struct{})
+	var writers, readers sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		writers.Add(1)
+		go func(w int) {
+			defer writers.Done()
+			for i := 0; i < 300; i++ {
+				subject := NewNamedNode("subject" + strconv.Itoa(w*10+i%10))
+				graph := NewNamedNode("graph" + strconv.Itoa(i%10))
+				if i%3 == 2 {
+					store.RemoveMatches(subject, nil, nil, graph)
+					continue
+				}
+				transaction := store.Begin()
+				transaction.AddQuadFromTerms(subject, left, NewIntegerLiteral(i), graph)
+				transaction.AddQuadFromTerms(subject, right, NewIntegerLiteral(i), graph)
+				_ = transaction.Commit()
+			}
+		}(w)
+	}
+
+	read := func(check func() bool) {
+		defer readers.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if !check() {
+				return
+			}
+		}
+	}
+	readers.Add(4)
+	go read(func() bool {
+		if size := store.Size(); size%2 != 0 {
+			t.Errorf("Expected an even size, got %d", size)
+			return false
+		}
+		return true
+	})
+	go read(func() bool {
+		count := 0
+		store.ForEach(func(interfaces.IQuad) { count++ })
+		if count%2 != 0 {
+			t.Errorf("Expected ForEach to visit an even number of quads, got %d", count)
+			return false
+		}
+		return true
+	})
+	go read(func() bool {
+		counts := map[string]int{}
+		for quad := range store.Match(nil, nil, nil, nil) {
+			counts[quad.GetSubject().GetValue()+" "+quad.GetObject().GetValue()+" "+quad.GetGraph().GetValue()]++
+		}
+		for key, count := range counts {
+			if count != 2 {
+				t.Errorf("Expected both quads of %s, got %d", key, count)
+				return false
+			}
+		}
+		return true
+	})
+	go read(func() bool {
+		transaction := store.Begin()
+		defer transaction.Rollback()
+		lefts := 0
+		for range transaction.MatchSeq(nil, left, nil, nil) {
+			lefts++
+		}
+		rights := 0
+		for range transaction.MatchSeq(nil, right, nil, nil) {
+			rights++
+		}
+		if lefts != rights || lefts*2 != transaction.Size() {
+			t.Errorf("Expected a transaction to read one version, got %d, %d and size %d", lefts, rights, transaction.Size())
+			return false
+		}
+		return true
+	})
+
+	writers.Wait()
+	close(stop)
+	readers.Wait()
+	if store.Size() != Stream(store.Match(nil, nil, nil, nil)).Count() {
+		t.Errorf("Expected Size to agree with Match")
+	}
+}
+
+func TestStore_MatchAtCallTime(t *testing.T) {
+	store := NewStore()
+	quads := testQuads(3)
+	store.AddQuad(quads[0])
+	store.AddQuad(quads[1])
+	stream := store.Match(nil, nil, nil, nil)
+	store.RemoveQuad(quads[0])
+	store.AddQuad(quads[2])
+	expected := []string{quads[0].ToString(), quads[1].ToString()}
+	if got := matchStrings(stream); !equalStrings(expected, got) {
+		t.Errorf("Expected the quads at the time of the call %v, got %v", expected, got)
+	}
+}
@@ -1,6 +1,10 @@
 package rdfgo
 
-import "github.com/maartyman/rdfgo/interfaces"
+import (
+	"context"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
 
 type Stream interfaces.IStream
 
@@ -39,22 +43,31 @@ func (s Stream) Count() int {
 	return count
 }
 
+// ToQuadStream adapts the stream to a QuadStream that can be cancelled.
+func (s Stream) ToQuadStream(ctx context.Context) *QuadStream {
+	return FromIStream(ctx, s.ToIStream())
+}
+
 func (s Stream) Import(stream interfaces.IStream) {
+	s.ImportContext(context.Background(), FromIStream(context.Background(), stream))
+}
+
+// ImportContext forwards the quads of stream to s and closes s afterwards.
+// When ctx is cancelled, s is closed and stream is closed as well.
+func (s Stream) ImportContext(ctx context.Context, stream interfaces.IQuadStream) {
 	go func() {
-		for quad := range stream {
-			s <- quad
+		defer close(s)
+		defer stream.Close()
+		for quad := range stream.Quads() {
+			select {
+			case s <- quad:
+			case <-ctx.Done():
+				return
+			}
 		}
-		close(s)
 	}()
 }
 
 func ArrayToStream(quads []interfaces.IQuad) Stream {
-	channel := make(interfaces.IStream)
-	go func() {
-		for _, quad := range quads {
-			channel <- quad
-		}
-		close(channel)
-	}()
-	return Stream(channel)
+	return Stream(ArrayToQuadStream(context.Background(), quads).ToIStream())
 }
@@ -0,0 +1,229 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+var SubscriptionOverflowError = errors.New("subscription fell too far behind")
+
+type EventType int
+
+const (
+	QuadAdded EventType = iota
+	QuadRemoved
+)
+
+// QuadEvent is a change to a Store. Events are delivered in the order they
+// were committed, and all events of one commit have the same Version.
+type QuadEvent struct {
+	Type    EventType
+	Quad    interfaces.IQuad
+	Version uint64
+}
+
+type OverflowPolicy int
+
+const (
+	// BlockWriters makes writes to the store wait until the subscriber has
+	// caught up. A write only waits after it is committed, so the events of
+	// one write are all queued: RemoveMatches or DeleteGraph over a million
+	// quads queues a million events, however small Buffer is. A subscriber
+	// that writes to the store itself while it is behind would wait for
+	// itself, so it should use CloseSubscription.
+	BlockWriters OverflowPolicy = iota
+	// CloseSubscription closes a subscription that falls behind. Its Err
+	// returns SubscriptionOverflowError, and the subscriber can subscribe
+	// again and read the store to catch up.
+	CloseSubscription
+)
+
+// SubscriptionOptions filters the events of a subscription by a quad
+// pattern, where nil terms and variables match anything. Buffer is the
+// number of events that may wait for the subscriber before Overflow applies,
+// it defaults to 1024.
+type SubscriptionOptions struct {
+	Subject   interfaces.ITerm
+	Predicate interfaces.ITerm
+	Object    interfaces.ITerm
+	Graph     interfaces.ITerm
+	Buffer    int
+	Overflow  OverflowPolicy
+}
+
+// Subscription delivers the changes to a Store after Subscribe was called.
+type Subscription struct {
+	store   *Store
+	options SubscriptionOptions
+	events  chan QuadEvent
+	queue   []QuadEvent
+	err     error
+	closed  bool
+	stop    chan struct{}
+	mux     sync.Mutex
+	cond    *sync.Cond
+}
+
+// Subscribe registers for the changes to the store that match the pattern in
+// the options. The subscription ends when ctx is cancelled or Close is
+// called.
+func (s *Store) Subscribe(ctx context.Context, options ...SubscriptionOptions) *Subscription {
+	x := &Subscription{
+		store:  s,
+		events: make(chan QuadEvent),
+		stop:   make(chan struct{}),
+	}
+	if len(options) > 0 {
+		x.options = options[0]
+	}
+	if x.options.Buffer <= 0 {
+		x.options.Buffer = 1024
+	}
+	x.options.Subject, x.options.Predicate, x.options.Object, x.options.Graph = convertVariablesToNil(
+		x.options.Subject, x.options.Predicate, x.options.Object, x.options.Graph,
+	)
+	x.cond = sync.NewCond(&x.mux)
+
+	s.mux.Lock()
+	if s.subscriptions == nil {
+		s.subscriptions = make(map[*Subscription]struct{})
+	}
+	s.subscriptions[x] = struct{}{}
+	s.mux.Unlock()
+
+	stopContext := context.AfterFunc(ctx, func() {
+		x.end(ctx.Err())
+	})
+	go func() {
+		defer stopContext()
+		x.deliver()
+	}()
+	return x
+}
+
+// Events returns the channel the events are delivered on. It is closed when
+// the subscription ends.
+func (x *Subscription) Events() <-chan QuadEvent {
+	return x.events
+}
+
+// Err returns why the subscription ended: the error of its context,
+// SubscriptionOverflowError, or nil after Close.
+func (x *Subscription) Err() error {
+	x.mux.Lock()
+	defer x.mux.Unlock()
+	return x.err
+}
+
+// Close ends the subscription. Events that were not delivered yet are
+// dropped.
+func (x *Subscription) Close() {
+	x.end(nil)
+}
+
+func (x *Subscription) end(err error) {
+	x.store.mux.Lock()
+	delete(x.store.subscriptions, x)
+	x.store.mux.Unlock()
+
+	x.mux.Lock()
+	defer x.mux.Unlock()
+	if x.closed {
+		return
+	}
+	x.closed = true
+	x.err = err
+	x.queue = nil
+	close(x.stop)
+	x.cond.Broadcast()
+}
+
+func (x *Subscription) deliver() {
+	defer close(x.events)
+	for {
+		x.mux.Lock()
+		for len(x.queue) == 0 && !x.closed {
+			x.cond.Wait()
+		}
+		if x.closed {
+			x.mux.Unlock()
+			return
+		}
+		event := x.queue[0]
+		x.queue = x.queue[1:]
+		x.cond.Broadcast()
+		x.mux.Unlock()
+
+		select {
+		case x.events <- event:
+		case <-x.stop:
+			return
+		}
+	}
+}
+
+// push queues an event of a commit and reports whether the subscription is
+// still open. The store calls it with its lock held, so the events are
+// queued in commit order.
+func (x *Subscription) push(event QuadEvent) bool {
+	x.mux.Lock()
+	defer x.mux.Unlock()
+	if x.closed {
+		return false
+	}
+	if !x.store.quadMatches(event.Quad, x.options.Subject, x.options.Predicate, x.options.Object, x.options.Graph) {
+		return true
+	}
+	if len(x.queue) >= x.options.Buffer && x.options.Overflow == CloseSubscription {
+		x.closed = true
+		x.err = SubscriptionOverflowError
+		x.queue = nil
+		close(x.stop)
+		x.cond.Broadcast()
+		return false
+	}
+	x.queue = append(x.queue, event)
+	x.cond.Broadcast()
+	return true
+}
+
+// wait blocks until the queue fits in the buffer again.
+func (x *Subscription) wait() {
+	x.mux.Lock()
+	defer x.mux.Unlock()
+	for len(x.queue) > x.options.Buffer && !x.closed {
+		x.cond.Wait()
+	}
+}
+
+// publish queues an event for every subscription. The caller has to hold the
+// lock.
+func (s *Store) publish(event QuadEvent) {
+	for x := range s.subscriptions {
+		if !x.push(event) {
+			delete(s.subscriptions, x)
+		}
+	}
+}
+
+// write runs change with the lock held, and then waits for the subscribers
+// that block writers to catch up with the events of the change.
+func (s *Store) write(change func()) {
+	var blocking []*Subscription
+	func() {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		change()
+		for x := range s.subscriptions {
+			if x.options.Overflow == BlockWriters {
+				blocking = append(blocking, x)
+			}
+		}
+	}()
+	for _, x := range blocking {
+		x.wait()
+	}
+}
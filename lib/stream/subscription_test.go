@@ -0,0 +1,130 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func TestSubscription_Order(t *testing.T) {
+	store := NewStore().(*Store)
+	subscription := store.Subscribe(context.Background())
+	defer subscription.Close()
+	quads := testQuads(3)
+
+	store.AddQuad(quads[0])
+	store.AddQuad(quads[0])
+	transaction := store.Begin()
+	transaction.AddQuad(quads[1])
+	transaction.AddQuad(quads[2])
+	_ = transaction.Commit()
+	store.RemoveMatches(nil, nil, nil, nil)
+
+	var events []QuadEvent
+	for len(events) < 6 {
+		events = append(events, <-subscription.Events())
+	}
+	added, removed := 0, 0
+	for i, event := range events {
+		if i > 0 && event.Version < events[i-1].Version {
+			t.Errorf("Expected the events in commit order, got %v", events)
+		}
+		if event.Type == QuadAdded {
+			added++
+		} else {
+			removed++
+		}
+	}
+	if added != 3 || removed != 3 || events[0].Quad.ToString() != quads[0].ToString() {
+		t.Errorf("Expected 3 added and 3 removed events, got %v", events)
+	}
+	if events[1].Version != events[2].Version || events[3].Version != events[5].Version {
+		t.Errorf("Expected the events of one commit to have the same version, got %v", events)
+	}
+}
+
+func TestSubscription_Pattern(t *testing.T) {
+	store := NewStore().(*Store)
+	subscription := store.Subscribe(context.Background(), SubscriptionOptions{
+		Subject: NewVariable("s"),
+		Object:  NewIntegerLiteral(1),
+	})
+	defer subscription.Close()
+	for _, quad := range testQuads(3) {
+		store.AddQuad(quad)
+	}
+	store.RemoveQuad(testQuads(3)[1])
+
+	first, second := <-subscription.Events(), <-subscription.Events()
+	if first.Type != QuadAdded || second.Type != QuadRemoved || first.Quad.GetObject().GetValue() != "1" {
+		t.Errorf("Expected the events of the matching quad only, got %v and %v", first, second)
+	}
+}
+
+func TestSubscription_CloseSubscription(t *testing.T) {
+	store := NewStore().(*Store)
+	subscription := store.Subscribe(context.Background(), SubscriptionOptions{Buffer: 2, Overflow: CloseSubscription})
+	for _, quad := range testQuads(5) {
+		store.AddQuad(quad)
+	}
+	for range subscription.Events() {
+	}
+	if !errors.Is(subscription.Err(), SubscriptionOverflowError) {
+		t.Errorf("Expected an overflow, got %v", subscription.Err())
+	}
+	if len(store.subscriptions) != 0 {
+		t.Errorf("Expected the subscription to be removed from the store")
+	}
+}
+
+func TestSubscription_BlockWriters(t *testing.T) {
+	store := NewStore().(*Store)
+	subscription := store.Subscribe(context.Background(), SubscriptionOptions{Buffer: 1})
+	defer subscription.Close()
+	var written atomic.Int32
+	go func() {
+		for _, quad := range testQuads(10) {
+			store.AddQuad(quad)
+			written.Add(1)
+		}
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	if written.Load() >= 10 {
+		t.Errorf("Expected the writer to wait for the subscriber")
+	}
+	if store.Size() == 0 {
+		t.Errorf("Expected readers not to be blocked")
+	}
+	for i := 0; i < 10; i++ {
+		if event := <-subscription.Events(); event.Quad.GetObject().GetValue() != testQuads(10)[i].GetObject().GetValue() {
+			t.Errorf("Expected the events in order, got %s at %d", event.Quad.ToString(), i)
+		}
+	}
+}
+
+func TestSubscription_Cancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+	store := NewStore().(*Store)
+	ctx, cancel := context.WithCancel(context.Background())
+	subscription := store.Subscribe(ctx)
+	closed := store.Subscribe(context.Background())
+	store.AddQuad(testQuads(1)[0])
+	cancel()
+	closed.Close()
+	closed.Close()
+	for range subscription.Events() {
+	}
+	for range closed.Events() {
+	}
+	if !errors.Is(subscription.Err(), context.Canceled) || closed.Err() != nil {
+		t.Errorf("Expected a cancelled and a closed subscription, got %v and %v", subscription.Err(), closed.Err())
+	}
+	store.AddQuad(testQuads(2)[1])
+	waitForGoroutines(t, before)
+}
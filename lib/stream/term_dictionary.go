@@ -0,0 +1,109 @@
+package rdfgo
+
+import (
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	langtag "github.com/maartyman/rdfgo/lib/langtag"
+)
+
+// termID identifies a term in a termDictionary. IDs start at 1, so the zero
+// value can be used for terms that are not bound.
+type termID uint32
+
+// termDictionary interns terms into integer IDs. It only grows: IDs are never
+// reused, so an ID that was handed out stays valid while the store changes.
+type termDictionary struct {
+	ids   map[string]termID
+	terms []interfaces.ITerm
+}
+
+func newTermDictionary() *termDictionary {
+	return &termDictionary{
+		ids: make(map[string]termID),
+	}
+}
+
+// termKey returns a string that is equal for two terms exactly when the terms
+// are equal. Unlike ToString it can not be confused by the characters in a
+// literal or by a named node with an empty IRI.
+func termKey(term interfaces.ITerm) string {
+	var builder strings.Builder
+	writeTermKey(&builder, term)
+	return builder.String()
+}
+
+func writeTermKey(builder *strings.Builder, term interfaces.ITerm) {
+	switch term.GetType() {
+	case interfaces.NamedNodeType:
+		builder.WriteByte('N')
+		builder.WriteString(term.GetValue())
+	case interfaces.BlankNodeType:
+		builder.WriteByte('B')
+		builder.WriteString(term.GetValue())
+	case interfaces.LiteralType:
+		literal := term.(interfaces.ILiteral)
+		builder.WriteByte('L')
+		builder.WriteString(strings.ToLower(langtag.JoinDirection(literal.GetLanguage(), literal.GetDirection())))
+		builder.WriteByte(0)
+		if literal.GetDatatype() != nil {
+			builder.WriteString(literal.GetDatatype().GetValue())
+		}
+		builder.WriteByte(0)
+		builder.WriteString(term.GetValue())
+	case interfaces.DefaultGraphType:
+		builder.WriteByte('D')
+	case interfaces.QuadType:
+		quad := term.(interfaces.IQuad)
+		builder.WriteByte('Q')
+		for _, component := range []interfaces.ITerm{quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph()} {
+			writeTermKey(builder, component)
+			builder.WriteByte(0)
+		}
+	default:
+		builder.WriteByte('V')
+		builder.WriteString(term.GetValue())
+	}
+}
+
+// lookup returns the ID of a term without adding it.
+func (d *termDictionary) lookup(term interfaces.ITerm) (termID, bool) {
+	id, ok := d.ids[termKey(term)]
+	return id, ok
+}
+
+// intern returns the ID of a term, adding it when it is new.
+func (d *termDictionary) intern(term interfaces.ITerm) termID {
+	key := termKey(term)
+	if id, ok := d.ids[key]; ok {
+		return id
+	}
+	d.terms = append(d.terms, term)
+	id := termID(len(d.terms))
+	d.ids[key] = id
+	return id
+}
+
+func (d *termDictionary) term(id termID) interfaces.ITerm {
+	return d.terms[id-1]
+}
+
+// lookupQuad returns the IDs of the terms of a quad, or false when one of the
+// terms is not in the dictionary.
+func (d *termDictionary) lookupQuad(quad interfaces.IQuad) (quadIDs, bool) {
+	var ids quadIDs
+	for i, term := range [4]interfaces.ITerm{quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph()} {
+		id, ok := d.lookup(term)
+		if !ok {
+			return ids, false
+		}
+		ids[i] = id
+	}
+	return ids, true
+}
+
+func (d *termDictionary) quad(ids quadIDs) interfaces.IQuad {
+	quad, _ := NewQuad(d.term(ids[0]), d.term(ids[1]), d.term(ids[2]), d.term(ids[3]))
+	return quad
+}
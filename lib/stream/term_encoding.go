@@ -0,0 +1,132 @@
+package rdfgo
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+	langtag "github.com/maartyman/rdfgo/lib/langtag"
+)
+
+var CorruptTermError = errors.New("corrupt term encoding")
+
+const (
+	namedNodeTag    byte = 'N'
+	blankNodeTag    byte = 'B'
+	literalTag      byte = 'L'
+	typedLiteralTag byte = 'T'
+	defaultGraphTag byte = 'D'
+	quadTag         byte = 'Q'
+	variableTag     byte = 'V'
+)
+
+func appendString(buffer []byte, value string) []byte {
+	buffer = binary.AppendUvarint(buffer, uint64(len(value)))
+	return append(buffer, value...)
+}
+
+// appendTerm appends a binary encoding of term that decodeTerm reads back.
+func appendTerm(buffer []byte, term interfaces.ITerm) []byte {
+	switch term.GetType() {
+	case interfaces.NamedNodeType:
+		return appendString(append(buffer, namedNodeTag), term.GetValue())
+	case interfaces.BlankNodeType:
+		return appendString(append(buffer, blankNodeTag), term.GetValue())
+	case interfaces.LiteralType:
+		literal := term.(interfaces.ILiteral)
+		if literal.GetDatatype() == nil {
+			buffer = appendString(append(buffer, literalTag), literal.GetValue())
+			return appendString(buffer, literal.GetLanguage())
+		}
+		// The direction is written with the language, as NewLiteral reads it.
+		buffer = appendString(append(buffer, typedLiteralTag), literal.GetValue())
+		buffer = appendString(buffer, langtag.JoinDirection(literal.GetLanguage(), literal.GetDirection()))
+		return appendString(buffer, literal.GetDatatype().GetValue())
+	case interfaces.DefaultGraphType:
+		return append(buffer, defaultGraphTag)
+	case interfaces.QuadType:
+		quad := term.(interfaces.IQuad)
+		buffer = append(buffer, quadTag)
+		for _, component := range [4]interfaces.ITerm{quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph()} {
+			buffer = appendTerm(buffer, component)
+		}
+		return buffer
+	default:
+		return appendString(append(buffer, variableTag), term.GetValue())
+	}
+}
+
+type termDecoder struct {
+	data []byte
+	err  error
+}
+
+func (d *termDecoder) string() string {
+	length, n := binary.Uvarint(d.data)
+	if n <= 0 || uint64(len(d.data)-n) < length {
+		d.err = CorruptTermError
+		return ""
+	}
+	value := string(d.data[n : n+int(length)])
+	d.data = d.data[n+int(length):]
+	return value
+}
+
+func (d *termDecoder) term() interfaces.ITerm {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.data) == 0 {
+		d.err = CorruptTermError
+		return nil
+	}
+	tag := d.data[0]
+	d.data = d.data[1:]
+	switch tag {
+	case namedNodeTag:
+		return NewNamedNode(d.string())
+	case blankNodeTag:
+		return NewBlankNode(d.string())
+	case literalTag:
+		value := d.string()
+		return NewLiteral(value, d.string(), nil)
+	case typedLiteralTag:
+		value := d.string()
+		language := d.string()
+		return NewLiteral(value, language, NewNamedNode(d.string()))
+	case defaultGraphTag:
+		return NewDefaultGraph()
+	case quadTag:
+		subject, predicate, object, graph := d.term(), d.term(), d.term(), d.term()
+		if d.err != nil {
+			return nil
+		}
+		quad, err := NewQuad(subject, predicate, object, graph)
+		if err != nil {
+			d.err = CorruptTermError
+		}
+		return quad
+	case variableTag:
+		value := d.string()
+		if d.err != nil || value == "" {
+			d.err = CorruptTermError
+			return nil
+		}
+		return NewVariable(value)
+	default:
+		d.err = CorruptTermError
+		return nil
+	}
+}
+
+// decodeTerm decodes a term written by appendTerm and returns the number of
+// bytes it used.
+func decodeTerm(data []byte) (interfaces.ITerm, int, error) {
+	decoder := termDecoder{data: data}
+	term := decoder.term()
+	if decoder.err != nil {
+		return nil, 0, decoder.err
+	}
+	return term, len(data) - len(decoder.data), nil
+}
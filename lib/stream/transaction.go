@@ -0,0 +1,283 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"maps"
+
+	"github.com/maartyman/rdfgo/interfaces"
+)
+
+var TransactionDoneError = errors.New("transaction is already committed or rolled back")
+var TransactionConflictError = errors.New("transaction conflicts with a concurrent commit")
+
+var _ IStore = (*Transaction)(nil)
+
+// Transaction groups changes to a Store. It reads the version of the store
+// at the time Begin was called, together with its own changes, and Commit
+// applies all changes at once as a single new version. A Transaction is not
+// safe for concurrent use, but any number of transactions can be open on a
+// store at the same time.
+type Transaction struct {
+	store   *Store
+	version uint64
+	size    int
+	added   map[string]interfaces.IQuad
+	removed map[string]interfaces.IQuad
+	done    bool
+}
+
+// Begin starts a transaction. It has to be committed or rolled back, until
+// then the store keeps the quads that the transaction can read.
+func (s *Store) Begin() *Transaction {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.snapshots[s.version]++
+	return &Transaction{
+		store:   s,
+		version: s.version,
+		size:    s.size,
+		added:   make(map[string]interfaces.IQuad),
+		removed: make(map[string]interfaces.IQuad),
+	}
+}
+
+// release ends the transaction. The caller has to hold the lock of the store.
+func (t *Transaction) release() {
+	t.done = true
+	t.store.snapshots[t.version]--
+	if t.store.snapshots[t.version] == 0 {
+		delete(t.store.snapshots, t.version)
+		t.store.vacuum()
+	}
+}
+
+// Commit applies the changes of the transaction. It fails with
+// TransactionConflictError, and applies nothing, when a quad that the
+// transaction changed was also changed by a commit after Begin, and with a
+// LimitError when the changes would exceed a limit of the store.
+func (t *Transaction) Commit() error {
+	if t.done {
+		return TransactionDoneError
+	}
+	s := t.store
+	var err error
+	s.write(func() {
+		defer t.release()
+		for _, changes := range []map[string]interfaces.IQuad{t.added, t.removed} {
+			for key := range changes {
+				if bucket := s.entries[key]; bucket != nil {
+					for _, entry := range bucket.entries {
+						if entry.added > t.version || entry.removed.Load() > t.version {
+							err = TransactionConflictError
+							return
+						}
+					}
+				}
+			}
+		}
+
+		added := make(map[string][]string, len(t.added))
+		bytes := int64(0)
+		for key, quad := range t.added {
+			added[key] = s.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+			bytes += s.cost(quad, added[key])
+		}
+		for key := range t.removed {
+			bytes -= quadBytes(key) + entryBytes + slotBytes
+		}
+		if err = s.checkLimits(len(t.added)-len(t.removed), bytes); err != nil {
+			return
+		}
+
+		version := s.version + 1
+		for _, quad := range t.removed {
+			s.remove(quad, version)
+		}
+		for key, quad := range t.added {
+			s.add(quad, added[key], version)
+		}
+	})
+	return err
+}
+
+// Rollback discards the changes of the transaction. Rolling back a
+// transaction that is already done does nothing.
+func (t *Transaction) Rollback() {
+	if t.done {
+		return
+	}
+	t.store.mux.Lock()
+	defer t.store.mux.Unlock()
+	t.release()
+}
+
+func (t *Transaction) Size() int {
+	return t.size
+}
+
+func (t *Transaction) has(key string) bool {
+	if _, ok := t.added[key]; ok {
+		return true
+	}
+	if _, ok := t.removed[key]; ok {
+		return false
+	}
+	t.store.mux.RLock()
+	defer t.store.mux.RUnlock()
+	return t.store.find(key, t.version) != nil
+}
+
+func (t *Transaction) Has(quad interfaces.IQuad) bool {
+	return t.has(t.store.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())[4])
+}
+
+// AddQuadFromTerms adds a quad to the transaction. It returns false when the
+// quad is invalid, already in the transaction or the transaction is done.
+func (t *Transaction) AddQuadFromTerms(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) bool {
+	quad, ok := newStoreQuad(subject, predicate, object, graph)
+	if !ok || t.done {
+		return false
+	}
+	key := t.store.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())[4]
+	if t.has(key) {
+		return false
+	}
+	if _, ok := t.removed[key]; ok {
+		delete(t.removed, key)
+	} else {
+		t.added[key] = quad
+	}
+	t.size++
+	return true
+}
+
+func (t *Transaction) AddQuad(quad interfaces.IQuad) bool {
+	return t.AddQuadFromTerms(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())
+}
+
+func (t *Transaction) RemoveQuad(quad interfaces.IQuad) {
+	key := t.store.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())[4]
+	if t.done || !t.has(key) {
+		return
+	}
+	if _, ok := t.added[key]; ok {
+		delete(t.added, key)
+	} else {
+		t.removed[key] = quad
+	}
+	t.size--
+}
+
+func (t *Transaction) RemoveMatches(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) {
+	var quads []interfaces.IQuad
+	for quad := range t.MatchSeq(subject, predicate, object, graph) {
+		quads = append(quads, quad)
+	}
+	for _, quad := range quads {
+		t.RemoveQuad(quad)
+	}
+}
+
+func (t *Transaction) Remove(stream interfaces.IStream) {
+	for quad := range stream {
+		if quad != nil {
+			t.RemoveQuad(quad)
+		}
+	}
+}
+
+func (t *Transaction) DeleteGraph(graph interfaces.ITerm) {
+	t.RemoveMatches(nil, nil, nil, graph)
+}
+
+func (t *Transaction) Import(quadStream interfaces.IStream) {
+	for quad := range quadStream {
+		if quad != nil {
+			t.AddQuad(quad)
+		}
+	}
+}
+
+// MatchSeq returns the matching quads of the version the transaction started
+// at, with the changes of the transaction applied.
+func (t *Transaction) MatchSeq(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) iter.Seq[interfaces.IQuad] {
+	stored := t.store.matchAt(t.version, subject, predicate, object, graph)
+	subject, predicate, object, graph = convertVariablesToNil(subject, predicate, object, graph)
+	return func(yield func(interfaces.IQuad) bool) {
+		added := make([]interfaces.IQuad, 0, len(t.added))
+		for _, quad := range t.added {
+			if t.store.quadMatches(quad, subject, predicate, object, graph) {
+				added = append(added, quad)
+			}
+		}
+		for quad := range stored {
+			key := t.store.hashes(quad.GetSubject(), quad.GetPredicate(), quad.GetObject(), quad.GetGraph())[4]
+			if _, ok := t.removed[key]; ok {
+				continue
+			}
+			if !yield(quad) {
+				return
+			}
+		}
+		for _, quad := range added {
+			if !yield(quad) {
+				return
+			}
+		}
+	}
+}
+
+func (t *Transaction) MatchContext(
+	ctx context.Context,
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) *QuadStream {
+	// The stream is produced in another goroutine, so it reads a copy of the
+	// changes that later writes to the transaction do not touch.
+	view := *t
+	view.added = maps.Clone(t.added)
+	view.removed = maps.Clone(t.removed)
+	quads := view.MatchSeq(subject, predicate, object, graph)
+	return NewQuadStream(ctx, func(ctx context.Context, emit func(interfaces.IQuad) bool) error {
+		for quad := range quads {
+			if !emit(quad) {
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
+func (t *Transaction) Match(
+	subject interfaces.ITerm,
+	predicate interfaces.ITerm,
+	object interfaces.ITerm,
+	graph interfaces.ITerm,
+) interfaces.IStream {
+	return t.MatchContext(context.Background(), subject, predicate, object, graph).ToIStream()
+}
+
+func (t *Transaction) ForEach(callback func(interfaces.IQuad)) {
+	for quad := range t.MatchSeq(nil, nil, nil, nil) {
+		callback(quad)
+	}
+}
@@ -0,0 +1,118 @@
+package rdfgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func TestTransaction_SameAsStore(t *testing.T) {
+	testSameAsStore(t, func() IStore {
+		return NewStore().(*Store).Begin()
+	})
+}
+
+func TestTransaction_Isolation(t *testing.T) {
+	store := NewStore().(*Store)
+	quads := testQuads(4)
+	store.AddQuad(quads[0])
+	store.AddQuad(quads[1])
+
+	transaction := store.Begin()
+	transaction.RemoveQuad(quads[0])
+	transaction.AddQuad(quads[2])
+	store.AddQuad(quads[3])
+	store.RemoveQuad(quads[1])
+
+	if transaction.Size() != 2 || !transaction.Has(quads[1]) || transaction.Has(quads[3]) || transaction.Has(quads[0]) {
+		t.Errorf("Expected the transaction to read the store as it was at Begin")
+	}
+	if got := Stream(transaction.Match(NewNamedNode("s"), nil, nil, nil)).Count(); got != 2 {
+		t.Errorf("Expected 2 matches in the transaction, got %d", got)
+	}
+	if store.Size() != 2 || store.Has(quads[2]) || !store.Has(quads[0]) {
+		t.Errorf("Expected the store not to see the changes before Commit")
+	}
+
+	if err := transaction.Commit(); err != nil {
+		t.Fatalf("Expected the commit to succeed, got %v", err)
+	}
+	expected := []string{quads[2].ToString(), quads[3].ToString()}
+	if got := matchStrings(store.Match(nil, nil, nil, nil)); !equalStrings(expected, got) {
+		t.Errorf("Expected %v after the commit, got %v", expected, got)
+	}
+	if !errors.Is(transaction.Commit(), TransactionDoneError) || transaction.AddQuad(quads[0]) {
+		t.Errorf("Expected a committed transaction to reject changes")
+	}
+}
+
+func TestTransaction_Conflict(t *testing.T) {
+	store := NewStore().(*Store)
+	quad := testQuads(1)[0]
+	first, second := store.Begin(), store.Begin()
+	first.AddQuad(quad)
+	second.AddQuad(quad)
+	if err := first.Commit(); err != nil {
+		t.Fatalf("Expected the first commit to succeed, got %v", err)
+	}
+	if err := second.Commit(); !errors.Is(err, TransactionConflictError) {
+		t.Errorf("Expected a conflict, got %v", err)
+	}
+	if store.Size() != 1 || len(store.snapshots) != 0 {
+		t.Errorf("Expected 1 quad and no open snapshots, got %d and %d", store.Size(), len(store.snapshots))
+	}
+
+	rolledBack := store.Begin()
+	rolledBack.RemoveQuad(quad)
+	rolledBack.Rollback()
+	rolledBack.Rollback()
+	if !store.Has(quad) || len(store.snapshots) != 0 {
+		t.Errorf("Expected a rollback to discard the changes")
+	}
+}
+
+func TestTransaction_KeepsRemovedQuads(t *testing.T) {
+	store := NewStore().(*Store)
+	for _, quad := range testQuads(100) {
+		store.AddQuad(quad)
+	}
+	transaction := store.Begin()
+	store.RemoveMatches(nil, NewNamedNode("p"), nil, nil)
+	if store.Size() != 0 || Stream(store.Match(nil, nil, nil, nil)).Count() != 0 {
+		t.Errorf("Expected the store to be empty")
+	}
+	if got := Stream(transaction.Match(nil, nil, nil, nil)).Count(); got != 100 {
+		t.Errorf("Expected the transaction to still read 100 quads, got %d", got)
+	}
+	if len(store.all.entries) != 100 {
+		t.Errorf("Expected the removed entries to be kept, got %d", len(store.all.entries))
+	}
+	transaction.Rollback()
+
+	store.AddQuad(testQuads(1)[0])
+	store.RemoveQuad(testQuads(1)[0])
+	if len(store.all.entries) != 0 || len(store.entries) != 0 {
+		t.Errorf("Expected the removed entries to be dropped, got %d entries in %d buckets", len(store.all.entries), len(store.entries))
+	}
+}
+
+func TestTransaction_MatchDuringWrites(t *testing.T) {
+	store := NewStore().(*Store)
+	transaction := store.Begin()
+	for _, quad := range testQuads(50) {
+		transaction.AddQuad(quad)
+	}
+	count := 0
+	for quad := range transaction.MatchSeq(nil, nil, nil, nil) {
+		transaction.RemoveQuad(quad)
+		count++
+	}
+	quadStream := transaction.MatchContext(context.Background(), nil, nil, nil, nil)
+	transaction.AddQuad(testQuads(1)[0])
+	if got, _ := quadStream.Count(); count != 50 || got != 0 || transaction.Size() != 1 {
+		t.Errorf("Expected 50 quads to be removed while iterating, got %d", count)
+	}
+	transaction.Rollback()
+}
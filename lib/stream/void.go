@@ -0,0 +1,253 @@
+package rdfgo
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	voidNamespace = "http://rdfs.org/ns/void#"
+	sdNamespace   = "http://www.w3.org/ns/sparql-service-description#"
+)
+
+// VoIDOptions configures DescribeVoID. Dataset is the subject of the
+// description, it defaults to a blank node. Graph is the graph of the quads
+// of the description, it defaults to the default graph.
+type VoIDOptions struct {
+	Dataset interfaces.ITerm
+	Graph   interfaces.ITerm
+}
+
+// ServiceDescriptionOptions configures DescribeService. Service and Dataset
+// are the subjects of the service and of its default dataset, they default to
+// blank nodes. Passing the Dataset of DescribeVoID links both descriptions.
+// Graph is the graph of the quads of the description.
+type ServiceDescriptionOptions struct {
+	Service interfaces.ITerm
+	Dataset interfaces.ITerm
+	Graph   interfaces.ITerm
+}
+
+// description collects the quads of a generated description.
+type description struct {
+	graph interfaces.ITerm
+	quads []interfaces.IQuad
+}
+
+func newDescription(graph interfaces.ITerm) *description {
+	if graph == nil {
+		graph = NewDefaultGraph()
+	}
+	return &description{graph: graph}
+}
+
+func (d *description) add(subject interfaces.ITerm, predicate string, object interfaces.ITerm) {
+	quad, _ := NewQuad(subject, NewNamedNode(predicate), object, d.graph)
+	d.quads = append(d.quads, quad)
+}
+
+// node returns term, or a fresh blank node when term is nil, so that the
+// nodes of two descriptions stay apart when they are merged.
+func (d *description) node(term interfaces.ITerm) interfaces.ITerm {
+	if term != nil {
+		return term
+	}
+	return NewBlankNode("")
+}
+
+func (d *description) stream() interfaces.IStream {
+	stream := NewStream(len(d.quads))
+	for _, quad := range d.quads {
+		stream <- quad
+	}
+	close(stream)
+	return stream.ToIStream()
+}
+
+// termCounter counts quads per term, and optionally the distinct terms that
+// occur together with each term.
+type termCounter map[string]*termCount
+
+type termCount struct {
+	term     interfaces.ITerm
+	count    int
+	distinct map[string]struct{}
+}
+
+func (c termCounter) count(term interfaces.ITerm) *termCount {
+	key := term.ToString()
+	count := c[key]
+	if count == nil {
+		count = &termCount{term: term, distinct: make(map[string]struct{})}
+		c[key] = count
+	}
+	count.count++
+	return count
+}
+
+// sorted returns the counts ordered by their term.
+func (c termCounter) sorted() []*termCount {
+	counts := make([]*termCount, 0, len(c))
+	for _, key := range slices.Sorted(maps.Keys(c)) {
+		counts = append(counts, c[key])
+	}
+	return counts
+}
+
+// linkset is a graph whose objects are subjects in another graph, linked by
+// a predicate.
+type linkset struct {
+	subjects  string
+	objects   string
+	predicate string
+}
+
+// DescribeVoID walks the quads of a store and returns a VoID description of
+// them: the number of triples, entities, distinct subjects and objects,
+// classes and properties, a partition per property and per class, a subset
+// per graph, and a linkset for every predicate that links the subjects of a
+// graph to the subjects of another graph. Entities are the subjects that are
+// IRIs.
+func DescribeVoID(store IStore, options ...VoIDOptions) interfaces.IStream {
+	var config VoIDOptions
+	if len(options) > 0 {
+		config = options[0]
+	}
+	triples := 0
+	subjects := make(map[string]map[string]struct{}) // the graphs of every subject
+	objects := make(map[string]struct{})
+	entities := make(map[string]struct{})
+	properties, classes, graphs := termCounter{}, termCounter{}, termCounter{}
+	for quad := range store.MatchSeq(nil, nil, nil, nil) {
+		triples++
+		subject, graph := quad.GetSubject().ToString(), quad.GetGraph().ToString()
+		if subjects[subject] == nil {
+			subjects[subject] = make(map[string]struct{})
+		}
+		subjects[subject][graph] = struct{}{}
+		objects[quad.GetObject().ToString()] = struct{}{}
+		if quad.GetSubject().GetType() == interfaces.NamedNodeType {
+			entities[subject] = struct{}{}
+		}
+		properties.count(quad.GetPredicate())
+		graphs.count(quad.GetGraph())
+		if quad.GetPredicate().GetType() == interfaces.NamedNodeType && quad.GetPredicate().GetValue() == rdfType {
+			classes.count(quad.GetObject()).distinct[subject] = struct{}{}
+		}
+	}
+	links := make(map[linkset]*termCount)
+	for quad := range store.MatchSeq(nil, nil, nil, nil) {
+		from := quad.GetGraph().ToString()
+		for to := range subjects[quad.GetObject().ToString()] {
+			if to == from {
+				continue
+			}
+			key := linkset{subjects: from, objects: to, predicate: quad.GetPredicate().ToString()}
+			if links[key] == nil {
+				links[key] = &termCount{term: quad.GetPredicate()}
+			}
+			links[key].count++
+		}
+	}
+
+	d := newDescription(config.Graph)
+	dataset := d.node(config.Dataset)
+	d.add(dataset, rdfType, NewNamedNode(voidNamespace+"Dataset"))
+	d.add(dataset, voidNamespace+"triples", NewIntegerLiteral(triples))
+	d.add(dataset, voidNamespace+"entities", NewIntegerLiteral(len(entities)))
+	d.add(dataset, voidNamespace+"distinctSubjects", NewIntegerLiteral(len(subjects)))
+	d.add(dataset, voidNamespace+"distinctObjects", NewIntegerLiteral(len(objects)))
+	d.add(dataset, voidNamespace+"classes", NewIntegerLiteral(len(classes)))
+	d.add(dataset, voidNamespace+"properties", NewIntegerLiteral(len(properties)))
+	for _, property := range properties.sorted() {
+		partition := d.node(nil)
+		d.add(dataset, voidNamespace+"propertyPartition", partition)
+		d.add(partition, voidNamespace+"property", property.term)
+		d.add(partition, voidNamespace+"triples", NewIntegerLiteral(property.count))
+	}
+	for _, class := range classes.sorted() {
+		partition := d.node(nil)
+		d.add(dataset, voidNamespace+"classPartition", partition)
+		d.add(partition, voidNamespace+"class", class.term)
+		d.add(partition, voidNamespace+"entities", NewIntegerLiteral(len(class.distinct)))
+	}
+	subsets := make(map[string]interfaces.ITerm)
+	for _, graph := range graphs.sorted() {
+		subset := d.node(nil)
+		subsets[graph.term.ToString()] = subset
+		d.add(dataset, voidNamespace+"subset", subset)
+		d.add(subset, rdfType, NewNamedNode(voidNamespace+"Dataset"))
+		if graph.term.GetType() != interfaces.DefaultGraphType {
+			d.add(subset, sdNamespace+"name", graph.term)
+		}
+		d.add(subset, voidNamespace+"triples", NewIntegerLiteral(graph.count))
+	}
+	sorted := slices.SortedFunc(maps.Keys(links), func(a linkset, b linkset) int {
+		return cmp.Or(
+			strings.Compare(a.subjects, b.subjects),
+			strings.Compare(a.objects, b.objects),
+			strings.Compare(a.predicate, b.predicate),
+		)
+	})
+	for _, link := range sorted {
+		subset := d.node(nil)
+		d.add(dataset, voidNamespace+"subset", subset)
+		d.add(subset, rdfType, NewNamedNode(voidNamespace+"Linkset"))
+		d.add(subset, voidNamespace+"subjectsTarget", subsets[link.subjects])
+		d.add(subset, voidNamespace+"objectsTarget", subsets[link.objects])
+		d.add(subset, voidNamespace+"linkPredicate", links[link].term)
+		d.add(subset, voidNamespace+"triples", NewIntegerLiteral(links[link].count))
+	}
+	return d.stream()
+}
+
+// DescribeService returns a SPARQL Service Description of an endpoint that
+// answers SPARQL 1.1 queries over a store. The default dataset of the
+// service has the default graph of the store as its default graph and the
+// other graphs as named graphs, each with its number of triples.
+func DescribeService(store IStore, endpoint interfaces.INamedNode, options ...ServiceDescriptionOptions) interfaces.IStream {
+	var config ServiceDescriptionOptions
+	if len(options) > 0 {
+		config = options[0]
+	}
+	graphs := termCounter{}
+	for quad := range store.MatchSeq(nil, nil, nil, nil) {
+		graphs.count(quad.GetGraph())
+	}
+
+	d := newDescription(config.Graph)
+	service := d.node(config.Service)
+	dataset := d.node(config.Dataset)
+	d.add(service, rdfType, NewNamedNode(sdNamespace+"Service"))
+	d.add(service, sdNamespace+"endpoint", endpoint)
+	d.add(service, sdNamespace+"supportedLanguage", NewNamedNode(sdNamespace+"SPARQL11Query"))
+	d.add(service, sdNamespace+"defaultDataset", dataset)
+	d.add(dataset, rdfType, NewNamedNode(sdNamespace+"Dataset"))
+
+	defaultGraph := d.node(nil)
+	d.add(dataset, sdNamespace+"defaultGraph", defaultGraph)
+	d.add(defaultGraph, rdfType, NewNamedNode(sdNamespace+"Graph"))
+	triples := 0
+	if count := graphs[NewDefaultGraph().ToString()]; count != nil {
+		triples = count.count
+	}
+	d.add(defaultGraph, voidNamespace+"triples", NewIntegerLiteral(triples))
+	for _, graph := range graphs.sorted() {
+		if graph.term.GetType() == interfaces.DefaultGraphType {
+			continue
+		}
+		namedGraph, description := d.node(nil), d.node(nil)
+		d.add(dataset, sdNamespace+"namedGraph", namedGraph)
+		d.add(namedGraph, rdfType, NewNamedNode(sdNamespace+"NamedGraph"))
+		d.add(namedGraph, sdNamespace+"name", graph.term)
+		d.add(namedGraph, sdNamespace+"graph", description)
+		d.add(description, rdfType, NewNamedNode(sdNamespace+"Graph"))
+		d.add(description, voidNamespace+"triples", NewIntegerLiteral(graph.count))
+	}
+	return d.stream()
+}
@@ -0,0 +1,140 @@
+package rdfgo
+
+import (
+	"testing"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+// voidStore has people in the graphs g1 and g2, of which alice in g1 knows
+// bob in g2, and an agent in the default graph.
+func voidStore() IStore {
+	store := NewStore()
+	typeNode := NewNamedNode(rdfType)
+	g1, g2 := NewNamedNode("g1"), NewNamedNode("g2")
+	store.AddQuadFromTerms(NewNamedNode("alice"), typeNode, NewNamedNode("Person"), g1)
+	store.AddQuadFromTerms(NewNamedNode("alice"), NewNamedNode("knows"), NewNamedNode("bob"), g1)
+	store.AddQuadFromTerms(NewNamedNode("alice"), NewNamedNode("name"), NewStringLiteral("Alice", ""), g1)
+	store.AddQuadFromTerms(NewNamedNode("bob"), typeNode, NewNamedNode("Person"), g2)
+	store.AddQuadFromTerms(NewNamedNode("bob"), NewNamedNode("name"), NewStringLiteral("Bob", ""), g2)
+	store.AddQuadFromTerms(NewNamedNode("carol"), typeNode, NewNamedNode("Agent"), NewDefaultGraph())
+	return store
+}
+
+// describedObject returns the object of the only quad with the subject and
+// predicate in a description, or nil.
+func describedObject(t *testing.T, description IStore, subject interfaces.ITerm, predicate string) interfaces.ITerm {
+	t.Helper()
+	objects := SeqToArray(description.MatchSeq(subject, NewNamedNode(predicate), nil, nil))
+	if len(objects) != 1 {
+		t.Errorf("Expected one %s of %s, got %d", predicate, subject.ToString(), len(objects))
+		return nil
+	}
+	return objects[0].GetObject()
+}
+
+// describedSubject returns the subject of the only quad with the predicate
+// and object in a description, or nil.
+func describedSubject(t *testing.T, description IStore, predicate string, object interfaces.ITerm) interfaces.ITerm {
+	t.Helper()
+	subjects := SeqToArray(description.MatchSeq(nil, NewNamedNode(predicate), object, nil))
+	if len(subjects) != 1 {
+		t.Errorf("Expected one subject with %s %s, got %d", predicate, object.ToString(), len(subjects))
+		return nil
+	}
+	return subjects[0].GetSubject()
+}
+
+func TestDescribeVoID(t *testing.T) {
+	dataset := NewNamedNode("dataset")
+	description := Stream(DescribeVoID(voidStore(), VoIDOptions{Dataset: dataset})).ToStore().(IStore)
+
+	counts := map[string]int{
+		"triples":          6,
+		"entities":         3,
+		"distinctSubjects": 3,
+		"distinctObjects":  5,
+		"classes":          2,
+		"properties":       3,
+	}
+	for property, expected := range counts {
+		if got := describedObject(t, description, dataset, voidNamespace+property); got == nil || !got.Equals(NewIntegerLiteral(expected)) {
+			t.Errorf("Expected %d %s, got %v", expected, property, got)
+		}
+	}
+
+	partition := describedSubject(t, description, voidNamespace+"property", NewNamedNode("name"))
+	if got := describedObject(t, description, partition, voidNamespace+"triples"); got == nil || !got.Equals(NewIntegerLiteral(2)) {
+		t.Errorf("Expected the name partition to have 2 triples, got %v", got)
+	}
+	partition = describedSubject(t, description, voidNamespace+"class", NewNamedNode("Person"))
+	if got := describedObject(t, description, partition, voidNamespace+"entities"); got == nil || !got.Equals(NewIntegerLiteral(2)) {
+		t.Errorf("Expected the Person partition to have 2 entities, got %v", got)
+	}
+	subset := describedSubject(t, description, sdNamespace+"name", NewNamedNode("g1"))
+	if got := describedObject(t, description, subset, voidNamespace+"triples"); got == nil || !got.Equals(NewIntegerLiteral(3)) {
+		t.Errorf("Expected g1 to have 3 triples, got %v", got)
+	}
+
+	linkset := describedSubject(t, description, rdfType, NewNamedNode(voidNamespace+"Linkset"))
+	if got := describedObject(t, description, linkset, voidNamespace+"linkPredicate"); got == nil || !got.Equals(NewNamedNode("knows")) {
+		t.Errorf("Expected the linkset to be linked by knows, got %v", got)
+	}
+	if got := describedObject(t, description, linkset, voidNamespace+"subjectsTarget"); got == nil || !got.Equals(subset) {
+		t.Errorf("Expected the linkset to link the subjects of g1, got %v", got)
+	}
+	target := describedSubject(t, description, sdNamespace+"name", NewNamedNode("g2"))
+	if got := describedObject(t, description, linkset, voidNamespace+"objectsTarget"); got == nil || !got.Equals(target) {
+		t.Errorf("Expected the linkset to link to g2, got %v", got)
+	}
+}
+
+func TestDescribeVoID_Empty(t *testing.T) {
+	quads := Stream(DescribeVoID(NewStore(), VoIDOptions{Graph: NewNamedNode("meta")})).ToArray()
+	if len(quads) != 7 {
+		t.Errorf("Expected only the counts of the dataset, got %d quads", len(quads))
+	}
+	for _, quad := range quads {
+		if !quad.GetGraph().Equals(NewNamedNode("meta")) {
+			t.Errorf("Expected the description in the graph meta, got %s", quad.ToString())
+		}
+	}
+}
+
+func TestDescribeVoID_Merge(t *testing.T) {
+	first := Stream(DescribeVoID(voidStore())).ToArray()
+	second := Stream(DescribeVoID(voidStore())).ToArray()
+	description := ArrayToStream(first).ToStore().(IStore)
+	description.Import(ArrayToStream(second).ToIStream())
+	if description.Size() != len(first)+len(second) {
+		t.Errorf("Expected the blank nodes of both descriptions to stay apart, got %d of %d quads", description.Size(), len(first)+len(second))
+	}
+}
+
+func TestDescribeService(t *testing.T) {
+	endpoint := NewNamedNode("http://example.org/sparql")
+	description := Stream(DescribeService(voidStore(), endpoint, ServiceDescriptionOptions{
+		Service: NewNamedNode("service"),
+		Dataset: NewNamedNode("dataset"),
+	})).ToStore().(IStore)
+
+	if got := describedObject(t, description, NewNamedNode("service"), sdNamespace+"endpoint"); got == nil || !got.Equals(endpoint) {
+		t.Errorf("Expected the endpoint %s, got %v", endpoint.ToString(), got)
+	}
+	if got := describedObject(t, description, NewNamedNode("service"), sdNamespace+"defaultDataset"); got == nil || !got.Equals(NewNamedNode("dataset")) {
+		t.Errorf("Expected the default dataset, got %v", got)
+	}
+	defaultGraph := describedObject(t, description, NewNamedNode("dataset"), sdNamespace+"defaultGraph")
+	if got := describedObject(t, description, defaultGraph, voidNamespace+"triples"); got == nil || !got.Equals(NewIntegerLiteral(1)) {
+		t.Errorf("Expected the default graph to have 1 triple, got %v", got)
+	}
+	if got := SeqCount(description.MatchSeq(NewNamedNode("dataset"), NewNamedNode(sdNamespace+"namedGraph"), nil, nil)); got != 2 {
+		t.Errorf("Expected 2 named graphs, got %d", got)
+	}
+	namedGraph := describedSubject(t, description, sdNamespace+"name", NewNamedNode("g2"))
+	graph := describedObject(t, description, namedGraph, sdNamespace+"graph")
+	if got := describedObject(t, description, graph, voidNamespace+"triples"); got == nil || !got.Equals(NewIntegerLiteral(2)) {
+		t.Errorf("Expected g2 to have 2 triples, got %v", got)
+	}
+}
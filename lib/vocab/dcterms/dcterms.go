@@ -0,0 +1,217 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/dcterms.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the DCMI Metadata Terms.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://purl.org/dc/terms/"
+	Prefix    = "dcterms"
+)
+
+var (
+	Agent                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Agent")
+	AgentClass                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AgentClass")
+	BibliographicResource        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "BibliographicResource")
+	Box                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Box")
+	DCMIType                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DCMIType")
+	DDC                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DDC")
+	FileFormat                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "FileFormat")
+	Frequency                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Frequency")
+	IMT                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "IMT")
+	ISO3166                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ISO3166")
+	ISO639_2                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ISO639-2")
+	ISO639_3                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ISO639-3")
+	Jurisdiction                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Jurisdiction")
+	LCC                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LCC")
+	LCSH                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LCSH")
+	LicenseDocument              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LicenseDocument")
+	LinguisticSystem             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LinguisticSystem")
+	Location                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Location")
+	LocationPeriodOrJurisdiction interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LocationPeriodOrJurisdiction")
+	MESH                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MESH")
+	MediaType                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MediaType")
+	MediaTypeOrExtent            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MediaTypeOrExtent")
+	MethodOfAccrual              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MethodOfAccrual")
+	MethodOfInstruction          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MethodOfInstruction")
+	NLM                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NLM")
+	Period                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Period")
+	PeriodOfTime                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PeriodOfTime")
+	PhysicalMedium               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PhysicalMedium")
+	PhysicalResource             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PhysicalResource")
+	Point                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Point")
+	Policy                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Policy")
+	ProvenanceStatement          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ProvenanceStatement")
+	RFC1766                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "RFC1766")
+	RFC3066                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "RFC3066")
+	RFC4646                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "RFC4646")
+	RFC5646                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "RFC5646")
+	RightsStatement              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "RightsStatement")
+	SizeOrDuration               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SizeOrDuration")
+	Standard                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Standard")
+	TGN                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "TGN")
+	UDC                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "UDC")
+	URI                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "URI")
+	W3CDTF                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "W3CDTF")
+	Abstract                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "abstract")
+	AccessRights                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "accessRights")
+	AccrualMethod                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "accrualMethod")
+	AccrualPeriodicity           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "accrualPeriodicity")
+	AccrualPolicy                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "accrualPolicy")
+	Alternative                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "alternative")
+	Audience                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "audience")
+	Available                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "available")
+	BibliographicCitation        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "bibliographicCitation")
+	ConformsTo                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "conformsTo")
+	Contributor                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "contributor")
+	Coverage                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "coverage")
+	Created                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "created")
+	Creator                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "creator")
+	Date                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "date")
+	DateAccepted                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dateAccepted")
+	DateCopyrighted              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dateCopyrighted")
+	DateSubmitted                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dateSubmitted")
+	Description                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "description")
+	EducationLevel               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "educationLevel")
+	Extent                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "extent")
+	Format                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "format")
+	HasFormat                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasFormat")
+	HasPart                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasPart")
+	HasVersion                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasVersion")
+	Identifier                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "identifier")
+	InstructionalMethod          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "instructionalMethod")
+	IsFormatOf                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isFormatOf")
+	IsPartOf                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isPartOf")
+	IsReferencedBy               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isReferencedBy")
+	IsReplacedBy                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isReplacedBy")
+	IsRequiredBy                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isRequiredBy")
+	IsVersionOf                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isVersionOf")
+	Issued                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "issued")
+	Language                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "language")
+	License                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "license")
+	Mediator                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "mediator")
+	Medium                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "medium")
+	Modified                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "modified")
+	Provenance                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "provenance")
+	Publisher                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "publisher")
+	References                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "references")
+	Relation                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "relation")
+	Replaces                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "replaces")
+	Requires                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "requires")
+	Rights                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rights")
+	RightsHolder                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rightsHolder")
+	Source                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "source")
+	Spatial                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "spatial")
+	Subject                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "subject")
+	TableOfContents              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "tableOfContents")
+	Temporal                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "temporal")
+	Title                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "title")
+	Type                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "type")
+	Valid                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "valid")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"Agent":                        Agent,
+	"AgentClass":                   AgentClass,
+	"BibliographicResource":        BibliographicResource,
+	"Box":                          Box,
+	"DCMIType":                     DCMIType,
+	"DDC":                          DDC,
+	"FileFormat":                   FileFormat,
+	"Frequency":                    Frequency,
+	"IMT":                          IMT,
+	"ISO3166":                      ISO3166,
+	"ISO639-2":                     ISO639_2,
+	"ISO639-3":                     ISO639_3,
+	"Jurisdiction":                 Jurisdiction,
+	"LCC":                          LCC,
+	"LCSH":                         LCSH,
+	"LicenseDocument":              LicenseDocument,
+	"LinguisticSystem":             LinguisticSystem,
+	"Location":                     Location,
+	"LocationPeriodOrJurisdiction": LocationPeriodOrJurisdiction,
+	"MESH":                         MESH,
+	"MediaType":                    MediaType,
+	"MediaTypeOrExtent":            MediaTypeOrExtent,
+	"MethodOfAccrual":              MethodOfAccrual,
+	"MethodOfInstruction":          MethodOfInstruction,
+	"NLM":                          NLM,
+	"Period":                       Period,
+	"PeriodOfTime":                 PeriodOfTime,
+	"PhysicalMedium":               PhysicalMedium,
+	"PhysicalResource":             PhysicalResource,
+	"Point":                        Point,
+	"Policy":                       Policy,
+	"ProvenanceStatement":          ProvenanceStatement,
+	"RFC1766":                      RFC1766,
+	"RFC3066":                      RFC3066,
+	"RFC4646":                      RFC4646,
+	"RFC5646":                      RFC5646,
+	"RightsStatement":              RightsStatement,
+	"SizeOrDuration":               SizeOrDuration,
+	"Standard":                     Standard,
+	"TGN":                          TGN,
+	"UDC":                          UDC,
+	"URI":                          URI,
+	"W3CDTF":                       W3CDTF,
+	"abstract":                     Abstract,
+	"accessRights":                 AccessRights,
+	"accrualMethod":                AccrualMethod,
+	"accrualPeriodicity":           AccrualPeriodicity,
+	"accrualPolicy":                AccrualPolicy,
+	"alternative":                  Alternative,
+	"audience":                     Audience,
+	"available":                    Available,
+	"bibliographicCitation":        BibliographicCitation,
+	"conformsTo":                   ConformsTo,
+	"contributor":                  Contributor,
+	"coverage":                     Coverage,
+	"created":                      Created,
+	"creator":                      Creator,
+	"date":                         Date,
+	"dateAccepted":                 DateAccepted,
+	"dateCopyrighted":              DateCopyrighted,
+	"dateSubmitted":                DateSubmitted,
+	"description":                  Description,
+	"educationLevel":               EducationLevel,
+	"extent":                       Extent,
+	"format":                       Format,
+	"hasFormat":                    HasFormat,
+	"hasPart":                      HasPart,
+	"hasVersion":                   HasVersion,
+	"identifier":                   Identifier,
+	"instructionalMethod":          InstructionalMethod,
+	"isFormatOf":                   IsFormatOf,
+	"isPartOf":                     IsPartOf,
+	"isReferencedBy":               IsReferencedBy,
+	"isReplacedBy":                 IsReplacedBy,
+	"isRequiredBy":                 IsRequiredBy,
+	"isVersionOf":                  IsVersionOf,
+	"issued":                       Issued,
+	"language":                     Language,
+	"license":                      License,
+	"mediator":                     Mediator,
+	"medium":                       Medium,
+	"modified":                     Modified,
+	"provenance":                   Provenance,
+	"publisher":                    Publisher,
+	"references":                   References,
+	"relation":                     Relation,
+	"replaces":                     Replaces,
+	"requires":                     Requires,
+	"rights":                       Rights,
+	"rightsHolder":                 RightsHolder,
+	"source":                       Source,
+	"spatial":                      Spatial,
+	"subject":                      Subject,
+	"tableOfContents":              TableOfContents,
+	"temporal":                     Temporal,
+	"title":                        Title,
+	"type":                         Type,
+	"valid":                        Valid,
+}
@@ -0,0 +1,171 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/foaf.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the FOAF vocabulary.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://xmlns.com/foaf/0.1/"
+	Prefix    = "foaf"
+)
+
+var (
+	Agent                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Agent")
+	Document                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Document")
+	Group                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Group")
+	Image                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Image")
+	LabelProperty           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LabelProperty")
+	OnlineAccount           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OnlineAccount")
+	OnlineChatAccount       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OnlineChatAccount")
+	OnlineEcommerceAccount  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OnlineEcommerceAccount")
+	OnlineGamingAccount     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OnlineGamingAccount")
+	Organization            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Organization")
+	Person                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Person")
+	PersonalProfileDocument interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PersonalProfileDocument")
+	Project                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Project")
+	Account                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "account")
+	AccountName             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "accountName")
+	AccountServiceHomepage  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "accountServiceHomepage")
+	Age                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "age")
+	AimChatID               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "aimChatID")
+	Based_near              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "based_near")
+	Birthday                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "birthday")
+	CurrentProject          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "currentProject")
+	Depiction               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "depiction")
+	Depicts                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "depicts")
+	DnaChecksum             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dnaChecksum")
+	FamilyName              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "familyName")
+	Family_name             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "family_name")
+	FirstName               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "firstName")
+	Focus                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "focus")
+	FundedBy                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "fundedBy")
+	Geekcode                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "geekcode")
+	Gender                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gender")
+	GivenName               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "givenName")
+	Givenname               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "givenname")
+	HoldsAccount            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "holdsAccount")
+	Homepage                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "homepage")
+	IcqChatID               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "icqChatID")
+	Img                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "img")
+	Interest                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "interest")
+	IsPrimaryTopicOf        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isPrimaryTopicOf")
+	JabberID                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "jabberID")
+	Knows                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "knows")
+	LastName                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "lastName")
+	Logo                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "logo")
+	Made                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "made")
+	Maker                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maker")
+	Mbox                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "mbox")
+	Mbox_sha1sum            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "mbox_sha1sum")
+	Member                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "member")
+	MembershipClass         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "membershipClass")
+	MsnChatID               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "msnChatID")
+	MyersBriggs             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "myersBriggs")
+	Name                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "name")
+	Nick                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nick")
+	Openid                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "openid")
+	Page                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "page")
+	PastProject             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "pastProject")
+	Phone                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "phone")
+	Plan                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "plan")
+	PrimaryTopic            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "primaryTopic")
+	Publications            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "publications")
+	SchoolHomepage          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "schoolHomepage")
+	Sha1                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sha1")
+	SkypeID                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "skypeID")
+	Status                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "status")
+	Surname                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "surname")
+	Theme                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "theme")
+	Thumbnail               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "thumbnail")
+	Tipjar                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "tipjar")
+	Title                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "title")
+	Topic                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "topic")
+	Topic_interest          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "topic_interest")
+	Weblog                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "weblog")
+	WorkInfoHomepage        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "workInfoHomepage")
+	WorkplaceHomepage       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "workplaceHomepage")
+	YahooChatID             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "yahooChatID")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"Agent":                   Agent,
+	"Document":                Document,
+	"Group":                   Group,
+	"Image":                   Image,
+	"LabelProperty":           LabelProperty,
+	"OnlineAccount":           OnlineAccount,
+	"OnlineChatAccount":       OnlineChatAccount,
+	"OnlineEcommerceAccount":  OnlineEcommerceAccount,
+	"OnlineGamingAccount":     OnlineGamingAccount,
+	"Organization":            Organization,
+	"Person":                  Person,
+	"PersonalProfileDocument": PersonalProfileDocument,
+	"Project":                 Project,
+	"account":                 Account,
+	"accountName":             AccountName,
+	"accountServiceHomepage":  AccountServiceHomepage,
+	"age":                     Age,
+	"aimChatID":               AimChatID,
+	"based_near":              Based_near,
+	"birthday":                Birthday,
+	"currentProject":          CurrentProject,
+	"depiction":               Depiction,
+	"depicts":                 Depicts,
+	"dnaChecksum":             DnaChecksum,
+	"familyName":              FamilyName,
+	"family_name":             Family_name,
+	"firstName":               FirstName,
+	"focus":                   Focus,
+	"fundedBy":                FundedBy,
+	"geekcode":                Geekcode,
+	"gender":                  Gender,
+	"givenName":               GivenName,
+	"givenname":               Givenname,
+	"holdsAccount":            HoldsAccount,
+	"homepage":                Homepage,
+	"icqChatID":               IcqChatID,
+	"img":                     Img,
+	"interest":                Interest,
+	"isPrimaryTopicOf":        IsPrimaryTopicOf,
+	"jabberID":                JabberID,
+	"knows":                   Knows,
+	"lastName":                LastName,
+	"logo":                    Logo,
+	"made":                    Made,
+	"maker":                   Maker,
+	"mbox":                    Mbox,
+	"mbox_sha1sum":            Mbox_sha1sum,
+	"member":                  Member,
+	"membershipClass":         MembershipClass,
+	"msnChatID":               MsnChatID,
+	"myersBriggs":             MyersBriggs,
+	"name":                    Name,
+	"nick":                    Nick,
+	"openid":                  Openid,
+	"page":                    Page,
+	"pastProject":             PastProject,
+	"phone":                   Phone,
+	"plan":                    Plan,
+	"primaryTopic":            PrimaryTopic,
+	"publications":            Publications,
+	"schoolHomepage":          SchoolHomepage,
+	"sha1":                    Sha1,
+	"skypeID":                 SkypeID,
+	"status":                  Status,
+	"surname":                 Surname,
+	"theme":                   Theme,
+	"thumbnail":               Thumbnail,
+	"tipjar":                  Tipjar,
+	"title":                   Title,
+	"topic":                   Topic,
+	"topic_interest":          Topic_interest,
+	"weblog":                  Weblog,
+	"workInfoHomepage":        WorkInfoHomepage,
+	"workplaceHomepage":       WorkplaceHomepage,
+	"yahooChatID":             YahooChatID,
+}
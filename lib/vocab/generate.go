@@ -0,0 +1,16 @@
+// Package rdfgo groups the generated vocabulary packages. Every package holds
+// a variable for each term of its vocabulary, generated by cmd/vocab from the
+// ontology in cmd/vocab/ontologies.
+package rdfgo
+
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/rdf.ttl -namespace http://www.w3.org/1999/02/22-rdf-syntax-ns# -prefix rdf -title "the RDF vocabulary" -out rdf
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/rdfs.ttl -namespace http://www.w3.org/2000/01/rdf-schema# -prefix rdfs -title "the RDF Schema vocabulary" -out rdfs
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/owl.ttl -namespace http://www.w3.org/2002/07/owl# -prefix owl -title "the OWL 2 vocabulary" -out owl
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/xsd.ttl -namespace http://www.w3.org/2001/XMLSchema# -prefix xsd -title "the XML Schema datatypes" -out xsd
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/skos.ttl -namespace http://www.w3.org/2004/02/skos/core# -prefix skos -title "the SKOS vocabulary" -out skos
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/sh.ttl -namespace http://www.w3.org/ns/shacl# -prefix sh -title "the SHACL vocabulary" -out shacl
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/prov.ttl -namespace http://www.w3.org/ns/prov# -prefix prov -title "the PROV-O ontology" -out prov
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/dcterms.ttl -namespace http://purl.org/dc/terms/ -prefix dcterms -title "the DCMI Metadata Terms" -out dcterms
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/foaf.ttl -namespace http://xmlns.com/foaf/0.1/ -prefix foaf -title "the FOAF vocabulary" -out foaf
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/schema.ttl -namespace https://schema.org/ -prefix schema -title "the commonly used part of schema.org" -out schema
+//go:generate go run ../../cmd/vocab -in ../../cmd/vocab/ontologies/geo.ttl -namespace http://www.opengis.net/ont/geosparql# -prefix geo -title "the GeoSPARQL ontology" -out geo
@@ -0,0 +1,151 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/geo.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the GeoSPARQL ontology.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://www.opengis.net/ont/geosparql#"
+	Prefix    = "geo"
+)
+
+var (
+	Feature                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Feature")
+	FeatureCollection          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "FeatureCollection")
+	Geometry                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Geometry")
+	GeometryCollection         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "GeometryCollection")
+	SpatialObject              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SpatialObject")
+	SpatialObjectCollection    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SpatialObjectCollection")
+	AsDGGS                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "asDGGS")
+	AsGML                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "asGML")
+	AsGeoJSON                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "asGeoJSON")
+	AsKML                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "asKML")
+	AsWKT                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "asWKT")
+	CoordinateDimension        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "coordinateDimension")
+	DefaultGeometry            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "defaultGeometry")
+	DggsLiteral                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dggsLiteral")
+	Dimension                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dimension")
+	EhContains                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ehContains")
+	EhCoveredBy                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ehCoveredBy")
+	EhCovers                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ehCovers")
+	EhDisjoint                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ehDisjoint")
+	EhEquals                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ehEquals")
+	EhInside                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ehInside")
+	EhMeet                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ehMeet")
+	EhOverlap                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ehOverlap")
+	GeoJSONLiteral             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "geoJSONLiteral")
+	GmlLiteral                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gmlLiteral")
+	HasArea                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasArea")
+	HasBoundingBox             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasBoundingBox")
+	HasCentroid                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasCentroid")
+	HasDefaultGeometry         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasDefaultGeometry")
+	HasGeometry                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasGeometry")
+	HasLength                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasLength")
+	HasMetricArea              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasMetricArea")
+	HasMetricLength            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasMetricLength")
+	HasMetricPerimeterLength   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasMetricPerimeterLength")
+	HasMetricSize              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasMetricSize")
+	HasMetricSpatialAccuracy   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasMetricSpatialAccuracy")
+	HasMetricSpatialResolution interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasMetricSpatialResolution")
+	HasMetricVolume            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasMetricVolume")
+	HasPerimeterLength         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasPerimeterLength")
+	HasSerialization           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasSerialization")
+	HasSize                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasSize")
+	HasSpatialAccuracy         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasSpatialAccuracy")
+	HasSpatialResolution       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasSpatialResolution")
+	HasVolume                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasVolume")
+	IsEmpty                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isEmpty")
+	IsSimple                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isSimple")
+	KmlLiteral                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "kmlLiteral")
+	Rcc8dc                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rcc8dc")
+	Rcc8ec                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rcc8ec")
+	Rcc8eq                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rcc8eq")
+	Rcc8ntpp                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rcc8ntpp")
+	Rcc8ntppi                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rcc8ntppi")
+	Rcc8po                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rcc8po")
+	Rcc8tpp                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rcc8tpp")
+	Rcc8tppi                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rcc8tppi")
+	SfContains                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sfContains")
+	SfCrosses                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sfCrosses")
+	SfDisjoint                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sfDisjoint")
+	SfEquals                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sfEquals")
+	SfIntersects               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sfIntersects")
+	SfOverlaps                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sfOverlaps")
+	SfTouches                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sfTouches")
+	SfWithin                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sfWithin")
+	SpatialDimension           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "spatialDimension")
+	WktLiteral                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wktLiteral")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"Feature":                    Feature,
+	"FeatureCollection":          FeatureCollection,
+	"Geometry":                   Geometry,
+	"GeometryCollection":         GeometryCollection,
+	"SpatialObject":              SpatialObject,
+	"SpatialObjectCollection":    SpatialObjectCollection,
+	"asDGGS":                     AsDGGS,
+	"asGML":                      AsGML,
+	"asGeoJSON":                  AsGeoJSON,
+	"asKML":                      AsKML,
+	"asWKT":                      AsWKT,
+	"coordinateDimension":        CoordinateDimension,
+	"defaultGeometry":            DefaultGeometry,
+	"dggsLiteral":                DggsLiteral,
+	"dimension":                  Dimension,
+	"ehContains":                 EhContains,
+	"ehCoveredBy":                EhCoveredBy,
+	"ehCovers":                   EhCovers,
+	"ehDisjoint":                 EhDisjoint,
+	"ehEquals":                   EhEquals,
+	"ehInside":                   EhInside,
+	"ehMeet":                     EhMeet,
+	"ehOverlap":                  EhOverlap,
+	"geoJSONLiteral":             GeoJSONLiteral,
+	"gmlLiteral":                 GmlLiteral,
+	"hasArea":                    HasArea,
+	"hasBoundingBox":             HasBoundingBox,
+	"hasCentroid":                HasCentroid,
+	"hasDefaultGeometry":         HasDefaultGeometry,
+	"hasGeometry":                HasGeometry,
+	"hasLength":                  HasLength,
+	"hasMetricArea":              HasMetricArea,
+	"hasMetricLength":            HasMetricLength,
+	"hasMetricPerimeterLength":   HasMetricPerimeterLength,
+	"hasMetricSize":              HasMetricSize,
+	"hasMetricSpatialAccuracy":   HasMetricSpatialAccuracy,
+	"hasMetricSpatialResolution": HasMetricSpatialResolution,
+	"hasMetricVolume":            HasMetricVolume,
+	"hasPerimeterLength":         HasPerimeterLength,
+	"hasSerialization":           HasSerialization,
+	"hasSize":                    HasSize,
+	"hasSpatialAccuracy":         HasSpatialAccuracy,
+	"hasSpatialResolution":       HasSpatialResolution,
+	"hasVolume":                  HasVolume,
+	"isEmpty":                    IsEmpty,
+	"isSimple":                   IsSimple,
+	"kmlLiteral":                 KmlLiteral,
+	"rcc8dc":                     Rcc8dc,
+	"rcc8ec":                     Rcc8ec,
+	"rcc8eq":                     Rcc8eq,
+	"rcc8ntpp":                   Rcc8ntpp,
+	"rcc8ntppi":                  Rcc8ntppi,
+	"rcc8po":                     Rcc8po,
+	"rcc8tpp":                    Rcc8tpp,
+	"rcc8tppi":                   Rcc8tppi,
+	"sfContains":                 SfContains,
+	"sfCrosses":                  SfCrosses,
+	"sfDisjoint":                 SfDisjoint,
+	"sfEquals":                   SfEquals,
+	"sfIntersects":               SfIntersects,
+	"sfOverlaps":                 SfOverlaps,
+	"sfTouches":                  SfTouches,
+	"sfWithin":                   SfWithin,
+	"spatialDimension":           SpatialDimension,
+	"wktLiteral":                 WktLiteral,
+}
@@ -0,0 +1,179 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/owl.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the OWL 2 vocabulary.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://www.w3.org/2002/07/owl#"
+	Prefix    = "owl"
+)
+
+var (
+	AllDifferent              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AllDifferent")
+	AllDisjointClasses        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AllDisjointClasses")
+	AllDisjointProperties     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AllDisjointProperties")
+	Annotation                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Annotation")
+	AnnotationProperty        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AnnotationProperty")
+	AsymmetricProperty        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AsymmetricProperty")
+	Axiom                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Axiom")
+	Class                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Class")
+	DataRange                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DataRange")
+	DatatypeProperty          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DatatypeProperty")
+	DeprecatedClass           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DeprecatedClass")
+	DeprecatedProperty        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DeprecatedProperty")
+	FunctionalProperty        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "FunctionalProperty")
+	InverseFunctionalProperty interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "InverseFunctionalProperty")
+	IrreflexiveProperty       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "IrreflexiveProperty")
+	NamedIndividual           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NamedIndividual")
+	NegativePropertyAssertion interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NegativePropertyAssertion")
+	Nothing                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Nothing")
+	ObjectProperty            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ObjectProperty")
+	Ontology                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Ontology")
+	OntologyProperty          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OntologyProperty")
+	ReflexiveProperty         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ReflexiveProperty")
+	Restriction               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Restriction")
+	SymmetricProperty         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SymmetricProperty")
+	Thing                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Thing")
+	TransitiveProperty        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "TransitiveProperty")
+	AllValuesFrom             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "allValuesFrom")
+	AnnotatedProperty         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "annotatedProperty")
+	AnnotatedSource           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "annotatedSource")
+	AnnotatedTarget           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "annotatedTarget")
+	AssertionProperty         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "assertionProperty")
+	BackwardCompatibleWith    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "backwardCompatibleWith")
+	BottomDataProperty        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "bottomDataProperty")
+	BottomObjectProperty      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "bottomObjectProperty")
+	Cardinality               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "cardinality")
+	ComplementOf              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "complementOf")
+	DatatypeComplementOf      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "datatypeComplementOf")
+	Deprecated                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "deprecated")
+	DifferentFrom             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "differentFrom")
+	DisjointUnionOf           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "disjointUnionOf")
+	DisjointWith              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "disjointWith")
+	DistinctMembers           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "distinctMembers")
+	EquivalentClass           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "equivalentClass")
+	EquivalentProperty        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "equivalentProperty")
+	HasKey                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasKey")
+	HasSelf                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasSelf")
+	HasValue                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasValue")
+	Imports                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "imports")
+	IncompatibleWith          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "incompatibleWith")
+	IntersectionOf            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "intersectionOf")
+	InverseOf                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "inverseOf")
+	MaxCardinality            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxCardinality")
+	MaxQualifiedCardinality   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxQualifiedCardinality")
+	Members                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "members")
+	MinCardinality            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minCardinality")
+	MinQualifiedCardinality   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minQualifiedCardinality")
+	OnClass                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "onClass")
+	OnDataRange               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "onDataRange")
+	OnDatatype                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "onDatatype")
+	OnProperties              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "onProperties")
+	OnProperty                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "onProperty")
+	OneOf                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "oneOf")
+	PriorVersion              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "priorVersion")
+	PropertyChainAxiom        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "propertyChainAxiom")
+	PropertyDisjointWith      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "propertyDisjointWith")
+	QualifiedCardinality      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedCardinality")
+	Rational                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rational")
+	Real                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "real")
+	SameAs                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sameAs")
+	SomeValuesFrom            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "someValuesFrom")
+	SourceIndividual          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sourceIndividual")
+	TargetIndividual          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "targetIndividual")
+	TargetValue               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "targetValue")
+	TopDataProperty           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "topDataProperty")
+	TopObjectProperty         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "topObjectProperty")
+	UnionOf                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "unionOf")
+	VersionIRI                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "versionIRI")
+	VersionInfo               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "versionInfo")
+	WithRestrictions          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "withRestrictions")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"AllDifferent":              AllDifferent,
+	"AllDisjointClasses":        AllDisjointClasses,
+	"AllDisjointProperties":     AllDisjointProperties,
+	"Annotation":                Annotation,
+	"AnnotationProperty":        AnnotationProperty,
+	"AsymmetricProperty":        AsymmetricProperty,
+	"Axiom":                     Axiom,
+	"Class":                     Class,
+	"DataRange":                 DataRange,
+	"DatatypeProperty":          DatatypeProperty,
+	"DeprecatedClass":           DeprecatedClass,
+	"DeprecatedProperty":        DeprecatedProperty,
+	"FunctionalProperty":        FunctionalProperty,
+	"InverseFunctionalProperty": InverseFunctionalProperty,
+	"IrreflexiveProperty":       IrreflexiveProperty,
+	"NamedIndividual":           NamedIndividual,
+	"NegativePropertyAssertion": NegativePropertyAssertion,
+	"Nothing":                   Nothing,
+	"ObjectProperty":            ObjectProperty,
+	"Ontology":                  Ontology,
+	"OntologyProperty":          OntologyProperty,
+	"ReflexiveProperty":         ReflexiveProperty,
+	"Restriction":               Restriction,
+	"SymmetricProperty":         SymmetricProperty,
+	"Thing":                     Thing,
+	"TransitiveProperty":        TransitiveProperty,
+	"allValuesFrom":             AllValuesFrom,
+	"annotatedProperty":         AnnotatedProperty,
+	"annotatedSource":           AnnotatedSource,
+	"annotatedTarget":           AnnotatedTarget,
+	"assertionProperty":         AssertionProperty,
+	"backwardCompatibleWith":    BackwardCompatibleWith,
+	"bottomDataProperty":        BottomDataProperty,
+	"bottomObjectProperty":      BottomObjectProperty,
+	"cardinality":               Cardinality,
+	"complementOf":              ComplementOf,
+	"datatypeComplementOf":      DatatypeComplementOf,
+	"deprecated":                Deprecated,
+	"differentFrom":             DifferentFrom,
+	"disjointUnionOf":           DisjointUnionOf,
+	"disjointWith":              DisjointWith,
+	"distinctMembers":           DistinctMembers,
+	"equivalentClass":           EquivalentClass,
+	"equivalentProperty":        EquivalentProperty,
+	"hasKey":                    HasKey,
+	"hasSelf":                   HasSelf,
+	"hasValue":                  HasValue,
+	"imports":                   Imports,
+	"incompatibleWith":          IncompatibleWith,
+	"intersectionOf":            IntersectionOf,
+	"inverseOf":                 InverseOf,
+	"maxCardinality":            MaxCardinality,
+	"maxQualifiedCardinality":   MaxQualifiedCardinality,
+	"members":                   Members,
+	"minCardinality":            MinCardinality,
+	"minQualifiedCardinality":   MinQualifiedCardinality,
+	"onClass":                   OnClass,
+	"onDataRange":               OnDataRange,
+	"onDatatype":                OnDatatype,
+	"onProperties":              OnProperties,
+	"onProperty":                OnProperty,
+	"oneOf":                     OneOf,
+	"priorVersion":              PriorVersion,
+	"propertyChainAxiom":        PropertyChainAxiom,
+	"propertyDisjointWith":      PropertyDisjointWith,
+	"qualifiedCardinality":      QualifiedCardinality,
+	"rational":                  Rational,
+	"real":                      Real,
+	"sameAs":                    SameAs,
+	"someValuesFrom":            SomeValuesFrom,
+	"sourceIndividual":          SourceIndividual,
+	"targetIndividual":          TargetIndividual,
+	"targetValue":               TargetValue,
+	"topDataProperty":           TopDataProperty,
+	"topObjectProperty":         TopObjectProperty,
+	"unionOf":                   UnionOf,
+	"versionIRI":                VersionIRI,
+	"versionInfo":               VersionInfo,
+	"withRestrictions":          WithRestrictions,
+}
@@ -0,0 +1,181 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/prov.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the PROV-O ontology.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://www.w3.org/ns/prov#"
+	Prefix    = "prov"
+)
+
+var (
+	Activity               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Activity")
+	ActivityInfluence      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ActivityInfluence")
+	Agent                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Agent")
+	AgentInfluence         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AgentInfluence")
+	Association            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Association")
+	Attribution            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Attribution")
+	Bundle                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Bundle")
+	Collection             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Collection")
+	Communication          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Communication")
+	Delegation             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Delegation")
+	Derivation             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Derivation")
+	EmptyCollection        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EmptyCollection")
+	End                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "End")
+	Entity                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Entity")
+	EntityInfluence        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EntityInfluence")
+	Generation             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Generation")
+	Influence              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Influence")
+	InstantaneousEvent     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "InstantaneousEvent")
+	Invalidation           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Invalidation")
+	Location               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Location")
+	Organization           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Organization")
+	Person                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Person")
+	Plan                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Plan")
+	PrimarySource          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PrimarySource")
+	Quotation              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Quotation")
+	Revision               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Revision")
+	Role                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Role")
+	SoftwareAgent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SoftwareAgent")
+	Start                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Start")
+	Usage                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Usage")
+	ActedOnBehalfOf        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "actedOnBehalfOf")
+	ActivityProperty       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "activity")
+	AgentProperty          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "agent")
+	AlternateOf            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "alternateOf")
+	AtLocation             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "atLocation")
+	AtTime                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "atTime")
+	EndedAtTime            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "endedAtTime")
+	EntityProperty         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "entity")
+	Generated              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "generated")
+	GeneratedAtTime        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "generatedAtTime")
+	HadActivity            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hadActivity")
+	HadGeneration          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hadGeneration")
+	HadMember              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hadMember")
+	HadPlan                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hadPlan")
+	HadPrimarySource       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hadPrimarySource")
+	HadRole                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hadRole")
+	HadUsage               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hadUsage")
+	Influenced             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "influenced")
+	Influencer             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "influencer")
+	Invalidated            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "invalidated")
+	InvalidatedAtTime      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "invalidatedAtTime")
+	QualifiedAssociation   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedAssociation")
+	QualifiedAttribution   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedAttribution")
+	QualifiedCommunication interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedCommunication")
+	QualifiedDelegation    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedDelegation")
+	QualifiedDerivation    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedDerivation")
+	QualifiedEnd           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedEnd")
+	QualifiedGeneration    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedGeneration")
+	QualifiedInfluence     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedInfluence")
+	QualifiedInvalidation  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedInvalidation")
+	QualifiedPrimarySource interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedPrimarySource")
+	QualifiedQuotation     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedQuotation")
+	QualifiedRevision      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedRevision")
+	QualifiedStart         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedStart")
+	QualifiedUsage         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedUsage")
+	SpecializationOf       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "specializationOf")
+	StartedAtTime          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "startedAtTime")
+	Used                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "used")
+	Value                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "value")
+	WasAssociatedWith      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasAssociatedWith")
+	WasAttributedTo        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasAttributedTo")
+	WasDerivedFrom         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasDerivedFrom")
+	WasEndedBy             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasEndedBy")
+	WasGeneratedBy         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasGeneratedBy")
+	WasInfluencedBy        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasInfluencedBy")
+	WasInformedBy          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasInformedBy")
+	WasInvalidatedBy       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasInvalidatedBy")
+	WasQuotedFrom          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasQuotedFrom")
+	WasRevisionOf          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasRevisionOf")
+	WasStartedBy           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "wasStartedBy")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"Activity":               Activity,
+	"ActivityInfluence":      ActivityInfluence,
+	"Agent":                  Agent,
+	"AgentInfluence":         AgentInfluence,
+	"Association":            Association,
+	"Attribution":            Attribution,
+	"Bundle":                 Bundle,
+	"Collection":             Collection,
+	"Communication":          Communication,
+	"Delegation":             Delegation,
+	"Derivation":             Derivation,
+	"EmptyCollection":        EmptyCollection,
+	"End":                    End,
+	"Entity":                 Entity,
+	"EntityInfluence":        EntityInfluence,
+	"Generation":             Generation,
+	"Influence":              Influence,
+	"InstantaneousEvent":     InstantaneousEvent,
+	"Invalidation":           Invalidation,
+	"Location":               Location,
+	"Organization":           Organization,
+	"Person":                 Person,
+	"Plan":                   Plan,
+	"PrimarySource":          PrimarySource,
+	"Quotation":              Quotation,
+	"Revision":               Revision,
+	"Role":                   Role,
+	"SoftwareAgent":          SoftwareAgent,
+	"Start":                  Start,
+	"Usage":                  Usage,
+	"actedOnBehalfOf":        ActedOnBehalfOf,
+	"activity":               ActivityProperty,
+	"agent":                  AgentProperty,
+	"alternateOf":            AlternateOf,
+	"atLocation":             AtLocation,
+	"atTime":                 AtTime,
+	"endedAtTime":            EndedAtTime,
+	"entity":                 EntityProperty,
+	"generated":              Generated,
+	"generatedAtTime":        GeneratedAtTime,
+	"hadActivity":            HadActivity,
+	"hadGeneration":          HadGeneration,
+	"hadMember":              HadMember,
+	"hadPlan":                HadPlan,
+	"hadPrimarySource":       HadPrimarySource,
+	"hadRole":                HadRole,
+	"hadUsage":               HadUsage,
+	"influenced":             Influenced,
+	"influencer":             Influencer,
+	"invalidated":            Invalidated,
+	"invalidatedAtTime":      InvalidatedAtTime,
+	"qualifiedAssociation":   QualifiedAssociation,
+	"qualifiedAttribution":   QualifiedAttribution,
+	"qualifiedCommunication": QualifiedCommunication,
+	"qualifiedDelegation":    QualifiedDelegation,
+	"qualifiedDerivation":    QualifiedDerivation,
+	"qualifiedEnd":           QualifiedEnd,
+	"qualifiedGeneration":    QualifiedGeneration,
+	"qualifiedInfluence":     QualifiedInfluence,
+	"qualifiedInvalidation":  QualifiedInvalidation,
+	"qualifiedPrimarySource": QualifiedPrimarySource,
+	"qualifiedQuotation":     QualifiedQuotation,
+	"qualifiedRevision":      QualifiedRevision,
+	"qualifiedStart":         QualifiedStart,
+	"qualifiedUsage":         QualifiedUsage,
+	"specializationOf":       SpecializationOf,
+	"startedAtTime":          StartedAtTime,
+	"used":                   Used,
+	"value":                  Value,
+	"wasAssociatedWith":      WasAssociatedWith,
+	"wasAttributedTo":        WasAttributedTo,
+	"wasDerivedFrom":         WasDerivedFrom,
+	"wasEndedBy":             WasEndedBy,
+	"wasGeneratedBy":         WasGeneratedBy,
+	"wasInfluencedBy":        WasInfluencedBy,
+	"wasInformedBy":          WasInformedBy,
+	"wasInvalidatedBy":       WasInvalidatedBy,
+	"wasQuotedFrom":          WasQuotedFrom,
+	"wasRevisionOf":          WasRevisionOf,
+	"wasStartedBy":           WasStartedBy,
+}
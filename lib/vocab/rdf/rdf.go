@@ -0,0 +1,69 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/rdf.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the RDF vocabulary.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	Prefix    = "rdf"
+)
+
+var (
+	Alt             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Alt")
+	Bag             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Bag")
+	CompoundLiteral interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "CompoundLiteral")
+	HTML            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "HTML")
+	JSON            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSON")
+	List            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "List")
+	PlainLiteral    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PlainLiteral")
+	Property        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Property")
+	Seq             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Seq")
+	Statement       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Statement")
+	XMLLiteral      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "XMLLiteral")
+	DirLangString   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dirLangString")
+	Direction       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "direction")
+	First           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "first")
+	LangString      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "langString")
+	Language        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "language")
+	Nil             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nil")
+	Object          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "object")
+	Predicate       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "predicate")
+	Reifies         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "reifies")
+	Rest            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rest")
+	Subject         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "subject")
+	Type            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "type")
+	Value           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "value")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"Alt":             Alt,
+	"Bag":             Bag,
+	"CompoundLiteral": CompoundLiteral,
+	"HTML":            HTML,
+	"JSON":            JSON,
+	"List":            List,
+	"PlainLiteral":    PlainLiteral,
+	"Property":        Property,
+	"Seq":             Seq,
+	"Statement":       Statement,
+	"XMLLiteral":      XMLLiteral,
+	"dirLangString":   DirLangString,
+	"direction":       Direction,
+	"first":           First,
+	"langString":      LangString,
+	"language":        Language,
+	"nil":             Nil,
+	"object":          Object,
+	"predicate":       Predicate,
+	"reifies":         Reifies,
+	"rest":            Rest,
+	"subject":         Subject,
+	"type":            Type,
+	"value":           Value,
+}
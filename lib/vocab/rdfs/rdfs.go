@@ -0,0 +1,51 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/rdfs.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the RDF Schema vocabulary.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://www.w3.org/2000/01/rdf-schema#"
+	Prefix    = "rdfs"
+)
+
+var (
+	Class                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Class")
+	Container                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Container")
+	ContainerMembershipProperty interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ContainerMembershipProperty")
+	Datatype                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Datatype")
+	Literal                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Literal")
+	Resource                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Resource")
+	Comment                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "comment")
+	Domain                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "domain")
+	IsDefinedBy                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isDefinedBy")
+	Label                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "label")
+	Member                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "member")
+	Range                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "range")
+	SeeAlso                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "seeAlso")
+	SubClassOf                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "subClassOf")
+	SubPropertyOf               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "subPropertyOf")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"Class":                       Class,
+	"Container":                   Container,
+	"ContainerMembershipProperty": ContainerMembershipProperty,
+	"Datatype":                    Datatype,
+	"Literal":                     Literal,
+	"Resource":                    Resource,
+	"comment":                     Comment,
+	"domain":                      Domain,
+	"isDefinedBy":                 IsDefinedBy,
+	"label":                       Label,
+	"member":                      Member,
+	"range":                       Range,
+	"seeAlso":                     SeeAlso,
+	"subClassOf":                  SubClassOf,
+	"subPropertyOf":               SubPropertyOf,
+}
@@ -0,0 +1,883 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/schema.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the commonly used part of schema.org.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "https://schema.org/"
+	Prefix    = "schema"
+)
+
+var (
+	AboutPage                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AboutPage")
+	Action                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Action")
+	AdministrativeArea                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AdministrativeArea")
+	AggregateOffer                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AggregateOffer")
+	AggregateRating                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AggregateRating")
+	Airport                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Airport")
+	Answer                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Answer")
+	Article                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Article")
+	Audience                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Audience")
+	AudioObject                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AudioObject")
+	BackOrder                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "BackOrder")
+	Blog                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Blog")
+	BlogPosting                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "BlogPosting")
+	Book                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Book")
+	Boolean                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Boolean")
+	Brand                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Brand")
+	BreadcrumbList                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "BreadcrumbList")
+	BuyAction                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "BuyAction")
+	Car                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Car")
+	City                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "City")
+	Class                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Class")
+	Clip                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Clip")
+	CollegeOrUniversity               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "CollegeOrUniversity")
+	Comment                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Comment")
+	CommunicateAction                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "CommunicateAction")
+	ContactPage                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ContactPage")
+	ContactPoint                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ContactPoint")
+	Corporation                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Corporation")
+	Country                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Country")
+	Course                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Course")
+	CourseInstance                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "CourseInstance")
+	CreativeWork                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "CreativeWork")
+	CreativeWorkSeries                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "CreativeWorkSeries")
+	DamagedCondition                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DamagedCondition")
+	DataCatalog                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DataCatalog")
+	DataDownload                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DataDownload")
+	DataType                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DataType")
+	Dataset                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Dataset")
+	Date                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Date")
+	DateTime                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DateTime")
+	DayOfWeek                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DayOfWeek")
+	DefinedTerm                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DefinedTerm")
+	DefinedTermSet                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DefinedTermSet")
+	Discontinued                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Discontinued")
+	Drug                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Drug")
+	Duration                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Duration")
+	EducationalOccupationalCredential interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EducationalOccupationalCredential")
+	EducationalOrganization           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EducationalOrganization")
+	Enumeration                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Enumeration")
+	Episode                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Episode")
+	Event                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Event")
+	EventCancelled                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EventCancelled")
+	EventMovedOnline                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EventMovedOnline")
+	EventPostponed                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EventPostponed")
+	EventRescheduled                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EventRescheduled")
+	EventScheduled                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EventScheduled")
+	EventStatusType                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EventStatusType")
+	FAQPage                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "FAQPage")
+	False                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "False")
+	Female                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Female")
+	Flight                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Flight")
+	Float                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Float")
+	Friday                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Friday")
+	GenderType                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "GenderType")
+	GeoCoordinates                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "GeoCoordinates")
+	GeoShape                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "GeoShape")
+	GovernmentOrganization            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "GovernmentOrganization")
+	Hospital                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Hospital")
+	Hotel                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Hotel")
+	HowTo                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "HowTo")
+	HowToStep                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "HowToStep")
+	ImageObject                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ImageObject")
+	InStock                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "InStock")
+	InStoreOnly                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "InStoreOnly")
+	Intangible                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Intangible")
+	Integer                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Integer")
+	Invoice                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Invoice")
+	ItemAvailability                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ItemAvailability")
+	ItemList                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ItemList")
+	JobPosting                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JobPosting")
+	Language                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Language")
+	LimitedAvailability               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LimitedAvailability")
+	ListItem                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ListItem")
+	LocalBusiness                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LocalBusiness")
+	LodgingBusiness                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LodgingBusiness")
+	Male                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Male")
+	Map                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Map")
+	MediaObject                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MediaObject")
+	MedicalCondition                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MedicalCondition")
+	MedicalEntity                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MedicalEntity")
+	Menu                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Menu")
+	MenuItem                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MenuItem")
+	MobileApplication                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MobileApplication")
+	Monday                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Monday")
+	MonetaryAmount                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MonetaryAmount")
+	Movie                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Movie")
+	MusicAlbum                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MusicAlbum")
+	MusicGroup                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MusicGroup")
+	MusicRecording                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MusicRecording")
+	NGO                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NGO")
+	NewCondition                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NewCondition")
+	NewsArticle                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NewsArticle")
+	Number                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Number")
+	Occupation                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Occupation")
+	Offer                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Offer")
+	OfferItemCondition                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OfferItemCondition")
+	OnlineOnly                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OnlineOnly")
+	OpeningHoursSpecification         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OpeningHoursSpecification")
+	Order                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Order")
+	Organization                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Organization")
+	OutOfStock                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OutOfStock")
+	Painting                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Painting")
+	ParcelDelivery                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ParcelDelivery")
+	Periodical                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Periodical")
+	Person                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Person")
+	Photograph                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Photograph")
+	Physician                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Physician")
+	Place                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Place")
+	PostalAddress                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PostalAddress")
+	PreOrder                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PreOrder")
+	PreSale                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PreSale")
+	PriceSpecification                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PriceSpecification")
+	Product                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Product")
+	ProfilePage                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ProfilePage")
+	PropertyValue                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PropertyValue")
+	PublicHolidays                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PublicHolidays")
+	QuantitativeValue                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "QuantitativeValue")
+	Question                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Question")
+	Rating                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Rating")
+	ReadAction                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ReadAction")
+	Recipe                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Recipe")
+	RefurbishedCondition              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "RefurbishedCondition")
+	Report                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Report")
+	Reservation                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Reservation")
+	Restaurant                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Restaurant")
+	Review                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Review")
+	Saturday                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Saturday")
+	ScholarlyArticle                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ScholarlyArticle")
+	School                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "School")
+	SearchAction                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SearchAction")
+	Service                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Service")
+	SoftwareApplication               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SoftwareApplication")
+	SoftwareSourceCode                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SoftwareSourceCode")
+	SoldOut                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SoldOut")
+	SportsOrganization                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SportsOrganization")
+	SportsTeam                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SportsTeam")
+	State                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "State")
+	Store                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Store")
+	StructuredValue                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "StructuredValue")
+	Sunday                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Sunday")
+	TVEpisode                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "TVEpisode")
+	TVSeries                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "TVSeries")
+	Text                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Text")
+	Thesis                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Thesis")
+	Thing                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Thing")
+	Thursday                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Thursday")
+	Ticket                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Ticket")
+	Time                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Time")
+	TouristAttraction                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "TouristAttraction")
+	Trip                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Trip")
+	True                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "True")
+	Tuesday                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Tuesday")
+	URL                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "URL")
+	UnitPriceSpecification            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "UnitPriceSpecification")
+	UsedCondition                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "UsedCondition")
+	Vehicle                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Vehicle")
+	VideoObject                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "VideoObject")
+	ViewAction                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ViewAction")
+	WatchAction                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "WatchAction")
+	WebApplication                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "WebApplication")
+	WebPage                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "WebPage")
+	WebPageElement                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "WebPageElement")
+	WebSite                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "WebSite")
+	Wednesday                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Wednesday")
+	About                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "about")
+	Abstract                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "abstract")
+	AcceptedAnswer                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "acceptedAnswer")
+	AcceptsReservations               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "acceptsReservations")
+	ActionStatus                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "actionStatus")
+	Actor                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "actor")
+	AdditionalName                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "additionalName")
+	AdditionalType                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "additionalType")
+	Address                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "address")
+	AddressCountry                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "addressCountry")
+	AddressLocality                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "addressLocality")
+	AddressRegion                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "addressRegion")
+	Affiliation                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "affiliation")
+	AggregateRatingProperty           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "aggregateRating")
+	AlternateName                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "alternateName")
+	AlumniOf                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "alumniOf")
+	Amount                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "amount")
+	AnswerCount                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "answerCount")
+	ApplicationCategory               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "applicationCategory")
+	AreaServed                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "areaServed")
+	ArrivalAirport                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "arrivalAirport")
+	ArrivalTime                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "arrivalTime")
+	ArticleBody                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "articleBody")
+	Attendee                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "attendee")
+	AudienceProperty                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "audience")
+	Author                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "author")
+	Availability                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "availability")
+	AvailableLanguage                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "availableLanguage")
+	Award                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "award")
+	BaseSalary                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "baseSalary")
+	BestRating                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "bestRating")
+	BirthDate                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "birthDate")
+	BirthPlace                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "birthPlace")
+	BookEdition                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "bookEdition")
+	BookFormat                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "bookFormat")
+	BrandProperty                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "brand")
+	Breadcrumb                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "breadcrumb")
+	ByArtist                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "byArtist")
+	Calories                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "calories")
+	Caption                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "caption")
+	Category                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "category")
+	CheckinTime                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "checkinTime")
+	CheckoutTime                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "checkoutTime")
+	Children                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "children")
+	Citation                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "citation")
+	Closes                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "closes")
+	CodeRepository                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "codeRepository")
+	Colleague                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "colleague")
+	Color                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "color")
+	CommentProperty                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "comment")
+	CommentCount                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "commentCount")
+	ContactPointProperty              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "contactPoint")
+	ContactType                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "contactType")
+	ContainedInPlace                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "containedInPlace")
+	ContainsPlace                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "containsPlace")
+	ContentUrl                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "contentUrl")
+	CookTime                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "cookTime")
+	CopyrightHolder                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "copyrightHolder")
+	CopyrightYear                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "copyrightYear")
+	CourseCode                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "courseCode")
+	Creator                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "creator")
+	Currency                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "currency")
+	Customer                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "customer")
+	DatasetProperty                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dataset")
+	DateCreated                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dateCreated")
+	DateModified                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dateModified")
+	DatePosted                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "datePosted")
+	DatePublished                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "datePublished")
+	DayOfWeekProperty                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dayOfWeek")
+	DeathDate                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "deathDate")
+	DeathPlace                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "deathPlace")
+	DepartureAirport                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "departureAirport")
+	DepartureTime                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "departureTime")
+	Depth                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "depth")
+	Description                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "description")
+	Director                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "director")
+	DisambiguatingDescription         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "disambiguatingDescription")
+	Distribution                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "distribution")
+	DownloadUrl                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "downloadUrl")
+	DurationProperty                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "duration")
+	Editor                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "editor")
+	EducationRequirements             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "educationRequirements")
+	EducationalLevel                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "educationalLevel")
+	Elevation                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "elevation")
+	Email                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "email")
+	EmbedUrl                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "embedUrl")
+	Employee                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "employee")
+	EmploymentType                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "employmentType")
+	Encoding                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "encoding")
+	EncodingFormat                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "encodingFormat")
+	EndDate                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "endDate")
+	EpisodeNumber                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "episodeNumber")
+	EventStatus                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "eventStatus")
+	ExperienceRequirements            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "experienceRequirements")
+	FamilyName                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "familyName")
+	FaxNumber                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "faxNumber")
+	FlightNumber                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "flightNumber")
+	Founder                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "founder")
+	FoundingDate                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "foundingDate")
+	FuelType                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "fuelType")
+	Funder                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "funder")
+	Funding                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "funding")
+	Gender                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gender")
+	Genre                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "genre")
+	Geo                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "geo")
+	GivenName                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "givenName")
+	Gtin                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gtin")
+	Gtin13                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gtin13")
+	HasCourseInstance                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasCourseInstance")
+	HasMap                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasMap")
+	HasOccupation                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasOccupation")
+	HasPart                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasPart")
+	Headline                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "headline")
+	Height                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "height")
+	HiringOrganization                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hiringOrganization")
+	HonorificPrefix                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "honorificPrefix")
+	HonorificSuffix                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "honorificSuffix")
+	Identifier                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "identifier")
+	Illustrator                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "illustrator")
+	Image                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "image")
+	InAlbum                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "inAlbum")
+	InDefinedTermSet                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "inDefinedTermSet")
+	InLanguage                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "inLanguage")
+	IncludedInDataCatalog             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "includedInDataCatalog")
+	InteractionStatistic              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "interactionStatistic")
+	IsAccessibleForFree               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isAccessibleForFree")
+	IsBasedOn                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isBasedOn")
+	IsPartOf                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isPartOf")
+	Isbn                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "isbn")
+	IssueNumber                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "issueNumber")
+	Item                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "item")
+	ItemCondition                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "itemCondition")
+	ItemListElement                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "itemListElement")
+	ItemListOrder                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "itemListOrder")
+	ItemReviewed                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "itemReviewed")
+	JobLocation                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "jobLocation")
+	JobTitle                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "jobTitle")
+	Keywords                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "keywords")
+	Knows                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "knows")
+	KnowsAbout                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "knowsAbout")
+	KnowsLanguage                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "knowsLanguage")
+	Latitude                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "latitude")
+	LearningResourceType              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "learningResourceType")
+	LegalName                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "legalName")
+	License                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "license")
+	Location                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "location")
+	Logo                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "logo")
+	Longitude                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "longitude")
+	MainEntity                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "mainEntity")
+	MainEntityOfPage                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "mainEntityOfPage")
+	Manufacturer                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "manufacturer")
+	MaxValue                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxValue")
+	MeasurementTechnique              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "measurementTechnique")
+	Member                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "member")
+	MemberOf                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "memberOf")
+	MenuProperty                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "menu")
+	MinValue                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minValue")
+	Model                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "model")
+	Mpn                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "mpn")
+	Name                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "name")
+	Nationality                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nationality")
+	NumTracks                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "numTracks")
+	NumberOfEmployees                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "numberOfEmployees")
+	NumberOfItems                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "numberOfItems")
+	NumberOfPages                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "numberOfPages")
+	Nutrition                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nutrition")
+	OccupationalCategory              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "occupationalCategory")
+	Offers                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "offers")
+	OpeningHours                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "openingHours")
+	OpeningHoursSpecificationProperty interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "openingHoursSpecification")
+	OperatingSystem                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "operatingSystem")
+	OrderDate                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "orderDate")
+	OrderNumber                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "orderNumber")
+	OrderStatus                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "orderStatus")
+	Organizer                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "organizer")
+	PageEnd                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "pageEnd")
+	PageStart                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "pageStart")
+	Parent                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "parent")
+	ParentOrganization                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "parentOrganization")
+	PartOfSeries                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "partOfSeries")
+	PaymentDueDate                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "paymentDueDate")
+	Performer                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "performer")
+	Position                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "position")
+	PostOfficeBoxNumber               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "postOfficeBoxNumber")
+	PostalCode                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "postalCode")
+	PotentialAction                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "potentialAction")
+	PrepTime                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "prepTime")
+	Price                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "price")
+	PriceCurrency                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "priceCurrency")
+	PriceRange                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "priceRange")
+	ProductionCompany                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "productionCompany")
+	ProgrammingLanguage               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "programmingLanguage")
+	PropertyID                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "propertyID")
+	Provider                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "provider")
+	Publisher                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "publisher")
+	Query                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "query")
+	RatingCount                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ratingCount")
+	RatingValue                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ratingValue")
+	RecipeIngredient                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "recipeIngredient")
+	RecipeInstructions                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "recipeInstructions")
+	RecipeYield                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "recipeYield")
+	ReservationId                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "reservationId")
+	ReservationStatus                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "reservationStatus")
+	Result                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "result")
+	ReviewProperty                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "review")
+	ReviewBody                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "reviewBody")
+	ReviewCount                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "reviewCount")
+	ReviewRating                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "reviewRating")
+	SameAs                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sameAs")
+	SeasonNumber                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "seasonNumber")
+	Seller                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "seller")
+	ServesCuisine                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "servesCuisine")
+	ServiceType                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "serviceType")
+	Sibling                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sibling")
+	Skills                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "skills")
+	Sku                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sku")
+	Slogan                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "slogan")
+	SoftwareVersion                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "softwareVersion")
+	SpatialCoverage                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "spatialCoverage")
+	Spouse                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "spouse")
+	StarRating                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "starRating")
+	StartDate                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "startDate")
+	Step                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "step")
+	StreetAddress                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "streetAddress")
+	SubOrganization                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "subOrganization")
+	SubjectOf                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "subjectOf")
+	SuggestedAnswer                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "suggestedAnswer")
+	Target                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "target")
+	TaxID                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "taxID")
+	Teaches                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "teaches")
+	Telephone                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "telephone")
+	TemporalCoverage                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "temporalCoverage")
+	TermCode                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "termCode")
+	TextProperty                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "text")
+	ThumbnailUrl                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "thumbnailUrl")
+	TicketNumber                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ticketNumber")
+	TotalPaymentDue                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "totalPaymentDue")
+	TotalTime                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "totalTime")
+	Track                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "track")
+	Translator                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "translator")
+	UnderName                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "underName")
+	UnitCode                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "unitCode")
+	UnitText                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "unitText")
+	UploadDate                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "uploadDate")
+	Url                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "url")
+	ValidFrom                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "validFrom")
+	ValidThrough                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "validThrough")
+	Value                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "value")
+	VariableMeasured                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "variableMeasured")
+	VatID                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "vatID")
+	VehicleIdentificationNumber       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "vehicleIdentificationNumber")
+	Version                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "version")
+	VolumeNumber                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "volumeNumber")
+	Weight                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "weight")
+	Width                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "width")
+	WorksFor                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "worksFor")
+	WorstRating                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "worstRating")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"AboutPage":                         AboutPage,
+	"Action":                            Action,
+	"AdministrativeArea":                AdministrativeArea,
+	"AggregateOffer":                    AggregateOffer,
+	"AggregateRating":                   AggregateRating,
+	"Airport":                           Airport,
+	"Answer":                            Answer,
+	"Article":                           Article,
+	"Audience":                          Audience,
+	"AudioObject":                       AudioObject,
+	"BackOrder":                         BackOrder,
+	"Blog":                              Blog,
+	"BlogPosting":                       BlogPosting,
+	"Book":                              Book,
+	"Boolean":                           Boolean,
+	"Brand":                             Brand,
+	"BreadcrumbList":                    BreadcrumbList,
+	"BuyAction":                         BuyAction,
+	"Car":                               Car,
+	"City":                              City,
+	"Class":                             Class,
+	"Clip":                              Clip,
+	"CollegeOrUniversity":               CollegeOrUniversity,
+	"Comment":                           Comment,
+	"CommunicateAction":                 CommunicateAction,
+	"ContactPage":                       ContactPage,
+	"ContactPoint":                      ContactPoint,
+	"Corporation":                       Corporation,
+	"Country":                           Country,
+	"Course":                            Course,
+	"CourseInstance":                    CourseInstance,
+	"CreativeWork":                      CreativeWork,
+	"CreativeWorkSeries":                CreativeWorkSeries,
+	"DamagedCondition":                  DamagedCondition,
+	"DataCatalog":                       DataCatalog,
+	"DataDownload":                      DataDownload,
+	"DataType":                          DataType,
+	"Dataset":                           Dataset,
+	"Date":                              Date,
+	"DateTime":                          DateTime,
+	"DayOfWeek":                         DayOfWeek,
+	"DefinedTerm":                       DefinedTerm,
+	"DefinedTermSet":                    DefinedTermSet,
+	"Discontinued":                      Discontinued,
+	"Drug":                              Drug,
+	"Duration":                          Duration,
+	"EducationalOccupationalCredential": EducationalOccupationalCredential,
+	"EducationalOrganization":           EducationalOrganization,
+	"Enumeration":                       Enumeration,
+	"Episode":                           Episode,
+	"Event":                             Event,
+	"EventCancelled":                    EventCancelled,
+	"EventMovedOnline":                  EventMovedOnline,
+	"EventPostponed":                    EventPostponed,
+	"EventRescheduled":                  EventRescheduled,
+	"EventScheduled":                    EventScheduled,
+	"EventStatusType":                   EventStatusType,
+	"FAQPage":                           FAQPage,
+	"False":                             False,
+	"Female":                            Female,
+	"Flight":                            Flight,
+	"Float":                             Float,
+	"Friday":                            Friday,
+	"GenderType":                        GenderType,
+	"GeoCoordinates":                    GeoCoordinates,
+	"GeoShape":                          GeoShape,
+	"GovernmentOrganization":            GovernmentOrganization,
+	"Hospital":                          Hospital,
+	"Hotel":                             Hotel,
+	"HowTo":                             HowTo,
+	"HowToStep":                         HowToStep,
+	"ImageObject":                       ImageObject,
+	"InStock":                           InStock,
+	"InStoreOnly":                       InStoreOnly,
+	"Intangible":                        Intangible,
+	"Integer":                           Integer,
+	"Invoice":                           Invoice,
+	"ItemAvailability":                  ItemAvailability,
+	"ItemList":                          ItemList,
+	"JobPosting":                        JobPosting,
+	"Language":                          Language,
+	"LimitedAvailability":               LimitedAvailability,
+	"ListItem":                          ListItem,
+	"LocalBusiness":                     LocalBusiness,
+	"LodgingBusiness":                   LodgingBusiness,
+	"Male":                              Male,
+	"Map":                               Map,
+	"MediaObject":                       MediaObject,
+	"MedicalCondition":                  MedicalCondition,
+	"MedicalEntity":                     MedicalEntity,
+	"Menu":                              Menu,
+	"MenuItem":                          MenuItem,
+	"MobileApplication":                 MobileApplication,
+	"Monday":                            Monday,
+	"MonetaryAmount":                    MonetaryAmount,
+	"Movie":                             Movie,
+	"MusicAlbum":                        MusicAlbum,
+	"MusicGroup":                        MusicGroup,
+	"MusicRecording":                    MusicRecording,
+	"NGO":                               NGO,
+	"NewCondition":                      NewCondition,
+	"NewsArticle":                       NewsArticle,
+	"Number":                            Number,
+	"Occupation":                        Occupation,
+	"Offer":                             Offer,
+	"OfferItemCondition":                OfferItemCondition,
+	"OnlineOnly":                        OnlineOnly,
+	"OpeningHoursSpecification":         OpeningHoursSpecification,
+	"Order":                             Order,
+	"Organization":                      Organization,
+	"OutOfStock":                        OutOfStock,
+	"Painting":                          Painting,
+	"ParcelDelivery":                    ParcelDelivery,
+	"Periodical":                        Periodical,
+	"Person":                            Person,
+	"Photograph":                        Photograph,
+	"Physician":                         Physician,
+	"Place":                             Place,
+	"PostalAddress":                     PostalAddress,
+	"PreOrder":                          PreOrder,
+	"PreSale":                           PreSale,
+	"PriceSpecification":                PriceSpecification,
+	"Product":                           Product,
+	"ProfilePage":                       ProfilePage,
+	"PropertyValue":                     PropertyValue,
+	"PublicHolidays":                    PublicHolidays,
+	"QuantitativeValue":                 QuantitativeValue,
+	"Question":                          Question,
+	"Rating":                            Rating,
+	"ReadAction":                        ReadAction,
+	"Recipe":                            Recipe,
+	"RefurbishedCondition":              RefurbishedCondition,
+	"Report":                            Report,
+	"Reservation":                       Reservation,
+	"Restaurant":                        Restaurant,
+	"Review":                            Review,
+	"Saturday":                          Saturday,
+	"ScholarlyArticle":                  ScholarlyArticle,
+	"School":                            School,
+	"SearchAction":                      SearchAction,
+	"Service":                           Service,
+	"SoftwareApplication":               SoftwareApplication,
+	"SoftwareSourceCode":                SoftwareSourceCode,
+	"SoldOut":                           SoldOut,
+	"SportsOrganization":                SportsOrganization,
+	"SportsTeam":                        SportsTeam,
+	"State":                             State,
+	"Store":                             Store,
+	"StructuredValue":                   StructuredValue,
+	"Sunday":                            Sunday,
+	"TVEpisode":                         TVEpisode,
+	"TVSeries":                          TVSeries,
+	"Text":                              Text,
+	"Thesis":                            Thesis,
+	"Thing":                             Thing,
+	"Thursday":                          Thursday,
+	"Ticket":                            Ticket,
+	"Time":                              Time,
+	"TouristAttraction":                 TouristAttraction,
+	"Trip":                              Trip,
+	"True":                              True,
+	"Tuesday":                           Tuesday,
+	"URL":                               URL,
+	"UnitPriceSpecification":            UnitPriceSpecification,
+	"UsedCondition":                     UsedCondition,
+	"Vehicle":                           Vehicle,
+	"VideoObject":                       VideoObject,
+	"ViewAction":                        ViewAction,
+	"WatchAction":                       WatchAction,
+	"WebApplication":                    WebApplication,
+	"WebPage":                           WebPage,
+	"WebPageElement":                    WebPageElement,
+	"WebSite":                           WebSite,
+	"Wednesday":                         Wednesday,
+	"about":                             About,
+	"abstract":                          Abstract,
+	"acceptedAnswer":                    AcceptedAnswer,
+	"acceptsReservations":               AcceptsReservations,
+	"actionStatus":                      ActionStatus,
+	"actor":                             Actor,
+	"additionalName":                    AdditionalName,
+	"additionalType":                    AdditionalType,
+	"address":                           Address,
+	"addressCountry":                    AddressCountry,
+	"addressLocality":                   AddressLocality,
+	"addressRegion":                     AddressRegion,
+	"affiliation":                       Affiliation,
+	"aggregateRating":                   AggregateRatingProperty,
+	"alternateName":                     AlternateName,
+	"alumniOf":                          AlumniOf,
+	"amount":                            Amount,
+	"answerCount":                       AnswerCount,
+	"applicationCategory":               ApplicationCategory,
+	"areaServed":                        AreaServed,
+	"arrivalAirport":                    ArrivalAirport,
+	"arrivalTime":                       ArrivalTime,
+	"articleBody":                       ArticleBody,
+	"attendee":                          Attendee,
+	"audience":                          AudienceProperty,
+	"author":                            Author,
+	"availability":                      Availability,
+	"availableLanguage":                 AvailableLanguage,
+	"award":                             Award,
+	"baseSalary":                        BaseSalary,
+	"bestRating":                        BestRating,
+	"birthDate":                         BirthDate,
+	"birthPlace":                        BirthPlace,
+	"bookEdition":                       BookEdition,
+	"bookFormat":                        BookFormat,
+	"brand":                             BrandProperty,
+	"breadcrumb":                        Breadcrumb,
+	"byArtist":                          ByArtist,
+	"calories":                          Calories,
+	"caption":                           Caption,
+	"category":                          Category,
+	"checkinTime":                       CheckinTime,
+	"checkoutTime":                      CheckoutTime,
+	"children":                          Children,
+	"citation":                          Citation,
+	"closes":                            Closes,
+	"codeRepository":                    CodeRepository,
+	"colleague":                         Colleague,
+	"color":                             Color,
+	"comment":                           CommentProperty,
+	"commentCount":                      CommentCount,
+	"contactPoint":                      ContactPointProperty,
+	"contactType":                       ContactType,
+	"containedInPlace":                  ContainedInPlace,
+	"containsPlace":                     ContainsPlace,
+	"contentUrl":                        ContentUrl,
+	"cookTime":                          CookTime,
+	"copyrightHolder":                   CopyrightHolder,
+	"copyrightYear":                     CopyrightYear,
+	"courseCode":                        CourseCode,
+	"creator":                           Creator,
+	"currency":                          Currency,
+	"customer":                          Customer,
+	"dataset":                           DatasetProperty,
+	"dateCreated":                       DateCreated,
+	"dateModified":                      DateModified,
+	"datePosted":                        DatePosted,
+	"datePublished":                     DatePublished,
+	"dayOfWeek":                         DayOfWeekProperty,
+	"deathDate":                         DeathDate,
+	"deathPlace":                        DeathPlace,
+	"departureAirport":                  DepartureAirport,
+	"departureTime":                     DepartureTime,
+	"depth":                             Depth,
+	"description":                       Description,
+	"director":                          Director,
+	"disambiguatingDescription":         DisambiguatingDescription,
+	"distribution":                      Distribution,
+	"downloadUrl":                       DownloadUrl,
+	"duration":                          DurationProperty,
+	"editor":                            Editor,
+	"educationRequirements":             EducationRequirements,
+	"educationalLevel":                  EducationalLevel,
+	"elevation":                         Elevation,
+	"email":                             Email,
+	"embedUrl":                          EmbedUrl,
+	"employee":                          Employee,
+	"employmentType":                    EmploymentType,
+	"encoding":                          Encoding,
+	"encodingFormat":                    EncodingFormat,
+	"endDate":                           EndDate,
+	"episodeNumber":                     EpisodeNumber,
+	"eventStatus":                       EventStatus,
+	"experienceRequirements":            ExperienceRequirements,
+	"familyName":                        FamilyName,
+	"faxNumber":                         FaxNumber,
+	"flightNumber":                      FlightNumber,
+	"founder":                           Founder,
+	"foundingDate":                      FoundingDate,
+	"fuelType":                          FuelType,
+	"funder":                            Funder,
+	"funding":                           Funding,
+	"gender":                            Gender,
+	"genre":                             Genre,
+	"geo":                               Geo,
+	"givenName":                         GivenName,
+	"gtin":                              Gtin,
+	"gtin13":                            Gtin13,
+	"hasCourseInstance":                 HasCourseInstance,
+	"hasMap":                            HasMap,
+	"hasOccupation":                     HasOccupation,
+	"hasPart":                           HasPart,
+	"headline":                          Headline,
+	"height":                            Height,
+	"hiringOrganization":                HiringOrganization,
+	"honorificPrefix":                   HonorificPrefix,
+	"honorificSuffix":                   HonorificSuffix,
+	"identifier":                        Identifier,
+	"illustrator":                       Illustrator,
+	"image":                             Image,
+	"inAlbum":                           InAlbum,
+	"inDefinedTermSet":                  InDefinedTermSet,
+	"inLanguage":                        InLanguage,
+	"includedInDataCatalog":             IncludedInDataCatalog,
+	"interactionStatistic":              InteractionStatistic,
+	"isAccessibleForFree":               IsAccessibleForFree,
+	"isBasedOn":                         IsBasedOn,
+	"isPartOf":                          IsPartOf,
+	"isbn":                              Isbn,
+	"issueNumber":                       IssueNumber,
+	"item":                              Item,
+	"itemCondition":                     ItemCondition,
+	"itemListElement":                   ItemListElement,
+	"itemListOrder":                     ItemListOrder,
+	"itemReviewed":                      ItemReviewed,
+	"jobLocation":                       JobLocation,
+	"jobTitle":                          JobTitle,
+	"keywords":                          Keywords,
+	"knows":                             Knows,
+	"knowsAbout":                        KnowsAbout,
+	"knowsLanguage":                     KnowsLanguage,
+	"latitude":                          Latitude,
+	"learningResourceType":              LearningResourceType,
+	"legalName":                         LegalName,
+	"license":                           License,
+	"location":                          Location,
+	"logo":                              Logo,
+	"longitude":                         Longitude,
+	"mainEntity":                        MainEntity,
+	"mainEntityOfPage":                  MainEntityOfPage,
+	"manufacturer":                      Manufacturer,
+	"maxValue":                          MaxValue,
+	"measurementTechnique":              MeasurementTechnique,
+	"member":                            Member,
+	"memberOf":                          MemberOf,
+	"menu":                              MenuProperty,
+	"minValue":                          MinValue,
+	"model":                             Model,
+	"mpn":                               Mpn,
+	"name":                              Name,
+	"nationality":                       Nationality,
+	"numTracks":                         NumTracks,
+	"numberOfEmployees":                 NumberOfEmployees,
+	"numberOfItems":                     NumberOfItems,
+	"numberOfPages":                     NumberOfPages,
+	"nutrition":                         Nutrition,
+	"occupationalCategory":              OccupationalCategory,
+	"offers":                            Offers,
+	"openingHours":                      OpeningHours,
+	"openingHoursSpecification":         OpeningHoursSpecificationProperty,
+	"operatingSystem":                   OperatingSystem,
+	"orderDate":                         OrderDate,
+	"orderNumber":                       OrderNumber,
+	"orderStatus":                       OrderStatus,
+	"organizer":                         Organizer,
+	"pageEnd":                           PageEnd,
+	"pageStart":                         PageStart,
+	"parent":                            Parent,
+	"parentOrganization":                ParentOrganization,
+	"partOfSeries":                      PartOfSeries,
+	"paymentDueDate":                    PaymentDueDate,
+	"performer":                         Performer,
+	"position":                          Position,
+	"postOfficeBoxNumber":               PostOfficeBoxNumber,
+	"postalCode":                        PostalCode,
+	"potentialAction":                   PotentialAction,
+	"prepTime":                          PrepTime,
+	"price":                             Price,
+	"priceCurrency":                     PriceCurrency,
+	"priceRange":                        PriceRange,
+	"productionCompany":                 ProductionCompany,
+	"programmingLanguage":               ProgrammingLanguage,
+	"propertyID":                        PropertyID,
+	"provider":                          Provider,
+	"publisher":                         Publisher,
+	"query":                             Query,
+	"ratingCount":                       RatingCount,
+	"ratingValue":                       RatingValue,
+	"recipeIngredient":                  RecipeIngredient,
+	"recipeInstructions":                RecipeInstructions,
+	"recipeYield":                       RecipeYield,
+	"reservationId":                     ReservationId,
+	"reservationStatus":                 ReservationStatus,
+	"result":                            Result,
+	"review":                            ReviewProperty,
+	"reviewBody":                        ReviewBody,
+	"reviewCount":                       ReviewCount,
+	"reviewRating":                      ReviewRating,
+	"sameAs":                            SameAs,
+	"seasonNumber":                      SeasonNumber,
+	"seller":                            Seller,
+	"servesCuisine":                     ServesCuisine,
+	"serviceType":                       ServiceType,
+	"sibling":                           Sibling,
+	"skills":                            Skills,
+	"sku":                               Sku,
+	"slogan":                            Slogan,
+	"softwareVersion":                   SoftwareVersion,
+	"spatialCoverage":                   SpatialCoverage,
+	"spouse":                            Spouse,
+	"starRating":                        StarRating,
+	"startDate":                         StartDate,
+	"step":                              Step,
+	"streetAddress":                     StreetAddress,
+	"subOrganization":                   SubOrganization,
+	"subjectOf":                         SubjectOf,
+	"suggestedAnswer":                   SuggestedAnswer,
+	"target":                            Target,
+	"taxID":                             TaxID,
+	"teaches":                           Teaches,
+	"telephone":                         Telephone,
+	"temporalCoverage":                  TemporalCoverage,
+	"termCode":                          TermCode,
+	"text":                              TextProperty,
+	"thumbnailUrl":                      ThumbnailUrl,
+	"ticketNumber":                      TicketNumber,
+	"totalPaymentDue":                   TotalPaymentDue,
+	"totalTime":                         TotalTime,
+	"track":                             Track,
+	"translator":                        Translator,
+	"underName":                         UnderName,
+	"unitCode":                          UnitCode,
+	"unitText":                          UnitText,
+	"uploadDate":                        UploadDate,
+	"url":                               Url,
+	"validFrom":                         ValidFrom,
+	"validThrough":                      ValidThrough,
+	"value":                             Value,
+	"variableMeasured":                  VariableMeasured,
+	"vatID":                             VatID,
+	"vehicleIdentificationNumber":       VehicleIdentificationNumber,
+	"version":                           Version,
+	"volumeNumber":                      VolumeNumber,
+	"weight":                            Weight,
+	"width":                             Width,
+	"worksFor":                          WorksFor,
+	"worstRating":                       WorstRating,
+}
@@ -0,0 +1,387 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/sh.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the SHACL vocabulary.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://www.w3.org/ns/shacl#"
+	Prefix    = "sh"
+)
+
+var (
+	AbstractResult                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AbstractResult")
+	AndConstraintComponent               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "AndConstraintComponent")
+	BlankNode                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "BlankNode")
+	BlankNodeOrIRI                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "BlankNodeOrIRI")
+	BlankNodeOrLiteral                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "BlankNodeOrLiteral")
+	ClassConstraintComponent             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ClassConstraintComponent")
+	ClosedConstraintComponent            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ClosedConstraintComponent")
+	ConstraintComponent                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ConstraintComponent")
+	DatatypeConstraintComponent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DatatypeConstraintComponent")
+	DisjointConstraintComponent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "DisjointConstraintComponent")
+	EqualsConstraintComponent            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "EqualsConstraintComponent")
+	ExpressionConstraintComponent        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ExpressionConstraintComponent")
+	Function                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Function")
+	HasValueConstraintComponent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "HasValueConstraintComponent")
+	IRI                                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "IRI")
+	IRIOrLiteral                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "IRIOrLiteral")
+	InConstraintComponent                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "InConstraintComponent")
+	Info                                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Info")
+	JSConstraint                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSConstraint")
+	JSConstraintComponent                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSConstraintComponent")
+	JSExecutable                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSExecutable")
+	JSFunction                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSFunction")
+	JSLibrary                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSLibrary")
+	JSRule                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSRule")
+	JSTarget                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSTarget")
+	JSTargetType                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSTargetType")
+	JSValidator                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "JSValidator")
+	LanguageInConstraintComponent        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LanguageInConstraintComponent")
+	LessThanConstraintComponent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LessThanConstraintComponent")
+	LessThanOrEqualsConstraintComponent  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "LessThanOrEqualsConstraintComponent")
+	Literal                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Literal")
+	MaxCountConstraintComponent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MaxCountConstraintComponent")
+	MaxExclusiveConstraintComponent      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MaxExclusiveConstraintComponent")
+	MaxInclusiveConstraintComponent      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MaxInclusiveConstraintComponent")
+	MaxLengthConstraintComponent         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MaxLengthConstraintComponent")
+	MinCountConstraintComponent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MinCountConstraintComponent")
+	MinExclusiveConstraintComponent      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MinExclusiveConstraintComponent")
+	MinInclusiveConstraintComponent      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MinInclusiveConstraintComponent")
+	MinLengthConstraintComponent         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "MinLengthConstraintComponent")
+	NodeConstraintComponent              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NodeConstraintComponent")
+	NodeKind                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NodeKind")
+	NodeKindConstraintComponent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NodeKindConstraintComponent")
+	NodeShape                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NodeShape")
+	NotConstraintComponent               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NotConstraintComponent")
+	OrConstraintComponent                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OrConstraintComponent")
+	Parameter                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Parameter")
+	Parameterizable                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Parameterizable")
+	PatternConstraintComponent           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PatternConstraintComponent")
+	PrefixDeclaration                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PrefixDeclaration")
+	PropertyConstraintComponent          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PropertyConstraintComponent")
+	PropertyGroup                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PropertyGroup")
+	PropertyShape                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "PropertyShape")
+	QualifiedMaxCountConstraintComponent interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "QualifiedMaxCountConstraintComponent")
+	QualifiedMinCountConstraintComponent interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "QualifiedMinCountConstraintComponent")
+	ResultAnnotation                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ResultAnnotation")
+	Rule                                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Rule")
+	SPARQLAskExecutable                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLAskExecutable")
+	SPARQLAskValidator                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLAskValidator")
+	SPARQLConstraint                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLConstraint")
+	SPARQLConstraintComponent            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLConstraintComponent")
+	SPARQLConstructExecutable            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLConstructExecutable")
+	SPARQLExecutable                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLExecutable")
+	SPARQLFunction                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLFunction")
+	SPARQLRule                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLRule")
+	SPARQLSelectExecutable               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLSelectExecutable")
+	SPARQLSelectValidator                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLSelectValidator")
+	SPARQLTarget                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLTarget")
+	SPARQLTargetType                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLTargetType")
+	SPARQLUpdateExecutable               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "SPARQLUpdateExecutable")
+	Severity                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Severity")
+	Shape                                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Shape")
+	Target                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Target")
+	TargetType                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "TargetType")
+	TripleRule                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "TripleRule")
+	UniqueLangConstraintComponent        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "UniqueLangConstraintComponent")
+	ValidationReport                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ValidationReport")
+	ValidationResult                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ValidationResult")
+	Validator                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Validator")
+	Violation                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Violation")
+	Warning                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Warning")
+	XoneConstraintComponent              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "XoneConstraintComponent")
+	AlternativePath                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "alternativePath")
+	And                                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "and")
+	AnnotationProperty                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "annotationProperty")
+	AnnotationValue                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "annotationValue")
+	AnnotationVarName                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "annotationVarName")
+	Ask                                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ask")
+	Class                                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "class")
+	Closed                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "closed")
+	Condition                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "condition")
+	Conforms                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "conforms")
+	Construct                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "construct")
+	Datatype                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "datatype")
+	Deactivated                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "deactivated")
+	Declare                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "declare")
+	DefaultValue                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "defaultValue")
+	Description                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "description")
+	Detail                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "detail")
+	Disjoint                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "disjoint")
+	Entailment                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "entailment")
+	Equals                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "equals")
+	Expression                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "expression")
+	FilterShape                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "filterShape")
+	Flags                                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "flags")
+	FocusNode                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "focusNode")
+	Group                                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "group")
+	HasValue                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasValue")
+	IgnoredProperties                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ignoredProperties")
+	In                                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "in")
+	Intersection                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "intersection")
+	InversePath                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "inversePath")
+	Js                                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "js")
+	JsFunctionName                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "jsFunctionName")
+	JsLibrary                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "jsLibrary")
+	JsLibraryURL                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "jsLibraryURL")
+	LabelTemplate                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "labelTemplate")
+	LanguageIn                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "languageIn")
+	LessThan                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "lessThan")
+	LessThanOrEquals                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "lessThanOrEquals")
+	MaxCount                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxCount")
+	MaxExclusive                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxExclusive")
+	MaxInclusive                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxInclusive")
+	MaxLength                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxLength")
+	Message                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "message")
+	MinCount                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minCount")
+	MinExclusive                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minExclusive")
+	MinInclusive                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minInclusive")
+	MinLength                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minLength")
+	Name                                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "name")
+	NamespaceProperty                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "namespace")
+	Node                                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "node")
+	NodeKindProperty                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nodeKind")
+	NodeValidator                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nodeValidator")
+	Nodes                                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nodes")
+	Not                                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "not")
+	Object                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "object")
+	OneOrMorePath                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "oneOrMorePath")
+	Optional                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "optional")
+	Or                                   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "or")
+	Order                                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "order")
+	ParameterProperty                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "parameter")
+	Path                                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "path")
+	Pattern                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "pattern")
+	Predicate                            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "predicate")
+	PrefixProperty                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "prefix")
+	Prefixes                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "prefixes")
+	Property                             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "property")
+	PropertyValidator                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "propertyValidator")
+	QualifiedMaxCount                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedMaxCount")
+	QualifiedMinCount                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedMinCount")
+	QualifiedValueShape                  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedValueShape")
+	QualifiedValueShapesDisjoint         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "qualifiedValueShapesDisjoint")
+	Result                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "result")
+	ResultAnnotationProperty             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "resultAnnotation")
+	ResultMessage                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "resultMessage")
+	ResultPath                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "resultPath")
+	ResultSeverity                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "resultSeverity")
+	ReturnType                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "returnType")
+	RuleProperty                         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "rule")
+	Select                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "select")
+	SeverityProperty                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "severity")
+	ShapesGraph                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "shapesGraph")
+	ShapesGraphWellFormed                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "shapesGraphWellFormed")
+	SourceConstraint                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sourceConstraint")
+	SourceConstraintComponent            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sourceConstraintComponent")
+	SourceShape                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sourceShape")
+	Sparql                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "sparql")
+	Subject                              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "subject")
+	SuggestedShapesGraph                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "suggestedShapesGraph")
+	TargetProperty                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "target")
+	TargetClass                          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "targetClass")
+	TargetNode                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "targetNode")
+	TargetObjectsOf                      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "targetObjectsOf")
+	TargetSubjectsOf                     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "targetSubjectsOf")
+	This                                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "this")
+	Union                                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "union")
+	UniqueLang                           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "uniqueLang")
+	Update                               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "update")
+	ValidatorProperty                    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "validator")
+	Value                                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "value")
+	Xone                                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "xone")
+	ZeroOrMorePath                       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "zeroOrMorePath")
+	ZeroOrOnePath                        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "zeroOrOnePath")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"AbstractResult":                       AbstractResult,
+	"AndConstraintComponent":               AndConstraintComponent,
+	"BlankNode":                            BlankNode,
+	"BlankNodeOrIRI":                       BlankNodeOrIRI,
+	"BlankNodeOrLiteral":                   BlankNodeOrLiteral,
+	"ClassConstraintComponent":             ClassConstraintComponent,
+	"ClosedConstraintComponent":            ClosedConstraintComponent,
+	"ConstraintComponent":                  ConstraintComponent,
+	"DatatypeConstraintComponent":          DatatypeConstraintComponent,
+	"DisjointConstraintComponent":          DisjointConstraintComponent,
+	"EqualsConstraintComponent":            EqualsConstraintComponent,
+	"ExpressionConstraintComponent":        ExpressionConstraintComponent,
+	"Function":                             Function,
+	"HasValueConstraintComponent":          HasValueConstraintComponent,
+	"IRI":                                  IRI,
+	"IRIOrLiteral":                         IRIOrLiteral,
+	"InConstraintComponent":                InConstraintComponent,
+	"Info":                                 Info,
+	"JSConstraint":                         JSConstraint,
+	"JSConstraintComponent":                JSConstraintComponent,
+	"JSExecutable":                         JSExecutable,
+	"JSFunction":                           JSFunction,
+	"JSLibrary":                            JSLibrary,
+	"JSRule":                               JSRule,
+	"JSTarget":                             JSTarget,
+	"JSTargetType":                         JSTargetType,
+	"JSValidator":                          JSValidator,
+	"LanguageInConstraintComponent":        LanguageInConstraintComponent,
+	"LessThanConstraintComponent":          LessThanConstraintComponent,
+	"LessThanOrEqualsConstraintComponent":  LessThanOrEqualsConstraintComponent,
+	"Literal":                              Literal,
+	"MaxCountConstraintComponent":          MaxCountConstraintComponent,
+	"MaxExclusiveConstraintComponent":      MaxExclusiveConstraintComponent,
+	"MaxInclusiveConstraintComponent":      MaxInclusiveConstraintComponent,
+	"MaxLengthConstraintComponent":         MaxLengthConstraintComponent,
+	"MinCountConstraintComponent":          MinCountConstraintComponent,
+	"MinExclusiveConstraintComponent":      MinExclusiveConstraintComponent,
+	"MinInclusiveConstraintComponent":      MinInclusiveConstraintComponent,
+	"MinLengthConstraintComponent":         MinLengthConstraintComponent,
+	"NodeConstraintComponent":              NodeConstraintComponent,
+	"NodeKind":                             NodeKind,
+	"NodeKindConstraintComponent":          NodeKindConstraintComponent,
+	"NodeShape":                            NodeShape,
+	"NotConstraintComponent":               NotConstraintComponent,
+	"OrConstraintComponent":                OrConstraintComponent,
+	"Parameter":                            Parameter,
+	"Parameterizable":                      Parameterizable,
+	"PatternConstraintComponent":           PatternConstraintComponent,
+	"PrefixDeclaration":                    PrefixDeclaration,
+	"PropertyConstraintComponent":          PropertyConstraintComponent,
+	"PropertyGroup":                        PropertyGroup,
+	"PropertyShape":                        PropertyShape,
+	"QualifiedMaxCountConstraintComponent": QualifiedMaxCountConstraintComponent,
+	"QualifiedMinCountConstraintComponent": QualifiedMinCountConstraintComponent,
+	"ResultAnnotation":                     ResultAnnotation,
+	"Rule":                                 Rule,
+	"SPARQLAskExecutable":                  SPARQLAskExecutable,
+	"SPARQLAskValidator":                   SPARQLAskValidator,
+	"SPARQLConstraint":                     SPARQLConstraint,
+	"SPARQLConstraintComponent":            SPARQLConstraintComponent,
+	"SPARQLConstructExecutable":            SPARQLConstructExecutable,
+	"SPARQLExecutable":                     SPARQLExecutable,
+	"SPARQLFunction":                       SPARQLFunction,
+	"SPARQLRule":                           SPARQLRule,
+	"SPARQLSelectExecutable":               SPARQLSelectExecutable,
+	"SPARQLSelectValidator":                SPARQLSelectValidator,
+	"SPARQLTarget":                         SPARQLTarget,
+	"SPARQLTargetType":                     SPARQLTargetType,
+	"SPARQLUpdateExecutable":               SPARQLUpdateExecutable,
+	"Severity":                             Severity,
+	"Shape":                                Shape,
+	"Target":                               Target,
+	"TargetType":                           TargetType,
+	"TripleRule":                           TripleRule,
+	"UniqueLangConstraintComponent":        UniqueLangConstraintComponent,
+	"ValidationReport":                     ValidationReport,
+	"ValidationResult":                     ValidationResult,
+	"Validator":                            Validator,
+	"Violation":                            Violation,
+	"Warning":                              Warning,
+	"XoneConstraintComponent":              XoneConstraintComponent,
+	"alternativePath":                      AlternativePath,
+	"and":                                  And,
+	"annotationProperty":                   AnnotationProperty,
+	"annotationValue":                      AnnotationValue,
+	"annotationVarName":                    AnnotationVarName,
+	"ask":                                  Ask,
+	"class":                                Class,
+	"closed":                               Closed,
+	"condition":                            Condition,
+	"conforms":                             Conforms,
+	"construct":                            Construct,
+	"datatype":                             Datatype,
+	"deactivated":                          Deactivated,
+	"declare":                              Declare,
+	"defaultValue":                         DefaultValue,
+	"description":                          Description,
+	"detail":                               Detail,
+	"disjoint":                             Disjoint,
+	"entailment":                           Entailment,
+	"equals":                               Equals,
+	"expression":                           Expression,
+	"filterShape":                          FilterShape,
+	"flags":                                Flags,
+	"focusNode":                            FocusNode,
+	"group":                                Group,
+	"hasValue":                             HasValue,
+	"ignoredProperties":                    IgnoredProperties,
+	"in":                                   In,
+	"intersection":                         Intersection,
+	"inversePath":                          InversePath,
+	"js":                                   Js,
+	"jsFunctionName":                       JsFunctionName,
+	"jsLibrary":                            JsLibrary,
+	"jsLibraryURL":                         JsLibraryURL,
+	"labelTemplate":                        LabelTemplate,
+	"languageIn":                           LanguageIn,
+	"lessThan":                             LessThan,
+	"lessThanOrEquals":                     LessThanOrEquals,
+	"maxCount":                             MaxCount,
+	"maxExclusive":                         MaxExclusive,
+	"maxInclusive":                         MaxInclusive,
+	"maxLength":                            MaxLength,
+	"message":                              Message,
+	"minCount":                             MinCount,
+	"minExclusive":                         MinExclusive,
+	"minInclusive":                         MinInclusive,
+	"minLength":                            MinLength,
+	"name":                                 Name,
+	"namespace":                            NamespaceProperty,
+	"node":                                 Node,
+	"nodeKind":                             NodeKindProperty,
+	"nodeValidator":                        NodeValidator,
+	"nodes":                                Nodes,
+	"not":                                  Not,
+	"object":                               Object,
+	"oneOrMorePath":                        OneOrMorePath,
+	"optional":                             Optional,
+	"or":                                   Or,
+	"order":                                Order,
+	"parameter":                            ParameterProperty,
+	"path":                                 Path,
+	"pattern":                              Pattern,
+	"predicate":                            Predicate,
+	"prefix":                               PrefixProperty,
+	"prefixes":                             Prefixes,
+	"property":                             Property,
+	"propertyValidator":                    PropertyValidator,
+	"qualifiedMaxCount":                    QualifiedMaxCount,
+	"qualifiedMinCount":                    QualifiedMinCount,
+	"qualifiedValueShape":                  QualifiedValueShape,
+	"qualifiedValueShapesDisjoint":         QualifiedValueShapesDisjoint,
+	"result":                               Result,
+	"resultAnnotation":                     ResultAnnotationProperty,
+	"resultMessage":                        ResultMessage,
+	"resultPath":                           ResultPath,
+	"resultSeverity":                       ResultSeverity,
+	"returnType":                           ReturnType,
+	"rule":                                 RuleProperty,
+	"select":                               Select,
+	"severity":                             SeverityProperty,
+	"shapesGraph":                          ShapesGraph,
+	"shapesGraphWellFormed":                ShapesGraphWellFormed,
+	"sourceConstraint":                     SourceConstraint,
+	"sourceConstraintComponent":            SourceConstraintComponent,
+	"sourceShape":                          SourceShape,
+	"sparql":                               Sparql,
+	"subject":                              Subject,
+	"suggestedShapesGraph":                 SuggestedShapesGraph,
+	"target":                               TargetProperty,
+	"targetClass":                          TargetClass,
+	"targetNode":                           TargetNode,
+	"targetObjectsOf":                      TargetObjectsOf,
+	"targetSubjectsOf":                     TargetSubjectsOf,
+	"this":                                 This,
+	"union":                                Union,
+	"uniqueLang":                           UniqueLang,
+	"update":                               Update,
+	"validator":                            ValidatorProperty,
+	"value":                                Value,
+	"xone":                                 Xone,
+	"zeroOrMorePath":                       ZeroOrMorePath,
+	"zeroOrOnePath":                        ZeroOrOnePath,
+}
@@ -0,0 +1,85 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/skos.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the SKOS vocabulary.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://www.w3.org/2004/02/skos/core#"
+	Prefix    = "skos"
+)
+
+var (
+	Collection         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Collection")
+	Concept            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Concept")
+	ConceptScheme      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ConceptScheme")
+	OrderedCollection  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "OrderedCollection")
+	AltLabel           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "altLabel")
+	BroadMatch         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "broadMatch")
+	Broader            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "broader")
+	BroaderTransitive  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "broaderTransitive")
+	ChangeNote         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "changeNote")
+	CloseMatch         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "closeMatch")
+	Definition         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "definition")
+	EditorialNote      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "editorialNote")
+	ExactMatch         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "exactMatch")
+	Example            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "example")
+	HasTopConcept      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hasTopConcept")
+	HiddenLabel        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hiddenLabel")
+	HistoryNote        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "historyNote")
+	InScheme           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "inScheme")
+	MappingRelation    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "mappingRelation")
+	Member             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "member")
+	MemberList         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "memberList")
+	NarrowMatch        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "narrowMatch")
+	Narrower           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "narrower")
+	NarrowerTransitive interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "narrowerTransitive")
+	Notation           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "notation")
+	Note               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "note")
+	PrefLabel          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "prefLabel")
+	Related            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "related")
+	RelatedMatch       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "relatedMatch")
+	ScopeNote          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "scopeNote")
+	SemanticRelation   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "semanticRelation")
+	TopConceptOf       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "topConceptOf")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"Collection":         Collection,
+	"Concept":            Concept,
+	"ConceptScheme":      ConceptScheme,
+	"OrderedCollection":  OrderedCollection,
+	"altLabel":           AltLabel,
+	"broadMatch":         BroadMatch,
+	"broader":            Broader,
+	"broaderTransitive":  BroaderTransitive,
+	"changeNote":         ChangeNote,
+	"closeMatch":         CloseMatch,
+	"definition":         Definition,
+	"editorialNote":      EditorialNote,
+	"exactMatch":         ExactMatch,
+	"example":            Example,
+	"hasTopConcept":      HasTopConcept,
+	"hiddenLabel":        HiddenLabel,
+	"historyNote":        HistoryNote,
+	"inScheme":           InScheme,
+	"mappingRelation":    MappingRelation,
+	"member":             Member,
+	"memberList":         MemberList,
+	"narrowMatch":        NarrowMatch,
+	"narrower":           Narrower,
+	"narrowerTransitive": NarrowerTransitive,
+	"notation":           Notation,
+	"note":               Note,
+	"prefLabel":          PrefLabel,
+	"related":            Related,
+	"relatedMatch":       RelatedMatch,
+	"scopeNote":          ScopeNote,
+	"semanticRelation":   SemanticRelation,
+	"topConceptOf":       TopConceptOf,
+}
@@ -0,0 +1,147 @@
+// Code generated by cmd/vocab from ../../cmd/vocab/ontologies/xsd.ttl. DO NOT EDIT.
+
+// Package rdfgo holds the terms of the XML Schema datatypes.
+package rdfgo
+
+import (
+	"github.com/maartyman/rdfgo/interfaces"
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+const (
+	Namespace = "http://www.w3.org/2001/XMLSchema#"
+	Prefix    = "xsd"
+)
+
+var (
+	ENTITIES           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ENTITIES")
+	ENTITY             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ENTITY")
+	ID                 interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "ID")
+	IDREF              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "IDREF")
+	IDREFS             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "IDREFS")
+	NCName             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NCName")
+	NMTOKEN            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NMTOKEN")
+	NMTOKENS           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NMTOKENS")
+	NOTATION           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "NOTATION")
+	Name               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "Name")
+	QName              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "QName")
+	AnyAtomicType      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "anyAtomicType")
+	AnySimpleType      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "anySimpleType")
+	AnyType            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "anyType")
+	AnyURI             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "anyURI")
+	Base64Binary       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "base64Binary")
+	Boolean            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "boolean")
+	Byte               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "byte")
+	Date               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "date")
+	DateTime           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dateTime")
+	DateTimeStamp      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dateTimeStamp")
+	DayTimeDuration    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "dayTimeDuration")
+	Decimal            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "decimal")
+	Double             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "double")
+	Duration           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "duration")
+	Enumeration        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "enumeration")
+	ExplicitTimezone   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "explicitTimezone")
+	Float              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "float")
+	FractionDigits     interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "fractionDigits")
+	GDay               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gDay")
+	GMonth             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gMonth")
+	GMonthDay          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gMonthDay")
+	GYear              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gYear")
+	GYearMonth         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "gYearMonth")
+	HexBinary          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "hexBinary")
+	Int                interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "int")
+	Integer            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "integer")
+	Language           interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "language")
+	Length             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "length")
+	Long               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "long")
+	MaxExclusive       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxExclusive")
+	MaxInclusive       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxInclusive")
+	MaxLength          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "maxLength")
+	MinExclusive       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minExclusive")
+	MinInclusive       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minInclusive")
+	MinLength          interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "minLength")
+	NegativeInteger    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "negativeInteger")
+	NonNegativeInteger interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nonNegativeInteger")
+	NonPositiveInteger interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "nonPositiveInteger")
+	NormalizedString   interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "normalizedString")
+	Pattern            interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "pattern")
+	PositiveInteger    interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "positiveInteger")
+	Short              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "short")
+	String             interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "string")
+	Time               interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "time")
+	Token              interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "token")
+	TotalDigits        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "totalDigits")
+	UnsignedByte       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "unsignedByte")
+	UnsignedInt        interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "unsignedInt")
+	UnsignedLong       interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "unsignedLong")
+	UnsignedShort      interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "unsignedShort")
+	WhiteSpace         interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "whiteSpace")
+	YearMonthDuration  interfaces.INamedNode = datamodel.NewNamedNode(Namespace + "yearMonthDuration")
+)
+
+// Terms holds the terms of the vocabulary by local name.
+var Terms = map[string]interfaces.INamedNode{
+	"ENTITIES":           ENTITIES,
+	"ENTITY":             ENTITY,
+	"ID":                 ID,
+	"IDREF":              IDREF,
+	"IDREFS":             IDREFS,
+	"NCName":             NCName,
+	"NMTOKEN":            NMTOKEN,
+	"NMTOKENS":           NMTOKENS,
+	"NOTATION":           NOTATION,
+	"Name":               Name,
+	"QName":              QName,
+	"anyAtomicType":      AnyAtomicType,
+	"anySimpleType":      AnySimpleType,
+	"anyType":            AnyType,
+	"anyURI":             AnyURI,
+	"base64Binary":       Base64Binary,
+	"boolean":            Boolean,
+	"byte":               Byte,
+	"date":               Date,
+	"dateTime":           DateTime,
+	"dateTimeStamp":      DateTimeStamp,
+	"dayTimeDuration":    DayTimeDuration,
+	"decimal":            Decimal,
+	"double":             Double,
+	"duration":           Duration,
+	"enumeration":        Enumeration,
+	"explicitTimezone":   ExplicitTimezone,
+	"float":              Float,
+	"fractionDigits":     FractionDigits,
+	"gDay":               GDay,
+	"gMonth":             GMonth,
+	"gMonthDay":          GMonthDay,
+	"gYear":              GYear,
+	"gYearMonth":         GYearMonth,
+	"hexBinary":          HexBinary,
+	"int":                Int,
+	"integer":            Integer,
+	"language":           Language,
+	"length":             Length,
+	"long":               Long,
+	"maxExclusive":       MaxExclusive,
+	"maxInclusive":       MaxInclusive,
+	"maxLength":          MaxLength,
+	"minExclusive":       MinExclusive,
+	"minInclusive":       MinInclusive,
+	"minLength":          MinLength,
+	"negativeInteger":    NegativeInteger,
+	"nonNegativeInteger": NonNegativeInteger,
+	"nonPositiveInteger": NonPositiveInteger,
+	"normalizedString":   NormalizedString,
+	"pattern":            Pattern,
+	"positiveInteger":    PositiveInteger,
+	"short":              Short,
+	"string":             String,
+	"time":               Time,
+	"token":              Token,
+	"totalDigits":        TotalDigits,
+	"unsignedByte":       UnsignedByte,
+	"unsignedInt":        UnsignedInt,
+	"unsignedLong":       UnsignedLong,
+	"unsignedShort":      UnsignedShort,
+	"whiteSpace":         WhiteSpace,
+	"yearMonthDuration":  YearMonthDuration,
+}
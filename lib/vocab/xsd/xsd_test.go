@@ -0,0 +1,34 @@
+package rdfgo
+
+import (
+	"testing"
+
+	datamodel "github.com/maartyman/rdfgo/lib/data_model"
+	xsd "github.com/maartyman/rdfgo/lib/xsd"
+)
+
+func TestTerms(t *testing.T) {
+	if Namespace != xsd.Namespace {
+		t.Fatalf("expected namespace %s, got %s", xsd.Namespace, Namespace)
+	}
+	// Every datatype that the xsd package supports is in the vocabulary.
+	for _, datatype := range []string{
+		xsd.String, xsd.NormalizedString, xsd.Token, xsd.Language, xsd.AnyURI, xsd.Boolean, xsd.Decimal,
+		xsd.Integer, xsd.NonPositiveInteger, xsd.NegativeInteger, xsd.Long, xsd.Int, xsd.Short, xsd.Byte,
+		xsd.NonNegativeInteger, xsd.UnsignedLong, xsd.UnsignedInt, xsd.UnsignedShort, xsd.UnsignedByte,
+		xsd.PositiveInteger, xsd.Float, xsd.Double, xsd.DateTime, xsd.DateTimeStamp, xsd.Date, xsd.Time,
+		xsd.GYear, xsd.GYearMonth, xsd.GMonth, xsd.GMonthDay, xsd.GDay, xsd.Duration, xsd.DayTimeDuration,
+		xsd.YearMonthDuration, xsd.HexBinary, xsd.Base64Binary,
+	} {
+		term := Terms[datatype[len(Namespace):]]
+		if term == nil || term.GetValue() != datatype {
+			t.Errorf("expected a term for %s, got %v", datatype, term)
+		}
+	}
+	if !Integer.Equals(datamodel.IRI.XSD.Integer) || !String.Equals(datamodel.IRI.XSD.String) {
+		t.Errorf("expected the terms to equal the ones of the data model")
+	}
+	if len(Terms) != 63 {
+		t.Errorf("expected 63 terms, got %d", len(Terms))
+	}
+}
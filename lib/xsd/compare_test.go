@@ -0,0 +1,125 @@
+package rdfgo
+
+import (
+	"testing"
+)
+
+func mustParse(t *testing.T, lexical string, datatype string) Value {
+	t.Helper()
+	value, err := Parse(lexical, datatype)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        [2]string
+		b        [2]string
+		expected int
+		ok       bool
+	}{
+		{"integers", [2]string{"1", Integer}, [2]string{"01", Byte}, 0, true},
+		{"integer and decimal", [2]string{"1", Integer}, [2]string{"1.0", Decimal}, 0, true},
+		{"decimal and double", [2]string{"0.5", Decimal}, [2]string{"5E-1", Double}, 0, true},
+		{"exact decimals", [2]string{"0.1", Decimal}, [2]string{"0.10000000000000000001", Decimal}, -1, true},
+		{"float and integer", [2]string{"INF", Float}, [2]string{"99999999999999999999", Integer}, 1, true},
+		{"NaN", [2]string{"NaN", Double}, [2]string{"NaN", Double}, 0, false},
+		{"number and string", [2]string{"1", Integer}, [2]string{"1", String}, 0, false},
+		{"booleans", [2]string{"0", Boolean}, [2]string{"true", Boolean}, -1, true},
+		{"strings", [2]string{"b", String}, [2]string{"a", Token}, 1, true},
+		{"dateTimes in timezones", [2]string{"2024-01-01T01:00:00+01:00", DateTime}, [2]string{"2024-01-01T00:00:00Z", DateTimeStamp}, 0, true},
+		{"dateTimes without timezone", [2]string{"2024-01-01T00:00:00", DateTime}, [2]string{"2024-01-01T00:00:01", DateTime}, -1, true},
+		{"dateTime near a timezone", [2]string{"2024-01-01T00:00:00", DateTime}, [2]string{"2024-01-01T10:00:00Z", DateTime}, 0, false},
+		{"dateTime far from a timezone", [2]string{"2024-01-01T00:00:00Z", DateTime}, [2]string{"2024-01-02T00:00:00", DateTime}, -1, true},
+		{"dateTime and date", [2]string{"2024-01-01T00:00:00Z", DateTime}, [2]string{"2024-01-01Z", Date}, 0, false},
+		{"times", [2]string{"23:00:00-01:00", Time}, [2]string{"00:00:00Z", Time}, 1, true},
+		{"gMonthDays", [2]string{"--02-29", GMonthDay}, [2]string{"--03-01", GMonthDay}, -1, true},
+		{"durations", [2]string{"P1Y", YearMonthDuration}, [2]string{"P12M", Duration}, 0, true},
+		{"year and days", [2]string{"P1Y", Duration}, [2]string{"P364D", DayTimeDuration}, 1, true},
+		{"month and days", [2]string{"P1M", Duration}, [2]string{"P30D", Duration}, 0, false},
+		{"negative durations", [2]string{"-P1M", Duration}, [2]string{"-P27D", Duration}, -1, true},
+		{"binaries", [2]string{"00", HexBinary}, [2]string{"01", HexBinary}, -1, true},
+		{"hex and base64", [2]string{"00", HexBinary}, [2]string{"AA==", Base64Binary}, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, b := mustParse(t, test.a[0], test.a[1]), mustParse(t, test.b[0], test.b[1])
+			order, ok := Compare(a, b)
+			if ok != test.ok || ok && order != test.expected {
+				t.Errorf("Expected %d and %t, got %d and %t", test.expected, test.ok, order, ok)
+			}
+			reverse, reverseOK := Compare(b, a)
+			if reverseOK != ok || ok && reverse != -order {
+				t.Errorf("Expected the reverse order, got %d and %t", reverse, reverseOK)
+			}
+			if Equal(a, b) != (ok && order == 0) {
+				t.Errorf("Expected Equal to agree with Compare")
+			}
+			if total := Order(a, b); ok && order != 0 && total != order || total != -Order(b, a) {
+				t.Errorf("Expected Order to agree with Compare, got %d", total)
+			}
+		})
+	}
+}
+
+func TestValue_Key(t *testing.T) {
+	equal := [][2][2]string{
+		{{"1", Integer}, {"1.00", Decimal}},
+		{{"1", Byte}, {"1E0", Double}},
+		{{"0.5", Decimal}, {"5E-1", Float}},
+		{{"2024-01-01T01:00:00+01:00", DateTime}, {"2024-01-01T00:00:00Z", DateTimeStamp}},
+		{{"P1Y", YearMonthDuration}, {"P12M", Duration}},
+		{{"a", String}, {" a ", Token}},
+	}
+	for _, pair := range equal {
+		a, b := mustParse(t, pair[0][0], pair[0][1]), mustParse(t, pair[1][0], pair[1][1])
+		if a.Key() != b.Key() {
+			t.Errorf("Expected %v and %v to have the same key, got %q and %q", pair[0], pair[1], a.Key(), b.Key())
+		}
+	}
+	different := [][2][2]string{
+		{{"0.1", Decimal}, {"0.1", Double}},
+		{{"2024-01-01T00:00:00", DateTime}, {"2024-01-01T00:00:00Z", DateTime}},
+		{{"1", Integer}, {"1", String}},
+		{{"P1M", Duration}, {"P30D", Duration}},
+		{{"AA==", Base64Binary}, {"00", HexBinary}},
+	}
+	for _, pair := range different {
+		a, b := mustParse(t, pair[0][0], pair[0][1]), mustParse(t, pair[1][0], pair[1][1])
+		if a.Key() == b.Key() {
+			t.Errorf("Expected %v and %v to have different keys, got %q", pair[0], pair[1], a.Key())
+		}
+	}
+}
+
+func TestOrder(t *testing.T) {
+	values := []Value{
+		mustParse(t, "NaN", Double),
+		mustParse(t, "-INF", Float),
+		mustParse(t, "-1", Integer),
+		mustParse(t, "0.1", Decimal),
+		mustParse(t, "0.1", Double),
+		mustParse(t, "99999999999999999999", Integer),
+		mustParse(t, "INF", Double),
+		mustParse(t, "2024-01-01T00:00:00", DateTime),
+		mustParse(t, "2024-01-01T00:00:00Z", DateTime),
+		mustParse(t, "2024-01-01T03:00:00+02:00", DateTime),
+		mustParse(t, "P1M", Duration),
+		mustParse(t, "P30DT12H", Duration),
+		mustParse(t, "P31D", Duration),
+	}
+	for i, a := range values {
+		for j, b := range values {
+			if a.Family() != b.Family() {
+				continue
+			}
+			order := Order(a, b)
+			if i < j && order >= 0 || i > j && order <= 0 || i == j && order != 0 {
+				t.Errorf("Expected %s^^%s and %s^^%s in the order %d, %d, got %d", a, a.Datatype, b, b.Datatype, i, j, order)
+			}
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package rdfgo
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	integerPattern = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	decimalPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+	floatPattern   = regexp.MustCompile(`^([+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?|[+-]?INF|NaN)$`)
+)
+
+// integerRange is the range of an integer datatype, a nil bound is
+// unbounded.
+type integerRange struct {
+	min *big.Int
+	max *big.Int
+}
+
+func bits(exponent uint, offset int64) *big.Int {
+	bound := new(big.Int).Lsh(big.NewInt(1), exponent)
+	return bound.Add(bound, big.NewInt(offset))
+}
+
+var integerRanges = map[string]integerRange{
+	Integer:            {},
+	NonPositiveInteger: {max: big.NewInt(0)},
+	NegativeInteger:    {max: big.NewInt(-1)},
+	NonNegativeInteger: {min: big.NewInt(0)},
+	PositiveInteger:    {min: big.NewInt(1)},
+	Long:               {min: new(big.Int).Neg(bits(63, 0)), max: bits(63, -1)},
+	Int:                {min: big.NewInt(math.MinInt32), max: big.NewInt(math.MaxInt32)},
+	Short:              {min: big.NewInt(math.MinInt16), max: big.NewInt(math.MaxInt16)},
+	Byte:               {min: big.NewInt(math.MinInt8), max: big.NewInt(math.MaxInt8)},
+	UnsignedLong:       {min: big.NewInt(0), max: bits(64, -1)},
+	UnsignedInt:        {min: big.NewInt(0), max: big.NewInt(math.MaxUint32)},
+	UnsignedShort:      {min: big.NewInt(0), max: big.NewInt(math.MaxUint16)},
+	UnsignedByte:       {min: big.NewInt(0), max: big.NewInt(math.MaxUint8)},
+}
+
+func numericDatatypes() map[string]datatype {
+	result := map[string]datatype{
+		Decimal: {parse: parseDecimal, format: func(native any) string { return formatDecimal(native.(*big.Rat)) }},
+		Float: {parse: func(lexical string) (any, bool) {
+			value, ok := parseFloat(lexical, 32)
+			return float32(value), ok
+		}, format: func(native any) string { return formatFloat(float64(native.(float32)), 32) }},
+		Double: {parse: func(lexical string) (any, bool) {
+			return parseFloat(lexical, 64)
+		}, format: func(native any) string { return formatFloat(native.(float64), 64) }},
+	}
+	for iri, bounds := range integerRanges {
+		result[iri] = datatype{parse: func(lexical string) (any, bool) {
+			return parseInteger(lexical, bounds)
+		}, format: func(native any) string { return native.(*big.Int).String() }}
+	}
+	return result
+}
+
+func isNumeric(datatype string) bool {
+	_, integer := integerRanges[datatype]
+	return integer || datatype == Decimal || datatype == Float || datatype == Double
+}
+
+func parseInteger(lexical string, bounds integerRange) (any, bool) {
+	lexical = collapse(lexical)
+	if !integerPattern.MatchString(lexical) {
+		return nil, false
+	}
+	value, _ := new(big.Int).SetString(strings.TrimPrefix(lexical, "+"), 10)
+	if bounds.min != nil && value.Cmp(bounds.min) < 0 || bounds.max != nil && value.Cmp(bounds.max) > 0 {
+		return nil, false
+	}
+	return value, true
+}
+
+func parseDecimal(lexical string) (any, bool) {
+	lexical = collapse(lexical)
+	if !decimalPattern.MatchString(lexical) {
+		return nil, false
+	}
+	// big.Rat does not accept a decimal point without digits on both sides.
+	sign, digits := "", strings.TrimPrefix(lexical, "+")
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if strings.HasPrefix(digits, ".") {
+		digits = "0" + digits
+	}
+	if strings.HasSuffix(digits, ".") {
+		digits += "0"
+	}
+	value, ok := new(big.Rat).SetString(sign + digits)
+	return value, ok
+}
+
+// formatDecimal writes a decimal with the digits it needs after the decimal
+// point, and at least one.
+func formatDecimal(value *big.Rat) string {
+	places := 1
+	scaled := new(big.Rat).Set(value)
+	ten := big.NewRat(10, 1)
+	for !scaled.IsInt() {
+		scaled.Mul(scaled, ten)
+		places++
+	}
+	formatted := value.FloatString(places)
+	formatted = strings.TrimRight(formatted, "0")
+	if strings.HasSuffix(formatted, ".") {
+		formatted += "0"
+	}
+	if formatted == "-0.0" {
+		return "0.0"
+	}
+	return formatted
+}
+
+func parseFloat(lexical string, size int) (float64, bool) {
+	lexical = collapse(lexical)
+	if !floatPattern.MatchString(lexical) {
+		return 0, false
+	}
+	switch lexical {
+	case "INF", "+INF":
+		return math.Inf(1), true
+	case "-INF":
+		return math.Inf(-1), true
+	case "NaN":
+		return math.NaN(), true
+	}
+	// Values out of range round to infinity or zero.
+	value, err := strconv.ParseFloat(lexical, size)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, false
+	}
+	return value, true
+}
+
+// formatFloat writes a float in scientific notation with one digit before
+// the decimal point, at least one after it, and an exponent without leading
+// zeros, like 1.5E3.
+func formatFloat(value float64, size int) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "INF"
+	case math.IsInf(value, -1):
+		return "-INF"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	formatted := strconv.FormatFloat(value, 'E', -1, size)
+	mantissa, exponent, _ := strings.Cut(formatted, "E")
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+	power, _ := strconv.Atoi(exponent)
+	return mantissa + "E" + strconv.Itoa(power)
+}
+
+// exactKey writes the exact number a float holds like a decimal, and
+// infinity and NaN like a float.
+func exactKey(native any) string {
+	if exact := exactNumber(native); exact != nil {
+		return formatDecimal(exact)
+	}
+	value := floatNumber(native)
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return formatFloat(value, 64)
+	}
+	return formatDecimal(new(big.Rat).SetFloat64(value))
+}
+
+// compareNumbers compares numbers as floats when one of them is a float or a
+// double, and exactly otherwise.
+func compareNumbers(a Value, b Value) (int, bool) {
+	x, y := exactNumber(a.Native), exactNumber(b.Native)
+	if x != nil && y != nil {
+		return x.Cmp(y), true
+	}
+	f, g := floatNumber(a.Native), floatNumber(b.Native)
+	if math.IsNaN(f) || math.IsNaN(g) {
+		return 0, false
+	}
+	switch {
+	case f < g:
+		return -1, true
+	case f > g:
+		return 1, true
+	}
+	return 0, true
+}
+
+// orderNumbers orders NaN, negative infinity, the finite numbers by their
+// exact value and positive infinity.
+func orderNumbers(a any, b any) int {
+	rank := func(native any) int {
+		value := floatNumber(native)
+		switch {
+		case exactNumber(native) != nil:
+			return 2
+		case math.IsNaN(value):
+			return 0
+		case math.IsInf(value, -1):
+			return 1
+		case math.IsInf(value, 1):
+			return 3
+		}
+		return 2
+	}
+	if x, y := rank(a), rank(b); x != y || x != 2 {
+		return x - y
+	}
+	exact := func(native any) *big.Rat {
+		if value := exactNumber(native); value != nil {
+			return value
+		}
+		return new(big.Rat).SetFloat64(floatNumber(native))
+	}
+	return exact(a).Cmp(exact(b))
+}
+
+// exactNumber returns an integer or decimal as a big.Rat, and nil for floats.
+func exactNumber(native any) *big.Rat {
+	switch value := native.(type) {
+	case *big.Int:
+		return new(big.Rat).SetInt(value)
+	case *big.Rat:
+		return value
+	}
+	return nil
+}
+
+func floatNumber(native any) float64 {
+	switch value := native.(type) {
+	case float32:
+		return float64(value)
+	case float64:
+		return value
+	}
+	value, _ := exactNumber(native).Float64()
+	return value
+}
@@ -0,0 +1,397 @@
+package rdfgo
+
+import (
+	"cmp"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TemporalValue is the value of a date or time datatype. The fields that a
+// datatype does not have are set to a reference date in 1972, a leap year,
+// so values of the same datatype can be compared. Timezone is the offset
+// from UTC in minutes when HasTimezone is set. Fractions of seconds are kept
+// to the nanosecond.
+type TemporalValue struct {
+	Year        int
+	Month       int
+	Day         int
+	Hour        int
+	Minute      int
+	Second      int
+	Nanosecond  int
+	Timezone    int
+	HasTimezone bool
+}
+
+// DurationValue is the value of a duration datatype: a number of months and
+// a number of seconds, which have the same sign.
+type DurationValue struct {
+	Months  int64
+	Seconds *big.Rat
+}
+
+const (
+	yearPart     = `(?P<year>-?(?:[1-9][0-9]{3,}|0[0-9]{3}))`
+	monthPart    = `(?P<month>0[1-9]|1[0-2])`
+	dayPart      = `(?P<day>0[1-9]|[12][0-9]|3[01])`
+	timePart     = `(?P<hour>[01][0-9]|2[0-4]):(?P<minute>[0-5][0-9]):(?P<second>[0-5][0-9])(?P<fraction>\.[0-9]+)?`
+	timezonePart = `(?P<timezone>Z|[+-](?:(?:0[0-9]|1[0-3]):[0-5][0-9]|14:00))`
+)
+
+// temporalFormat is a date or time datatype.
+type temporalFormat struct {
+	pattern *regexp.Regexp
+	// utc makes the canonical form use UTC instead of the timezone of the
+	// value.
+	utc    bool
+	layout func(value TemporalValue) string
+}
+
+func temporalPattern(parts string, timezone string) *regexp.Regexp {
+	return regexp.MustCompile("^" + parts + timezonePart + timezone + "$")
+}
+
+func temporalDatatypes() map[string]datatype {
+	date, clock, dateTime := formatDate, formatClock, formatDateTime
+	formats := map[string]temporalFormat{
+		DateTime:      {pattern: temporalPattern(yearPart+"-"+monthPart+"-"+dayPart+"T"+timePart, "?"), utc: true, layout: dateTime},
+		DateTimeStamp: {pattern: temporalPattern(yearPart+"-"+monthPart+"-"+dayPart+"T"+timePart, ""), utc: true, layout: dateTime},
+		Time:          {pattern: temporalPattern(timePart, "?"), utc: true, layout: clock},
+		Date:          {pattern: temporalPattern(yearPart+"-"+monthPart+"-"+dayPart, "?"), layout: date},
+		GYear:         {pattern: temporalPattern(yearPart, "?"), layout: func(value TemporalValue) string { return formatYear(value.Year) }},
+		GYearMonth: {pattern: temporalPattern(yearPart+"-"+monthPart, "?"), layout: func(value TemporalValue) string {
+			return formatYear(value.Year) + fmt.Sprintf("-%02d", value.Month)
+		}},
+		GMonth: {pattern: temporalPattern("--"+monthPart, "?"), layout: func(value TemporalValue) string {
+			return fmt.Sprintf("--%02d", value.Month)
+		}},
+		GMonthDay: {pattern: temporalPattern("--"+monthPart+"-"+dayPart, "?"), layout: func(value TemporalValue) string {
+			return fmt.Sprintf("--%02d-%02d", value.Month, value.Day)
+		}},
+		GDay: {pattern: temporalPattern("---"+dayPart, "?"), layout: func(value TemporalValue) string {
+			return fmt.Sprintf("---%02d", value.Day)
+		}},
+	}
+
+	result := make(map[string]datatype)
+	for iri, format := range formats {
+		result[iri] = datatype{
+			parse: func(lexical string) (any, bool) { return parseTemporal(lexical, format) },
+			format: func(native any) string {
+				value := native.(TemporalValue)
+				if format.utc && value.HasTimezone {
+					value = value.inUTC()
+				}
+				return format.layout(value) + formatTimezone(value)
+			},
+		}
+	}
+	for iri, kind := range map[string]string{Duration: "", DayTimeDuration: "dayTime", YearMonthDuration: "yearMonth"} {
+		result[iri] = datatype{
+			parse: func(lexical string) (any, bool) { return parseDuration(lexical, kind) },
+			format: func(native any) string {
+				return formatDuration(native.(DurationValue), kind == "yearMonth")
+			},
+		}
+	}
+	return result
+}
+
+func parseTemporal(lexical string, format temporalFormat) (any, bool) {
+	match := format.pattern.FindStringSubmatch(collapse(lexical))
+	if match == nil {
+		return nil, false
+	}
+	value := TemporalValue{Year: 1972, Month: 12, Day: 1}
+	for i, name := range format.pattern.SubexpNames() {
+		part := match[i]
+		if name == "" || part == "" {
+			continue
+		}
+		var err error
+		switch name {
+		case "year":
+			value.Year, err = strconv.Atoi(part)
+		case "month":
+			value.Month, _ = strconv.Atoi(part)
+		case "day":
+			value.Day, _ = strconv.Atoi(part)
+		case "hour":
+			value.Hour, _ = strconv.Atoi(part)
+		case "minute":
+			value.Minute, _ = strconv.Atoi(part)
+		case "second":
+			value.Second, _ = strconv.Atoi(part)
+		case "fraction":
+			value.Nanosecond, _ = strconv.Atoi((part[1:] + "000000000")[:9])
+		case "timezone":
+			value.HasTimezone = true
+			if part != "Z" {
+				hours, _ := strconv.Atoi(part[1:3])
+				minutes, _ := strconv.Atoi(part[4:6])
+				value.Timezone = hours*60 + minutes
+				if part[0] == '-' {
+					value.Timezone = -value.Timezone
+				}
+			}
+		}
+		if err != nil {
+			return nil, false
+		}
+	}
+	if value.Day > daysIn(value.Year, value.Month) {
+		return nil, false
+	}
+	if value.Hour == 24 {
+		if value.Minute != 0 || value.Second != 0 || value.Nanosecond != 0 {
+			return nil, false
+		}
+		// 24:00:00 is the first moment of the next day.
+		next := time.Date(value.Year, time.Month(value.Month), value.Day+1, 0, 0, 0, 0, time.UTC)
+		if format.pattern.SubexpIndex("day") >= 0 {
+			value.Year, value.Month, value.Day = next.Year(), int(next.Month()), next.Day()
+		}
+		value.Hour = 0
+	}
+	return value, true
+}
+
+func formatDate(value TemporalValue) string {
+	return formatYear(value.Year) + fmt.Sprintf("-%02d-%02d", value.Month, value.Day)
+}
+
+func formatClock(value TemporalValue) string {
+	formatted := fmt.Sprintf("%02d:%02d:%02d", value.Hour, value.Minute, value.Second)
+	if value.Nanosecond > 0 {
+		formatted += strings.TrimRight(fmt.Sprintf(".%09d", value.Nanosecond), "0")
+	}
+	return formatted
+}
+
+func formatDateTime(value TemporalValue) string {
+	return formatDate(value) + "T" + formatClock(value)
+}
+
+func daysIn(year int, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func formatYear(year int) string {
+	if year < 0 {
+		return fmt.Sprintf("-%04d", -year)
+	}
+	return fmt.Sprintf("%04d", year)
+}
+
+func formatTimezone(value TemporalValue) string {
+	if !value.HasTimezone {
+		return ""
+	}
+	if value.Timezone == 0 {
+		return "Z"
+	}
+	sign, offset := "+", value.Timezone
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	return fmt.Sprintf("%s%02d:%02d", sign, offset/60, offset%60)
+}
+
+// instant returns the moment of the value, taking a missing timezone as UTC.
+func (v TemporalValue) instant() time.Time {
+	moment := time.Date(v.Year, time.Month(v.Month), v.Day, v.Hour, v.Minute, v.Second, v.Nanosecond, time.UTC)
+	return moment.Add(-time.Duration(v.Timezone) * time.Minute)
+}
+
+func (v TemporalValue) inUTC() TemporalValue {
+	moment := v.instant()
+	return TemporalValue{
+		Year:        moment.Year(),
+		Month:       int(moment.Month()),
+		Day:         moment.Day(),
+		Hour:        moment.Hour(),
+		Minute:      moment.Minute(),
+		Second:      moment.Second(),
+		Nanosecond:  moment.Nanosecond(),
+		HasTimezone: true,
+	}
+}
+
+// compareTemporals compares the moments of two values. A value without a
+// timezone can be in any timezone from -14:00 to +14:00, so it only has an
+// order with a value with a timezone when they are further apart than that.
+func compareTemporals(a TemporalValue, b TemporalValue) (int, bool) {
+	x, y := a.instant(), b.instant()
+	if a.HasTimezone == b.HasTimezone {
+		return x.Compare(y), true
+	}
+	margin := 14 * time.Hour
+	if !a.HasTimezone {
+		switch {
+		case x.Add(margin).Before(y):
+			return -1, true
+		case x.Add(-margin).After(y):
+			return 1, true
+		}
+		return 0, false
+	}
+	order, ok := compareTemporals(b, a)
+	return -order, ok
+}
+
+// orderTemporals orders values by their moment, and values without a timezone
+// before values with one at the same moment.
+func orderTemporals(a TemporalValue, b TemporalValue) int {
+	if order := a.instant().Compare(b.instant()); order != 0 || a.HasTimezone == b.HasTimezone {
+		return order
+	}
+	if !a.HasTimezone {
+		return -1
+	}
+	return 1
+}
+
+var durationPattern = regexp.MustCompile(`^(-)?P(?:([0-9]+)Y)?(?:([0-9]+)M)?(?:([0-9]+)D)?(?:T(?:([0-9]+)H)?(?:([0-9]+)M)?(?:([0-9]+(?:\.[0-9]+)?)S)?)?$`)
+
+// parseDuration parses a duration, a dayTimeDuration when kind is "dayTime"
+// and a yearMonthDuration when kind is "yearMonth".
+func parseDuration(lexical string, kind string) (any, bool) {
+	lexical = collapse(lexical)
+	match := durationPattern.FindStringSubmatch(lexical)
+	if match == nil || strings.HasSuffix(lexical, "P") || strings.HasSuffix(lexical, "T") {
+		return nil, false
+	}
+	hasMonths := match[2] != "" || match[3] != ""
+	hasSeconds := match[4] != "" || match[5] != "" || match[6] != "" || match[7] != ""
+	if kind == "dayTime" && hasMonths || kind == "yearMonth" && hasSeconds {
+		return nil, false
+	}
+
+	var months int64
+	for i, factor := range []int64{12, 1} {
+		if match[2+i] == "" {
+			continue
+		}
+		count, err := strconv.ParseInt(match[2+i], 10, 64)
+		if err != nil || count > (1<<60)/factor {
+			return nil, false
+		}
+		months += count * factor
+	}
+	seconds := new(big.Rat)
+	for i, factor := range []int64{86400, 3600, 60, 1} {
+		if match[4+i] == "" {
+			continue
+		}
+		count, _ := new(big.Rat).SetString(match[4+i])
+		seconds.Add(seconds, count.Mul(count, big.NewRat(factor, 1)))
+	}
+	if match[1] == "-" {
+		months = -months
+		seconds.Neg(seconds)
+	}
+	return DurationValue{Months: months, Seconds: seconds}, true
+}
+
+func formatDuration(value DurationValue, yearMonth bool) string {
+	months, seconds := value.Months, new(big.Rat).Set(value.Seconds)
+	sign := ""
+	if months < 0 || seconds.Sign() < 0 {
+		sign = "-"
+		months = -months
+		seconds.Neg(seconds)
+	}
+	var formatted strings.Builder
+	formatted.WriteString(sign + "P")
+	if months/12 > 0 {
+		fmt.Fprintf(&formatted, "%dY", months/12)
+	}
+	if months%12 > 0 {
+		fmt.Fprintf(&formatted, "%dM", months%12)
+	}
+
+	whole := new(big.Int).Quo(seconds.Num(), seconds.Denom())
+	fraction := new(big.Rat).Sub(seconds, new(big.Rat).SetInt(whole))
+	parts := make([]*big.Int, 4)
+	for i, unit := range []int64{86400, 3600, 60} {
+		parts[i], whole = new(big.Int).QuoRem(whole, big.NewInt(unit), new(big.Int))
+	}
+	parts[3] = whole
+	if parts[0].Sign() > 0 {
+		fmt.Fprintf(&formatted, "%sD", parts[0])
+	}
+	if parts[1].Sign() > 0 || parts[2].Sign() > 0 || parts[3].Sign() > 0 || fraction.Sign() > 0 {
+		formatted.WriteString("T")
+		if parts[1].Sign() > 0 {
+			fmt.Fprintf(&formatted, "%sH", parts[1])
+		}
+		if parts[2].Sign() > 0 {
+			fmt.Fprintf(&formatted, "%sM", parts[2])
+		}
+		if fraction.Sign() > 0 {
+			formatted.WriteString(formatDecimal(fraction.Add(fraction, new(big.Rat).SetInt(parts[3]))) + "S")
+		} else if parts[3].Sign() > 0 {
+			fmt.Fprintf(&formatted, "%sS", parts[3])
+		}
+	}
+	if formatted.Len() == len(sign)+1 {
+		if yearMonth {
+			return "P0M"
+		}
+		return "PT0S"
+	}
+	return formatted.String()
+}
+
+// compareDurations compares two durations by the shortest and the longest
+// number of seconds they can take: a year takes 365 or 366 days, and other
+// months 28 to 31 days. Durations whose ranges overlap have no order.
+func compareDurations(a DurationValue, b DurationValue) (int, bool) {
+	if a.Months == b.Months {
+		return a.Seconds.Cmp(b.Seconds), true
+	}
+	aShortest, aLongest := durationRange(a)
+	bShortest, bLongest := durationRange(b)
+	switch {
+	case aLongest.Cmp(bShortest) < 0:
+		return -1, true
+	case aShortest.Cmp(bLongest) > 0:
+		return 1, true
+	}
+	return 0, false
+}
+
+// orderDurations orders durations by the middle of their range of lengths,
+// which is inside the range, and then by their months.
+func orderDurations(a DurationValue, b DurationValue) int {
+	middle := func(value DurationValue) *big.Rat {
+		shortest, longest := durationRange(value)
+		return shortest.Add(shortest, longest)
+	}
+	if order := middle(a).Cmp(middle(b)); order != 0 {
+		return order
+	}
+	return cmp.Compare(a.Months, b.Months)
+}
+
+func durationRange(value DurationValue) (*big.Rat, *big.Rat) {
+	months := value.Months
+	negative := months < 0
+	if negative {
+		months = -months
+	}
+	days := func(year int64, month int64) *big.Rat {
+		count := new(big.Int).Mul(big.NewInt(months/12), big.NewInt(year))
+		count.Add(count, big.NewInt(months%12*month))
+		return new(big.Rat).SetInt(count.Mul(count, big.NewInt(86400)))
+	}
+	shortest, longest := days(365, 28), days(366, 31)
+	if negative {
+		shortest, longest = longest.Neg(longest), shortest.Neg(shortest)
+	}
+	return shortest.Add(shortest, value.Seconds), longest.Add(longest, value.Seconds)
+}
@@ -0,0 +1,307 @@
+package rdfgo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/maartyman/rdfgo/interfaces"
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+var InvalidLexicalFormError = errors.New("invalid lexical form")
+var UnsupportedDatatypeError = errors.New("unsupported datatype")
+
+const Namespace = "http://www.w3.org/2001/XMLSchema#"
+
+// The IRIs of the supported datatypes.
+const (
+	String             = Namespace + "string"
+	NormalizedString   = Namespace + "normalizedString"
+	Token              = Namespace + "token"
+	Language           = Namespace + "language"
+	AnyURI             = Namespace + "anyURI"
+	Boolean            = Namespace + "boolean"
+	Decimal            = Namespace + "decimal"
+	Integer            = Namespace + "integer"
+	NonPositiveInteger = Namespace + "nonPositiveInteger"
+	NegativeInteger    = Namespace + "negativeInteger"
+	Long               = Namespace + "long"
+	Int                = Namespace + "int"
+	Short              = Namespace + "short"
+	Byte               = Namespace + "byte"
+	NonNegativeInteger = Namespace + "nonNegativeInteger"
+	UnsignedLong       = Namespace + "unsignedLong"
+	UnsignedInt        = Namespace + "unsignedInt"
+	UnsignedShort      = Namespace + "unsignedShort"
+	UnsignedByte       = Namespace + "unsignedByte"
+	PositiveInteger    = Namespace + "positiveInteger"
+	Float              = Namespace + "float"
+	Double             = Namespace + "double"
+	DateTime           = Namespace + "dateTime"
+	DateTimeStamp      = Namespace + "dateTimeStamp"
+	Date               = Namespace + "date"
+	Time               = Namespace + "time"
+	GYear              = Namespace + "gYear"
+	GYearMonth         = Namespace + "gYearMonth"
+	GMonth             = Namespace + "gMonth"
+	GMonthDay          = Namespace + "gMonthDay"
+	GDay               = Namespace + "gDay"
+	Duration           = Namespace + "duration"
+	DayTimeDuration    = Namespace + "dayTimeDuration"
+	YearMonthDuration  = Namespace + "yearMonthDuration"
+	HexBinary          = Namespace + "hexBinary"
+	Base64Binary       = Namespace + "base64Binary"
+)
+
+// Value is the value of a literal with an XSD datatype. Native holds the Go
+// value, by datatype:
+//   - the integer datatypes: *big.Int
+//   - decimal: *big.Rat
+//   - float: float32, double: float64
+//   - boolean: bool
+//   - dateTime, dateTimeStamp, date, time and the g datatypes: TemporalValue
+//   - duration, dayTimeDuration and yearMonthDuration: DurationValue
+//   - hexBinary and base64Binary: []byte
+//   - string, normalizedString, token, language and anyURI: string
+type Value struct {
+	Datatype string
+	Native   any
+}
+
+// datatype parses and formats the values of one datatype.
+type datatype struct {
+	parse  func(lexical string) (any, bool)
+	format func(native any) string
+}
+
+var datatypes = map[string]datatype{}
+
+func init() {
+	for iri, datatype := range numericDatatypes() {
+		datatypes[iri] = datatype
+	}
+	for iri, datatype := range temporalDatatypes() {
+		datatypes[iri] = datatype
+	}
+	formatString := func(native any) string { return native.(string) }
+	datatypes[String] = datatype{parse: func(lexical string) (any, bool) { return lexical, true }, format: formatString}
+	datatypes[NormalizedString] = datatype{parse: func(lexical string) (any, bool) {
+		return strings.NewReplacer("\t", " ", "\n", " ", "\r", " ").Replace(lexical), true
+	}, format: formatString}
+	datatypes[Token] = datatype{parse: func(lexical string) (any, bool) { return collapse(lexical), true }, format: formatString}
+	datatypes[Language] = datatype{parse: func(lexical string) (any, bool) {
+		lexical = collapse(lexical)
+		return lexical, languagePattern.MatchString(lexical)
+	}, format: formatString}
+	datatypes[AnyURI] = datatype{parse: func(lexical string) (any, bool) { return collapse(lexical), true }, format: formatString}
+	datatypes[Boolean] = datatype{parse: parseBoolean, format: func(native any) string { return fmt.Sprint(native) }}
+	datatypes[HexBinary] = datatype{parse: func(lexical string) (any, bool) {
+		value, err := hex.DecodeString(collapse(lexical))
+		return value, err == nil
+	}, format: func(native any) string { return strings.ToUpper(hex.EncodeToString(native.([]byte))) }}
+	datatypes[Base64Binary] = datatype{parse: func(lexical string) (any, bool) {
+		value, err := base64.StdEncoding.Strict().DecodeString(strings.ReplaceAll(collapse(lexical), " ", ""))
+		return value, err == nil
+	}, format: func(native any) string { return base64.StdEncoding.EncodeToString(native.([]byte)) }}
+}
+
+var languagePattern = regexp.MustCompile(`^[a-zA-Z]{1,8}(-[a-zA-Z0-9]{1,8})*$`)
+
+// collapse trims the whitespace around a lexical form and replaces the
+// whitespace inside it with single spaces.
+func collapse(lexical string) string {
+	return strings.Join(strings.Fields(lexical), " ")
+}
+
+func parseBoolean(lexical string) (any, bool) {
+	switch collapse(lexical) {
+	case "true", "1":
+		return true, true
+	case "false", "0":
+		return false, true
+	}
+	return nil, false
+}
+
+// Supported reports whether Parse knows the datatype with the given IRI.
+func Supported(datatype string) bool {
+	_, ok := datatypes[datatype]
+	return ok
+}
+
+// Parse returns the value of a lexical form of a datatype. It fails with
+// UnsupportedDatatypeError for datatypes that are not supported and with
+// InvalidLexicalFormError for lexical forms that are not valid.
+func Parse(lexical string, datatype string) (Value, error) {
+	definition, ok := datatypes[datatype]
+	if !ok {
+		return Value{}, fmt.Errorf("%w: %s", UnsupportedDatatypeError, datatype)
+	}
+	native, ok := definition.parse(lexical)
+	if !ok {
+		return Value{}, fmt.Errorf("%w: %q is not a valid <%s>", InvalidLexicalFormError, lexical, datatype)
+	}
+	return Value{Datatype: datatype, Native: native}, nil
+}
+
+// ParseLiteral returns the value of a literal. A literal without a datatype
+// is an xsd:string, a literal with a language is not supported.
+func ParseLiteral(literal interfaces.ILiteral) (Value, error) {
+	if literal.GetLanguage() != "" {
+		return Value{}, fmt.Errorf("%w: a literal with a language", UnsupportedDatatypeError)
+	}
+	if literal.GetDatatype() == nil {
+		return Parse(literal.GetValue(), String)
+	}
+	return Parse(literal.GetValue(), literal.GetDatatype().GetValue())
+}
+
+// Validate returns the error of Parse, if any.
+func Validate(lexical string, datatype string) error {
+	_, err := Parse(lexical, datatype)
+	return err
+}
+
+// Canonical returns the canonical lexical form of a lexical form of a
+// datatype. All lexical forms of the same value have the same canonical form.
+func Canonical(lexical string, datatype string) (string, error) {
+	value, err := Parse(lexical, datatype)
+	if err != nil {
+		return "", err
+	}
+	return value.String(), nil
+}
+
+// CanonicalLiteral returns the literal with its canonical lexical form.
+func CanonicalLiteral(literal interfaces.ILiteral) (interfaces.ILiteral, error) {
+	value, err := ParseLiteral(literal)
+	if err != nil {
+		return nil, err
+	}
+	return value.Literal(), nil
+}
+
+// String returns the canonical lexical form of the value.
+func (v Value) String() string {
+	return datatypes[v.Datatype].format(v.Native)
+}
+
+// Literal returns a literal with the canonical lexical form of the value.
+func (v Value) Literal() interfaces.ILiteral {
+	return NewLiteral(v.String(), "", NewNamedNode(v.Datatype))
+}
+
+// Key returns a string that is the same for values that are equal and
+// different for values that are not. Unlike Compare it takes floats and
+// doubles for the exact number they hold, so the double 0.1 does not have the
+// key of the decimal 0.1, and it takes a date or time without a timezone to
+// differ from every date or time with one.
+func (v Value) Key() string {
+	family := valueFamily(v.Datatype)
+	switch family {
+	case Decimal:
+		return family + "|" + exactKey(v.Native)
+	case DateTime, Date, Time, GYear, GYearMonth, GMonth, GMonthDay, GDay:
+		value := v.Native.(TemporalValue)
+		key := formatDateTime(value.inUTC())
+		if value.HasTimezone {
+			key += "Z"
+		}
+		return family + "|" + key
+	case Duration:
+		value := v.Native.(DurationValue)
+		return fmt.Sprintf("%s|%d|%s", family, value.Months, value.Seconds.RatString())
+	}
+	return family + "|" + v.String()
+}
+
+// Equal reports whether two values are equal, see Compare.
+func Equal(a Value, b Value) bool {
+	order, ok := Compare(a, b)
+	return ok && order == 0
+}
+
+// Compare orders two values. It returns false when the values can not be
+// compared: their datatypes have different value spaces, one of them is NaN,
+// or the order of two dates or durations is indeterminate. Numbers of all
+// numeric datatypes are compared with each other, as are the three duration
+// datatypes, dateTime with dateTimeStamp, and the string datatypes.
+func Compare(a Value, b Value) (int, bool) {
+	family := valueFamily(a.Datatype)
+	if family == "" || family != valueFamily(b.Datatype) {
+		return 0, false
+	}
+	switch family {
+	case Decimal:
+		return compareNumbers(a, b)
+	case DateTime, Date, Time, GYear, GYearMonth, GMonth, GMonthDay, GDay:
+		return compareTemporals(a.Native.(TemporalValue), b.Native.(TemporalValue))
+	case Duration:
+		return compareDurations(a.Native.(DurationValue), b.Native.(DurationValue))
+	case Boolean:
+		x, y := a.Native.(bool), b.Native.(bool)
+		if x == y {
+			return 0, true
+		}
+		if !x {
+			return -1, true
+		}
+		return 1, true
+	case HexBinary, Base64Binary:
+		return bytes.Compare(a.Native.([]byte), b.Native.([]byte)), true
+	}
+	return strings.Compare(a.Native.(string), b.Native.(string)), true
+}
+
+// Order is a total order on values that agrees with Compare wherever Compare
+// orders two values, so values can be sorted. Values of different families are
+// ordered by their family and NaN comes before all other numbers. Numbers are
+// ordered by the exact number they hold, dates and times by their moment with
+// a missing timezone taken as UTC, and durations by their average length.
+func Order(a Value, b Value) int {
+	family := a.Family()
+	if other := b.Family(); family != other {
+		return strings.Compare(family, other)
+	}
+	switch family {
+	case Decimal:
+		return orderNumbers(a.Native, b.Native)
+	case DateTime, Date, Time, GYear, GYearMonth, GMonth, GMonthDay, GDay:
+		return orderTemporals(a.Native.(TemporalValue), b.Native.(TemporalValue))
+	case Duration:
+		return orderDurations(a.Native.(DurationValue), b.Native.(DurationValue))
+	}
+	order, _ := Compare(a, b)
+	return order
+}
+
+// Family returns the datatype whose value space holds the value, like decimal
+// for all numbers. Compare only orders values of the same family.
+func (v Value) Family() string {
+	return valueFamily(v.Datatype)
+}
+
+// valueFamily returns the datatype whose value space contains the values of
+// a datatype, or the empty string for datatypes that are not supported.
+func valueFamily(datatype string) string {
+	if isNumeric(datatype) {
+		return Decimal
+	}
+	switch datatype {
+	case DateTimeStamp:
+		return DateTime
+	case DayTimeDuration, YearMonthDuration:
+		return Duration
+	case NormalizedString, Token, Language:
+		return String
+	}
+	if !Supported(datatype) {
+		return ""
+	}
+	return datatype
+}
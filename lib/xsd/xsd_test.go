@@ -0,0 +1,175 @@
+package rdfgo
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	. "github.com/maartyman/rdfgo/lib/data_model"
+)
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		datatype  string
+		lexical   string
+		canonical string
+	}{
+		{Integer, " +007 ", "7"},
+		{Integer, "-0", "0"},
+		{Integer, "123456789012345678901234567890", "123456789012345678901234567890"},
+		{Byte, "-128", "-128"},
+		{UnsignedLong, "18446744073709551615", "18446744073709551615"},
+		{Long, "-9223372036854775808", "-9223372036854775808"},
+		{Decimal, "1", "1.0"},
+		{Decimal, "+01.50", "1.5"},
+		{Decimal, "-.25", "-0.25"},
+		{Decimal, "3.", "3.0"},
+		{Decimal, "-0.000", "0.0"},
+		{Double, "1e6", "1.0E6"},
+		{Double, "-0.00125", "-1.25E-3"},
+		{Double, "INF", "INF"},
+		{Double, "+INF", "INF"},
+		{Double, "-INF", "-INF"},
+		{Double, "NaN", "NaN"},
+		{Double, "1e400", "INF"},
+		{Float, "0.1", "1.0E-1"},
+		{Float, ".5E1", "5.0E0"},
+		{Boolean, "1", "true"},
+		{Boolean, "false", "false"},
+		{DateTime, "2024-02-29T12:30:00", "2024-02-29T12:30:00"},
+		{DateTime, "2024-02-29T23:30:00.500-02:00", "2024-03-01T01:30:00.5Z"},
+		{DateTime, "1999-12-31T24:00:00Z", "2000-01-01T00:00:00Z"},
+		{DateTime, "-0044-03-15T12:00:00+00:00", "-0044-03-15T12:00:00Z"},
+		{DateTimeStamp, "2024-01-01T00:00:00+01:00", "2023-12-31T23:00:00Z"},
+		{Date, "2024-01-01+00:00", "2024-01-01Z"},
+		{Date, "12024-01-01-05:00", "12024-01-01-05:00"},
+		{Time, "24:00:00", "00:00:00"},
+		{Time, "01:00:00+02:00", "23:00:00Z"},
+		{GYear, "0000", "0000"},
+		{GYearMonth, "2024-02Z", "2024-02Z"},
+		{GMonth, "--02", "--02"},
+		{GMonthDay, "--02-29", "--02-29"},
+		{GDay, "---31+14:00", "---31+14:00"},
+		{Duration, "P1Y14M", "P2Y2M"},
+		{Duration, "PT36H", "P1DT12H"},
+		{Duration, "-P0Y0DT90.50S", "-PT1M30.5S"},
+		{Duration, "PT0S", "PT0S"},
+		{DayTimeDuration, "P2DT0.25S", "P2DT0.25S"},
+		{YearMonthDuration, "P0Y", "P0M"},
+		{HexBinary, "0fb7", "0FB7"},
+		{Base64Binary, "aGVs bG8=", "aGVsbG8="},
+		{String, " a  b ", " a  b "},
+		{NormalizedString, "a\tb", "a b"},
+		{Token, " a  b ", "a b"},
+		{Language, "en-GB", "en-GB"},
+		{AnyURI, " http://example.org/ ", "http://example.org/"},
+	}
+	for _, test := range tests {
+		t.Run(test.datatype[len(Namespace):]+" "+test.lexical, func(t *testing.T) {
+			canonical, err := Canonical(test.lexical, test.datatype)
+			if err != nil || canonical != test.canonical {
+				t.Errorf("Expected %q, got %q and %v", test.canonical, canonical, err)
+			}
+		})
+	}
+}
+
+func TestValidate_Invalid(t *testing.T) {
+	tests := []struct {
+		datatype string
+		lexical  string
+	}{
+		{Integer, "1.0"},
+		{Integer, ""},
+		{Byte, "128"},
+		{UnsignedInt, "-1"},
+		{PositiveInteger, "0"},
+		{NegativeInteger, "0"},
+		{Long, "9223372036854775808"},
+		{Decimal, "1e6"},
+		{Decimal, "."},
+		{Double, "Infinity"},
+		{Double, "0x1p3"},
+		{Float, "nan"},
+		{Boolean, "yes"},
+		{DateTime, "2023-02-29T00:00:00"},
+		{DateTime, "2024-01-01"},
+		{DateTime, "2024-01-01T24:00:01"},
+		{DateTime, "2024-01-01T00:00:00+15:00"},
+		{DateTimeStamp, "2024-01-01T00:00:00"},
+		{Date, "24-01-01"},
+		{Time, "25:00:00"},
+		{GMonthDay, "--02-30"},
+		{GDay, "---32"},
+		{Duration, "P"},
+		{Duration, "P1YT"},
+		{Duration, "P1.5Y"},
+		{DayTimeDuration, "P1M"},
+		{YearMonthDuration, "P1D"},
+		{HexBinary, "abc"},
+		{Base64Binary, "aGVsbG8"},
+		{Language, "en_GB"},
+	}
+	for _, test := range tests {
+		t.Run(test.datatype[len(Namespace):]+" "+test.lexical, func(t *testing.T) {
+			if err := Validate(test.lexical, test.datatype); !errors.Is(err, InvalidLexicalFormError) {
+				t.Errorf("Expected an invalid lexical form, got %v", err)
+			}
+		})
+	}
+}
+
+func TestParse_NativeValues(t *testing.T) {
+	value, _ := Parse("42", Short)
+	if value.Native.(*big.Int).Int64() != 42 {
+		t.Errorf("Expected 42, got %v", value.Native)
+	}
+	value, _ = Parse("0.1", Decimal)
+	if value.Native.(*big.Rat).Cmp(big.NewRat(1, 10)) != 0 {
+		t.Errorf("Expected exactly 1/10, got %v", value.Native)
+	}
+	value, _ = Parse("-INF", Float)
+	if !math.IsInf(float64(value.Native.(float32)), -1) {
+		t.Errorf("Expected negative infinity, got %v", value.Native)
+	}
+	value, _ = Parse("2024-03-01T10:20:30.123+01:30", DateTime)
+	expected := TemporalValue{Year: 2024, Month: 3, Day: 1, Hour: 10, Minute: 20, Second: 30, Nanosecond: 123000000, Timezone: 90, HasTimezone: true}
+	if value.Native.(TemporalValue) != expected {
+		t.Errorf("Expected %+v, got %+v", expected, value.Native)
+	}
+	value, _ = Parse("-P1Y2DT3S", Duration)
+	duration := value.Native.(DurationValue)
+	if duration.Months != -12 || duration.Seconds.Cmp(big.NewRat(-2*86400-3, 1)) != 0 {
+		t.Errorf("Expected -12 months and -172803 seconds, got %+v", duration)
+	}
+	value, _ = Parse("AQI=", Base64Binary)
+	if string(value.Native.([]byte)) != "\x01\x02" {
+		t.Errorf("Expected two bytes, got %v", value.Native)
+	}
+
+	if _, err := Parse("x", Namespace+"unknown"); !errors.Is(err, UnsupportedDatatypeError) || Supported(Namespace+"unknown") {
+		t.Errorf("Expected an unsupported datatype, got %v", err)
+	}
+}
+
+func TestCanonicalLiteral(t *testing.T) {
+	literal, err := CanonicalLiteral(NewLiteral("0010", "", NewNamedNode(Integer)))
+	if err != nil || !literal.Equals(NewIntegerLiteral(10)) {
+		t.Errorf("Expected \"10\"^^xsd:integer, got %v and %v", literal, err)
+	}
+	literal, err = CanonicalLiteral(NewLiteral(" a ", "", nil))
+	if err != nil || literal.GetValue() != " a " || literal.GetDatatype().GetValue() != String {
+		t.Errorf("Expected a literal without datatype to be a string, got %v and %v", literal, err)
+	}
+	if _, err := CanonicalLiteral(NewLiteral("chat", "fr", nil)); !errors.Is(err, UnsupportedDatatypeError) {
+		t.Errorf("Expected a literal with a language to be unsupported, got %v", err)
+	}
+	if _, err := CanonicalLiteral(NewLiteral("1e6", "", IRI.XSD.Decimal)); !errors.Is(err, InvalidLexicalFormError) {
+		t.Errorf("Expected 1e6 not to be a decimal, got %v", err)
+	}
+	decimal := NewDecimalLiteral(1e6)
+	if err := Validate(decimal.GetValue(), Decimal); err != nil {
+		t.Errorf("Expected NewDecimalLiteral to write a valid decimal, got %v", err)
+	}
+}